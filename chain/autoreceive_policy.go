@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+// receivePolicy is the parsed config.ReceivePolicy of an account
+type receivePolicy struct {
+	account             types.Address
+	allowTokens         map[string]struct{}
+	denyTokens          map[string]struct{}
+	minAmount           types.Balance
+	fromAddresses       map[types.Address]struct{}
+	skipContractRefunds bool
+	interval            time.Duration
+	dryRun              bool
+}
+
+func newReceivePolicy(p *config.ReceivePolicy) (*receivePolicy, error) {
+	if err := p.Verify(); err != nil {
+		return nil, err
+	}
+	account, _ := types.HexToAddress(p.Account)
+	rp := &receivePolicy{
+		account:             account,
+		allowTokens:         make(map[string]struct{}),
+		denyTokens:          make(map[string]struct{}),
+		minAmount:           types.ZeroBalance,
+		fromAddresses:       make(map[types.Address]struct{}),
+		skipContractRefunds: p.SkipContractRefunds,
+		interval:            time.Duration(p.Interval) * time.Second,
+		dryRun:              p.DryRun,
+	}
+	for _, t := range p.AllowTokens {
+		rp.allowTokens[t] = struct{}{}
+	}
+	for _, t := range p.DenyTokens {
+		rp.denyTokens[t] = struct{}{}
+	}
+	for _, addr := range p.FromAddresses {
+		a, _ := types.HexToAddress(addr)
+		rp.fromAddresses[a] = struct{}{}
+	}
+	if p.MinAmount != "" {
+		v, _ := new(big.Int).SetString(p.MinAmount, 10)
+		rp.minAmount = types.NewBalanceFromBigInt(v)
+	}
+	return rp, nil
+}
+
+func newReceivePolicies(policies []*config.ReceivePolicy) (map[types.Address]*receivePolicy, error) {
+	rps := make(map[types.Address]*receivePolicy)
+	for _, p := range policies {
+		rp, err := newReceivePolicy(p)
+		if err != nil {
+			return nil, err
+		}
+		rps[rp.account] = rp
+	}
+	return rps, nil
+}
+
+// accept checks the pending of the send block, it returns the reason if the pending is filtered out
+func (rp *receivePolicy) accept(send *types.StateBlock, amount types.Balance, tokenName string) (bool, string) {
+	token := send.Token.String()
+	if rp.matchToken(rp.denyTokens, token, tokenName) {
+		return false, fmt.Sprintf("token %s is denied", tokenName)
+	}
+	if len(rp.allowTokens) > 0 && !rp.matchToken(rp.allowTokens, token, tokenName) {
+		return false, fmt.Sprintf("token %s is not allowed", tokenName)
+	}
+	if amount.Compare(rp.minAmount) == types.BalanceCompSmaller {
+		return false, fmt.Sprintf("amount %s is less than %s", amount, rp.minAmount)
+	}
+	if len(rp.fromAddresses) > 0 {
+		if _, ok := rp.fromAddresses[send.Address]; !ok {
+			return false, fmt.Sprintf("sender %s is not allowed", send.Address)
+		}
+	}
+	if rp.skipContractRefunds && isContractRefund(send) {
+		return false, fmt.Sprintf("refunded by contract %s", types.Address(send.Link))
+	}
+	return true, ""
+}
+
+// isContractRefund reports whether the pending is created by a contract call, e.g. withdrawing a pledge is
+// a ContractSend from the pledger to the pledge contract, which creates the pending of the beneficial
+func isContractRefund(send *types.StateBlock) bool {
+	return send.Type == types.ContractSend && contractaddress.IsContractAddress(types.Address(send.Link))
+}
+
+func (rp *receivePolicy) matchToken(tokens map[string]struct{}, token, tokenName string) bool {
+	if _, ok := tokens[token]; ok {
+		return true
+	}
+	_, ok := tokens[tokenName]
+	return ok
+}
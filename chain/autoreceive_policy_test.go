@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+func TestReceivePolicy_Accept(t *testing.T) {
+	account := mock.Address()
+	sender := mock.Address()
+	token := mock.Hash()
+
+	rp, err := newReceivePolicy(&config.ReceivePolicy{
+		Account:       account.String(),
+		AllowTokens:   []string{"QLC", token.String()},
+		DenyTokens:    []string{"QGAS"},
+		MinAmount:     "100",
+		FromAddresses: []string{sender.String()},
+		Interval:      5,
+		DryRun:        true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rp.account != account || rp.interval != 5*time.Second || !rp.dryRun {
+		t.Fatal(rp)
+	}
+
+	send := mock.StateBlockWithoutWork()
+	send.Address = sender
+	send.Token = token
+	amount := types.Balance{Int: types.NewBalance(100).Int}
+
+	cases := []struct {
+		name      string
+		send      func(blk *types.StateBlock)
+		amount    types.Balance
+		tokenName string
+		exp       bool
+	}{
+		{"accepted by hash", nil, amount, "XXX", true},
+		{"accepted by name", func(blk *types.StateBlock) { blk.Token = mock.Hash() }, amount, "QLC", true},
+		{"denied", nil, amount, "QGAS", false},
+		{"not allowed", func(blk *types.StateBlock) { blk.Token = mock.Hash() }, amount, "XXX", false},
+		{"less than min", nil, types.NewBalance(99), "XXX", false},
+		{"unknown sender", func(blk *types.StateBlock) { blk.Address = mock.Address() }, amount, "XXX", false},
+	}
+	for _, c := range cases {
+		blk := send.Clone()
+		if c.send != nil {
+			c.send(blk)
+		}
+		if ok, reason := rp.accept(blk, c.amount, c.tokenName); ok != c.exp {
+			t.Fatal(c.name, reason)
+		}
+	}
+
+	// withdrawing the pledge of account
+	data, err := (&abi.WithdrawPledgeParam{
+		Beneficial: account,
+		Amount:     amount.Int,
+		PType:      uint8(abi.Vote),
+		NEP5TxId:   mock.Hash().String(),
+	}).ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	refund := send.Clone()
+	refund.Type = types.ContractSend
+	refund.Link = contractaddress.NEP5PledgeAddress.ToHash()
+	refund.Data = data
+
+	if ok, reason := rp.accept(refund, amount, "XXX"); !ok {
+		t.Fatal(reason)
+	}
+	rp.skipContractRefunds = true
+	if ok, _ := rp.accept(refund, amount, "XXX"); ok {
+		t.Fatal("contract refund should be skipped")
+	}
+	if ok, reason := rp.accept(send, amount, "XXX"); !ok {
+		t.Fatal("send of account should not be skipped", reason)
+	}
+}
+
+func TestNewReceivePolicies(t *testing.T) {
+	a1 := mock.Address()
+	a2 := mock.Address()
+	rps, err := newReceivePolicies([]*config.ReceivePolicy{{Account: a1.String()}, {Account: a2.String()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rps) != 2 || rps[a1] == nil || rps[a2] == nil || !rps[a1].minAmount.IsZero() {
+		t.Fatal(rps)
+	}
+
+	if _, err := newReceivePolicies([]*config.ReceivePolicy{{Account: "qlc_invalid"}}); err == nil {
+		t.Fatal("invalid account should be rejected")
+	}
+	if _, err := newReceivePolicies([]*config.ReceivePolicy{{Account: a1.String(), MinAmount: "abc"}}); err == nil {
+		t.Fatal("invalid amount should be rejected")
+	}
+}
+
+func TestAutoReceiveService_SetPolicies(t *testing.T) {
+	as := &AutoReceiveService{policies: make(map[types.Address]*receivePolicy), batches: make(map[types.Address]*receiveBatch),
+		logger: log.NewLogger("test_auto_receive")}
+	account := mock.Address()
+	as.setPolicies([]*config.ReceivePolicy{{Account: account.String(), Interval: 60}})
+	if rp := as.policy(account); rp == nil || rp.interval != time.Minute {
+		t.Fatal(rp)
+	}
+
+	now := time.Now()
+	as.batches[account] = &receiveBatch{lastTime: now, pendings: make(map[types.Hash]*types.StateBlock)}
+	// invalid policies are ignored
+	as.setPolicies([]*config.ReceivePolicy{{Account: "qlc_invalid"}})
+	if as.policy(account) == nil || as.batches[account].lastTime != now {
+		t.Fatal("policies should not be changed")
+	}
+
+	as.setPolicies(nil)
+	if as.policy(account) != nil || !as.batches[account].lastTime.IsZero() {
+		t.Fatal("batch should be flushed")
+	}
+}
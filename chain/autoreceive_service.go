@@ -10,6 +10,7 @@ package chain
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
 )
 
@@ -33,12 +35,67 @@ type AutoReceiveService struct {
 	blockCache chan *types.StateBlock
 	quit       chan interface{}
 	state      uint32
+	enabled    uint32
+	cfgSubID   int
 	logger     *zap.SugaredLogger
+
+	policyLock sync.RWMutex
+	policies   map[types.Address]*receivePolicy
+	// pendings waiting for the batch of accounts whose policy has an interval
+	batches map[types.Address]*receiveBatch
+}
+
+type receiveBatch struct {
+	lastTime time.Time
+	pendings map[types.Hash]*types.StateBlock
 }
 
 func NewAutoReceiveService(cfgFile string) *AutoReceiveService {
-	return &AutoReceiveService{cfgFile: cfgFile, blockCache: make(chan *types.StateBlock, 100),
-		quit: make(chan interface{}), state: 0, logger: log.NewLogger("auto_receive_service")}
+	as := &AutoReceiveService{cfgFile: cfgFile, blockCache: make(chan *types.StateBlock, 100),
+		quit: make(chan interface{}), state: 0, logger: log.NewLogger("auto_receive_service"),
+		policies: make(map[types.Address]*receivePolicy), batches: make(map[types.Address]*receiveBatch)}
+	if cfg, err := context.NewChainContext(cfgFile).Config(); err == nil {
+		as.setEnabled(cfg.AutoGenerateReceive)
+		as.setPolicies(cfg.ReceivePolicies())
+	}
+	return as
+}
+
+// setPolicies replaces the receive policies, the old policies are kept if any of the new ones is invalid
+func (as *AutoReceiveService) setPolicies(policies []*config.ReceivePolicy) {
+	rps, err := newReceivePolicies(policies)
+	if err != nil {
+		as.logger.Errorf("invalid auto receive policy: %s", err)
+		return
+	}
+
+	as.policyLock.Lock()
+	defer as.policyLock.Unlock()
+	as.policies = rps
+	// pendings of accounts no longer batched are received by the next flush
+	for addr, b := range as.batches {
+		if rp, ok := rps[addr]; !ok || rp.interval == 0 {
+			b.lastTime = time.Time{}
+		}
+	}
+}
+
+func (as *AutoReceiveService) policy(address types.Address) *receivePolicy {
+	as.policyLock.RLock()
+	defer as.policyLock.RUnlock()
+	return as.policies[address]
+}
+
+func (as *AutoReceiveService) setEnabled(enabled bool) {
+	var v uint32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreUint32(&as.enabled, v)
+}
+
+func (as *AutoReceiveService) isEnabled() bool {
+	return atomic.LoadUint32(&as.enabled) == 1
 }
 
 func (as *AutoReceiveService) Init() error {
@@ -69,6 +126,17 @@ func (as *AutoReceiveService) Start() error {
 
 	cc := context.NewChainContext(as.cfgFile)
 
+	if cm, err := cc.ConfigManager(); err == nil {
+		as.cfgSubID = cm.Subscribe(func(old, cur *config.Config) {
+			as.setEnabled(cur.AutoGenerateReceive)
+			as.setPolicies(cur.ReceivePolicies())
+			// receive the pendings arrived while it was disabled
+			if cur.AutoGenerateReceive && atomic.LoadUint32(&as.state) != 0 {
+				go as.receivePendings(cc)
+			}
+		}, config.SectionAutoReceive)
+	}
+
 	go func() {
 		for {
 			ledgerService, _ := cc.Service(context.LedgerService)
@@ -79,34 +147,16 @@ func (as *AutoReceiveService) Start() error {
 				time.Sleep(100 * time.Millisecond)
 			}
 		}
-		ledgerService, _ := cc.Service(context.LedgerService)
-
-		l := ledgerService.(*LedgerService).Ledger
-		accounts := cc.Accounts()
-		for _, account := range accounts {
-			a := account
-			err := l.GetPendingsByAddress(a.Address(), func(key *types.PendingKey, value *types.PendingInfo) error {
-				as.logger.Debugf("%s receive %s[%s] from %s (%s)\n", key.Address, value.Type.String(), value.Source.String(), value.Amount.String(), key.Hash.String())
-				if send, err := l.GetStateBlock(key.Hash); err != nil {
-					as.logger.Error(err)
-				} else {
-					err = ReceiveBlock(send, a, cc)
-					if err != nil {
-						as.logger.Debugf("err[%s] when generate receive block.\n", err)
-					}
-				}
-				return nil
-			})
-
-			if err != nil {
-				as.logger.Error(err)
-			}
+		if as.isEnabled() {
+			as.receivePendings(cc)
 		}
 		atomic.StoreUint32(&as.state, 1)
 	}()
 
 	// auto receive
 	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
 		for {
 			select {
 			case <-as.quit:
@@ -115,9 +165,13 @@ func (as *AutoReceiveService) Start() error {
 					as.logger.Error(err)
 				}
 				return
+			case <-ticker.C:
+				if atomic.LoadUint32(&as.state) != 0 && as.isEnabled() {
+					as.flushBatches(cc)
+				}
 			case blk := <-as.blockCache:
 				// waiting ledger service start and process pending
-				if atomic.LoadUint32(&as.state) != 0 {
+				if atomic.LoadUint32(&as.state) != 0 && as.isEnabled() {
 					accounts := cc.Accounts()
 					for _, account := range accounts {
 						addr := account.Address()
@@ -130,10 +184,7 @@ func (as *AutoReceiveService) Start() error {
 							} else {
 								as.logger.Debugf("receive block from [%s] to [%s] balance [%s]", blk.Address.String(), rxAddr.String(), blk.Balance.String())
 							}
-							err := ReceiveBlock(blk, account, cc)
-							if err != nil {
-								as.logger.Errorf("err[%s] when generate receive block.", err)
-							}
+							as.receive(blk, account, cc)
 							break
 						}
 					}
@@ -145,12 +196,143 @@ func (as *AutoReceiveService) Start() error {
 	return nil
 }
 
+// receive generates the receive block of the send block if its pending is accepted by the policy of the account,
+// the pending is added to the batch of the account if the policy has an interval
+func (as *AutoReceiveService) receive(send *types.StateBlock, account *types.Account, cc *context.ChainContext) {
+	addr := account.Address()
+	rp := as.policy(addr)
+	if rp == nil {
+		if err := ReceiveBlock(send, account, cc); err != nil {
+			as.logger.Errorf("err[%s] when generate receive block.", err)
+		}
+		return
+	}
+
+	l, err := as.ledger(cc)
+	if err != nil {
+		as.logger.Error(err)
+		return
+	}
+	hash := send.GetHash()
+	pending, err := l.GetPending(&types.PendingKey{Address: addr, Hash: hash})
+	if err != nil {
+		as.logger.Debugf("get pending %s of %s: %s", hash, addr, err)
+		return
+	}
+	tokenName := send.Token.String()
+	if token, err := l.GetTokenById(send.Token); err == nil {
+		tokenName = token.TokenName
+	}
+	if ok, reason := rp.accept(send, pending.Amount, tokenName); !ok {
+		as.logger.Infof("skip pending %s of %s: %s", hash, addr, reason)
+		return
+	}
+
+	if rp.interval > 0 {
+		as.policyLock.Lock()
+		b, ok := as.batches[addr]
+		if !ok {
+			b = &receiveBatch{lastTime: time.Now(), pendings: make(map[types.Hash]*types.StateBlock)}
+			as.batches[addr] = b
+		}
+		b.pendings[hash] = send
+		as.policyLock.Unlock()
+		return
+	}
+	as.receiveAccepted(send, account, cc, rp)
+}
+
+func (as *AutoReceiveService) receiveAccepted(send *types.StateBlock, account *types.Account, cc *context.ChainContext, rp *receivePolicy) {
+	if rp != nil && rp.dryRun {
+		as.logger.Infof("dry run: receive %s from %s to %s", send.GetHash(), send.Address, account.Address())
+		return
+	}
+	if err := ReceiveBlock(send, account, cc); err != nil {
+		as.logger.Errorf("err[%s] when generate receive block.", err)
+	}
+}
+
+// flushBatches receives the pendings of batches whose interval is passed
+func (as *AutoReceiveService) flushBatches(cc *context.ChainContext) {
+	type batchItem struct {
+		rp       *receivePolicy
+		pendings []*types.StateBlock
+	}
+	items := make(map[types.Address]*batchItem)
+
+	as.policyLock.Lock()
+	now := time.Now()
+	for addr, b := range as.batches {
+		rp := as.policies[addr]
+		if rp != nil && now.Sub(b.lastTime) < rp.interval {
+			continue
+		}
+		item := &batchItem{rp: rp}
+		for _, send := range b.pendings {
+			item.pendings = append(item.pendings, send)
+		}
+		items[addr] = item
+		delete(as.batches, addr)
+	}
+	as.policyLock.Unlock()
+
+	if len(items) == 0 {
+		return
+	}
+	for _, account := range cc.Accounts() {
+		if item, ok := items[account.Address()]; ok {
+			as.logger.Debugf("receive %d pendings of %s in batch", len(item.pendings), account.Address())
+			for _, send := range item.pendings {
+				as.receiveAccepted(send, account, cc, item.rp)
+			}
+		}
+	}
+}
+
+func (as *AutoReceiveService) ledger(cc *context.ChainContext) (*ledger.Ledger, error) {
+	ledgerService, err := cc.Service(context.LedgerService)
+	if err != nil {
+		return nil, err
+	}
+	return ledgerService.(*LedgerService).Ledger, nil
+}
+
+// receivePendings generates receive blocks for all pendings of the accounts
+func (as *AutoReceiveService) receivePendings(cc *context.ChainContext) {
+	l, err := as.ledger(cc)
+	if err != nil {
+		as.logger.Error(err)
+		return
+	}
+
+	accounts := cc.Accounts()
+	for _, account := range accounts {
+		a := account
+		err := l.GetPendingsByAddress(a.Address(), func(key *types.PendingKey, value *types.PendingInfo) error {
+			as.logger.Debugf("%s receive %s[%s] from %s (%s)\n", key.Address, value.Type.String(), value.Source.String(), value.Amount.String(), key.Hash.String())
+			if send, err := l.GetStateBlock(key.Hash); err != nil {
+				as.logger.Error(err)
+			} else {
+				as.receive(send, a, cc)
+			}
+			return nil
+		})
+
+		if err != nil {
+			as.logger.Error(err)
+		}
+	}
+}
+
 func (as *AutoReceiveService) Stop() error {
 	if !as.PreStop() {
 		return errors.New("pre stop fail")
 	}
 	defer as.PostStop()
 
+	if cm, err := context.NewChainContext(as.cfgFile).ConfigManager(); err == nil {
+		cm.Unsubscribe(as.cfgSubID)
+	}
 	as.quit <- struct{}{}
 
 	return as.subscriber.Unsubscribe(topic.EventConfirmedBlock)
@@ -189,10 +371,14 @@ func ReceiveBlock(sendBlock *types.StateBlock, account *types.Account, cc *conte
 	}()
 	var receiveBlock *types.StateBlock
 	if sendBlock.Type == types.Send {
-		receiveBlock, err = l.GenerateReceiveBlock(sendBlock, account.PrivateKey())
+		receiveBlock, err = l.GenerateReceiveBlock(sendBlock, nil)
 		if err != nil {
 			return
 		}
+		// the private key may be kept by the wallet or a remote signer
+		if err = l.SignStateBlock(receiveBlock, account); err != nil {
+			return
+		}
 	} else if sendBlock.Type == types.ContractSend && sendBlock.Link == types.Hash(contractaddress.RewardsAddress) {
 		sendHash := sendBlock.GetHash()
 		err = client.Call(&receiveBlock, "rewards_getReceiveRewardBlock", &sendHash)
@@ -57,6 +57,45 @@ func TestAutoReceiveService_Init(t *testing.T) {
 		t.Fatal("stop failed.")
 	}
 }
+func TestAutoReceiveService_Reload(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	s := NewAutoReceiveService(cm.ConfigFile)
+	if err := s.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if s.isEnabled() {
+		t.Fatal("auto receive should be disabled by default")
+	}
+
+	cm2, err := context.NewChainContext(cm.ConfigFile).ConfigManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cm2.UpdateParams([]string{"autoGenerateReceive=true"}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := cm2.Reload(false); !ok || err != nil {
+		t.Fatal(ok, err)
+	}
+	if !s.isEnabled() {
+		t.Fatal("auto receive should be enabled")
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
 
 func TestReceiveBlock(t *testing.T) {
 	dir := filepath.Join(config.QlcTestDataDir(), "api", uuid.New().String())
@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/chain/cdrimport"
+	ctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+// CDRImportService scans the CDR dir periodically and imports the files which are not done to settlement contracts
+type CDRImportService struct {
+	common.ServiceLifecycle
+	cc     *ctx.ChainContext
+	ctx    context.Context
+	cancel context.CancelFunc
+	logger *zap.SugaredLogger
+}
+
+func NewCDRImportService(cfgFile string) *CDRImportService {
+	c, cancel := context.WithCancel(context.Background())
+	return &CDRImportService{
+		cc:     ctx.NewChainContext(cfgFile),
+		ctx:    c,
+		cancel: cancel,
+		logger: log.NewLogger("cdr_import_service"),
+	}
+}
+
+func (cs *CDRImportService) Init() error {
+	if !cs.PreInit() {
+		return errors.New("pre init fail")
+	}
+	defer cs.PostInit()
+
+	cfg, err := cs.cc.Config()
+	if err != nil {
+		return err
+	}
+	if cfg.CDRImport == nil {
+		return errors.New("cdr import is not configured")
+	}
+	if _, err := cs.account(cfg.CDRImport); err != nil {
+		return err
+	}
+	return os.MkdirAll(cfg.CDRImport.Dir, 0700)
+}
+
+func (cs *CDRImportService) Start() error {
+	if !cs.PreStart() {
+		return errors.New("pre start fail")
+	}
+	defer cs.PostStart()
+
+	cfg, err := cs.cc.Config()
+	if err != nil {
+		return err
+	}
+	c := cfg.CDRImport
+
+	go func() {
+		interval := time.Duration(c.Interval) * time.Second
+		if interval <= 0 {
+			interval = time.Minute
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-cs.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := cs.importDir(c); err != nil && err != context.Canceled {
+					cs.logger.Error(err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (cs *CDRImportService) Stop() error {
+	if !cs.PreStop() {
+		return errors.New("pre stop fail")
+	}
+	defer cs.PostStop()
+
+	cs.cancel()
+
+	return nil
+}
+
+func (cs *CDRImportService) Status() int32 {
+	return cs.State()
+}
+
+func (cs *CDRImportService) account(c *config.CDRImportConfig) (*types.Account, error) {
+	addr, err := types.HexToAddress(c.Account)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cdr import account %s", c.Account)
+	}
+	for _, acc := range cs.cc.Accounts() {
+		if acc.Address() == addr {
+			return acc, nil
+		}
+	}
+	return nil, fmt.Errorf("cdr import account %s is not found", c.Account)
+}
+
+// files returns CDR files of the dir whose imports are not done, sorted by name
+func (cs *CDRImportService) files(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, info := range infos {
+		if info.IsDir() {
+			continue
+		}
+		file := filepath.Join(dir, info.Name())
+		if _, err := cdrimport.FormatOf(file); err != nil {
+			continue
+		}
+		if !cdrimport.IsDone(cdrimport.JournalOf(file)) {
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (cs *CDRImportService) importDir(c *config.CDRImportConfig) error {
+	files, err := cs.files(c.Dir)
+	if err != nil || len(files) == 0 {
+		return err
+	}
+
+	rpcService, err := cs.cc.Service(ctx.RPCService)
+	if err != nil {
+		return err
+	}
+	if rpcService.Status() != int32(common.Started) {
+		return errors.New("rpc service not started")
+	}
+	if !cs.cc.IsPoVDone() {
+		return ctx.ErrPoVNotFinish
+	}
+
+	account, err := cs.account(c)
+	if err != nil {
+		return err
+	}
+	client, err := rpcService.(*RPCService).RPC().Attach()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	submitter, err := cdrimport.NewRPCSubmitter(client, account)
+	if err != nil {
+		return err
+	}
+	importer, err := cdrimport.NewImporter(submitter, &cdrimport.Options{
+		Format:     c.Format,
+		Mapping:    c.Mapping,
+		BatchSize:  c.BatchSize,
+		MaxPending: c.MaxPending,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if progress, err := importer.Import(cs.ctx, file); err != nil {
+			// the file is imported again by the next scan
+			cs.logger.Errorf("import %s: %s", file, err)
+			if err == context.Canceled {
+				return err
+			}
+		} else {
+			cs.logger.Infof("%s imported, %d CDRs in %d blocks", file, progress.Imported, progress.Batches)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/chain/cdrimport"
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestCDRImportService(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	account := mock.Account()
+	cc := context.NewChainContext(cm.ConfigFile)
+	cc.SetAccounts([]*types.Account{account})
+
+	s := NewCDRImportService(cm.ConfigFile)
+	if err := s.Init(); err == nil {
+		t.Fatal("account is not configured")
+	}
+
+	cm2, err := cc.ConfigManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cm2.UpdateParams([]string{"cdrImport.enabled=true", "cdrImport.account=" + account.Address().String()}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cm2.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _ := cc.Config()
+
+	s = NewCDRImportService(cm.ConfigFile)
+	if err := s.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if s.State() != 2 {
+		t.Fatal("cdr import init failed")
+	}
+
+	for _, f := range []string{"b.csv", "a.jsonl", "c.txt", "d.csv", "d.csv.journal"} {
+		if err := ioutil.WriteFile(filepath.Join(cfg.CDRImport.Dir, f), []byte{}, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(cdrimport.JournalOf(filepath.Join(cfg.CDRImport.Dir, "d.csv")), []byte(`{"done":true}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	files, err := s.files(cfg.CDRImport.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || filepath.Base(files[0]) != "a.jsonl" || filepath.Base(files[1]) != "b.csv" {
+		t.Fatal(files)
+	}
+
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if s.Status() != 6 {
+		t.Fatal("stop failed.")
+	}
+}
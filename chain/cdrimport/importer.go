@@ -0,0 +1,363 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdrimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/log"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+const (
+	defaultBatchSize    = 100
+	defaultMaxPending   = 10
+	defaultRetries      = 3
+	defaultPollInterval = time.Second
+	journalExt          = ".journal"
+)
+
+// Submitter builds and submits the ProcessCDR blocks of batches
+type Submitter interface {
+	// Build returns the signed ProcessCDR block of the CDRs, all CDRs belong to the same settlement contract
+	Build(params []*cabi.CDRParam) (*types.StateBlock, error)
+	// Process processes the block to the ledger
+	Process(block *types.StateBlock) error
+	// Status returns whether the block is in the ledger and whether it has been confirmed
+	Status(hash types.Hash) (exists, confirmed bool, err error)
+}
+
+// Options of the import, the zero value of each field means its default value
+type Options struct {
+	// csv or jsonl, decided by the extension of the file if empty
+	Format string
+	// CDRParam field(json name) => column of csv or key of jsonl
+	Mapping map[string]string
+	// max count of CDRs in a block
+	BatchSize int
+	// max count of unconfirmed blocks, the import waits for confirmation when it is exceeded
+	MaxPending int
+	// journal file, `file.journal` if empty
+	Journal string
+	// retries of building and submitting a batch
+	Retries      int
+	PollInterval time.Duration
+}
+
+// JournalOf returns the default journal file of the CDR file
+func JournalOf(file string) string {
+	return file + journalExt
+}
+
+// Importer imports CDRs from csv/jsonl files to settlement contracts, CDRs are deduplicated by their hashes and
+// submitted in batches, the progress is saved in the journal so that the import can be resumed after a crash
+type Importer struct {
+	submitter Submitter
+	opts      Options
+	logger    *zap.SugaredLogger
+}
+
+func NewImporter(submitter Submitter, opts *Options) (*Importer, error) {
+	if submitter == nil {
+		return nil, errors.New("invalid submitter")
+	}
+	o := Options{}
+	if opts != nil {
+		o = *opts
+	}
+	if err := verifyMapping(o.Mapping); err != nil {
+		return nil, err
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultBatchSize
+	}
+	if o.MaxPending <= 0 {
+		o.MaxPending = defaultMaxPending
+	}
+	if o.Retries <= 0 {
+		o.Retries = defaultRetries
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultPollInterval
+	}
+	return &Importer{
+		submitter: submitter,
+		opts:      o,
+		logger:    log.NewLogger("cdr_import"),
+	}, nil
+}
+
+type batch struct {
+	key    string
+	params []*cabi.CDRParam
+}
+
+func (b *batch) reset() {
+	b.key = ""
+	b.params = b.params[:0]
+}
+
+// Import imports the CDRs of the file, it resumes from the journal if the file has been imported partly
+func (im *Importer) Import(ctx context.Context, file string) (*Progress, error) {
+	format := im.opts.Format
+	if format == "" {
+		var err error
+		if format, err = FormatOf(file); err != nil {
+			return nil, err
+		}
+	}
+	journalFile := im.opts.Journal
+	if journalFile == "" {
+		journalFile = JournalOf(file)
+	}
+
+	progress, err := im.resume(journalFile)
+	if err != nil {
+		return nil, err
+	}
+	progress.File = file
+	if progress.Done {
+		return progress, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := newReader(format, f)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := openJournal(journalFile)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = j.close()
+	}()
+
+	// records before the offset have been handled, they are read again only to rebuild the deduplication
+	seen := make(map[types.Hash]struct{})
+	var offset uint64
+	for ; offset < progress.Offset; offset++ {
+		values, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("%s has %d records, but %d in the journal", file, offset, progress.Offset)
+			}
+			return nil, err
+		}
+		if param, err := toCDRParam(values, im.opts.Mapping); err == nil {
+			if h, err := param.ToHash(); err == nil {
+				seen[h] = struct{}{}
+			}
+		}
+	}
+	if progress.Offset > 0 {
+		im.logger.Infof("resume import of %s from record %d", file, progress.Offset)
+	}
+
+	var inflight []types.Hash
+	current := *progress
+	b := &batch{}
+
+	flush := func() error {
+		if len(b.params) == 0 {
+			return nil
+		}
+		if err := im.wait(ctx, &inflight, im.opts.MaxPending-1); err != nil {
+			return err
+		}
+		hash, err := im.submit(ctx, j, &current, b.params)
+		if err != nil {
+			return err
+		}
+		current.Hash = hash
+		current.Submitting = false
+		if err := j.append(&current); err != nil {
+			return err
+		}
+		*progress = current
+		b.reset()
+
+		inflight = append(inflight, hash)
+		return nil
+	}
+
+	for {
+		values, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return progress, err
+		}
+
+		param, err := toCDRParam(values, im.opts.Mapping)
+		if err != nil {
+			im.logger.Warnf("%s, invalid record %d: %s", file, current.Offset+1, err)
+			current.Offset++
+			current.Invalid++
+			continue
+		}
+		h, err := param.ToHash()
+		if err != nil {
+			return progress, err
+		}
+		if _, ok := seen[h]; ok {
+			current.Offset++
+			current.Duplicated++
+			continue
+		}
+
+		// CDRs of a block are sent to the same settlement contract, which is found by the stops
+		key := param.PreStop + "\x00" + param.NextStop
+		if len(b.params) > 0 && key != b.key {
+			if err := flush(); err != nil {
+				return progress, err
+			}
+		}
+		seen[h] = struct{}{}
+		b.key = key
+		b.params = append(b.params, param)
+		current.Offset++
+		current.Imported++
+
+		if len(b.params) >= im.opts.BatchSize {
+			if err := flush(); err != nil {
+				return progress, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return progress, err
+	}
+	if err := im.wait(ctx, &inflight, 0); err != nil {
+		return progress, err
+	}
+
+	current.Done = true
+	if err := j.append(&current); err != nil {
+		return progress, err
+	}
+	*progress = current
+	im.logger.Infof("import of %s is done, imported: %d, duplicated: %d, invalid: %d, batches: %d",
+		file, progress.Imported, progress.Duplicated, progress.Invalid, progress.Batches)
+	return progress, nil
+}
+
+// resume returns the progress to resume from, if the last batch was being submitted, it is regarded as handled
+// only when its block is found in the ledger
+func (im *Importer) resume(journalFile string) (*Progress, error) {
+	last, committed, err := loadJournal(journalFile)
+	if err != nil {
+		return nil, err
+	}
+	if last == nil {
+		return &Progress{}, nil
+	}
+	if !last.Submitting {
+		return last, nil
+	}
+
+	exists, _, err := im.submitter.Status(last.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		last.Submitting = false
+		return last, nil
+	}
+	im.logger.Infof("block %s of the last batch is not found, submit it again", last.Hash.String())
+	if committed == nil {
+		return &Progress{}, nil
+	}
+	return committed, nil
+}
+
+// submit builds and processes the block of the batch, progress contains the batch, it is saved to the journal
+// before the block is processed
+func (im *Importer) submit(ctx context.Context, j *journal, progress *Progress, params []*cabi.CDRParam) (types.Hash, error) {
+	var hash types.Hash
+	err := im.retry(ctx, func() error {
+		block, err := im.submitter.Build(params)
+		if err != nil {
+			return err
+		}
+		hash = block.GetHash()
+
+		p := *progress
+		p.Batches++
+		p.Hash = hash
+		p.Submitting = true
+		if err := j.append(&p); err != nil {
+			return err
+		}
+
+		if err := im.submitter.Process(block); err != nil {
+			if exists, _, e := im.submitter.Status(hash); e == nil && exists {
+				return nil
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	progress.Batches++
+	return hash, nil
+}
+
+func (im *Importer) retry(ctx context.Context, fn func() error) error {
+	var err error
+	for i := 0; i <= im.opts.Retries; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		im.logger.Warnf("submit CDRs (%d/%d): %s", i+1, im.opts.Retries+1, err)
+		if i < im.opts.Retries {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(im.opts.PollInterval * time.Duration(i+1)):
+			}
+		}
+	}
+	return err
+}
+
+// wait blocks until the count of unconfirmed blocks is no more than max
+func (im *Importer) wait(ctx context.Context, inflight *[]types.Hash, max int) error {
+	for len(*inflight) > max {
+		if _, confirmed, err := im.submitter.Status((*inflight)[0]); err != nil {
+			im.logger.Warn(err)
+		} else if confirmed {
+			*inflight = (*inflight)[1:]
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(im.opts.PollInterval):
+		}
+	}
+	return nil
+}
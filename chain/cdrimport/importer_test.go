@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdrimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+type mockSubmitter struct {
+	lock      sync.Mutex
+	count     int
+	batches   map[types.Hash][]*cabi.CDRParam
+	processed map[types.Hash]bool
+	queried   map[types.Hash]int
+	// fail the process of blocks from the index
+	failFrom   int
+	maxPending int
+}
+
+func newMockSubmitter() *mockSubmitter {
+	return &mockSubmitter{
+		batches:   make(map[types.Hash][]*cabi.CDRParam),
+		processed: make(map[types.Hash]bool),
+		queried:   make(map[types.Hash]int),
+		failFrom:  -1,
+	}
+}
+
+func (m *mockSubmitter) Build(params []*cabi.CDRParam) (*types.StateBlock, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.count++
+	b := &types.StateBlock{Type: types.ContractSend, Timestamp: int64(m.count)}
+	for _, p := range params {
+		if h, err := p.ToHash(); err == nil {
+			b.Data = append(b.Data, h[:]...)
+		}
+	}
+	m.batches[b.GetHash()] = append([]*cabi.CDRParam(nil), params...)
+	return b, nil
+}
+
+func (m *mockSubmitter) Process(block *types.StateBlock) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.failFrom >= 0 && int(block.Timestamp) > m.failFrom {
+		return errors.New("process failed")
+	}
+	m.processed[block.GetHash()] = true
+	pending := 0
+	for h := range m.processed {
+		if m.queried[h] < 2 {
+			pending++
+		}
+	}
+	if pending > m.maxPending {
+		m.maxPending = pending
+	}
+	return nil
+}
+
+// Status confirms the block at the second query
+func (m *mockSubmitter) Status(hash types.Hash) (bool, bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if !m.processed[hash] {
+		return false, false, nil
+	}
+	m.queried[hash]++
+	return true, m.queried[hash] >= 2, nil
+}
+
+// imported returns CDRs of processed blocks
+func (m *mockSubmitter) imported() []*cabi.CDRParam {
+	var result []*cabi.CDRParam
+	for h := range m.processed {
+		result = append(result, m.batches[h]...)
+	}
+	return result
+}
+
+func testDir(t *testing.T) (string, func()) {
+	dir := filepath.Join(config.QlcTestDataDir(), "cdrimport", uuid.New().String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// writeCDRs writes count CDRs, every fifth one duplicates the previous one, the stop changes every 7 CDRs
+func writeCDRs(t *testing.T, file string, count int) (unique int) {
+	var sb strings.Builder
+	sb.WriteString("index,smsDt,sender,destination,sendingStatus,dlrStatus,nextStop\n")
+	idx := 0
+	for i := 0; i < count; i++ {
+		if i%5 != 4 {
+			idx++
+			unique++
+		}
+		sb.WriteString(fmt.Sprintf("%d,1581065280,WeChat,85257***%03d,Sent,Delivered,stop%d\n", idx, idx, idx/7))
+	}
+	sb.WriteString("x,1581065280,WeChat,85257***343,Sent,Delivered,stop0\n")
+	if err := ioutil.WriteFile(file, []byte(sb.String()), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return unique
+}
+
+func verifyImported(t *testing.T, m *mockSubmitter, unique int) {
+	params := m.imported()
+	if len(params) != unique {
+		t.Fatalf("imported %d, exp: %d", len(params), unique)
+	}
+	seen := make(map[types.Hash]bool)
+	for _, p := range params {
+		h, _ := p.ToHash()
+		if seen[h] {
+			t.Fatal("duplicated CDR", p)
+		}
+		seen[h] = true
+	}
+	for h := range m.processed {
+		stop := m.batches[h][0].NextStop
+		for _, p := range m.batches[h] {
+			if p.NextStop != stop {
+				t.Fatal("CDRs of different stops in a batch")
+			}
+		}
+	}
+}
+
+func TestImporter_Import(t *testing.T) {
+	dir, clean := testDir(t)
+	defer clean()
+	file := filepath.Join(dir, "cdr.csv")
+	unique := writeCDRs(t, file, 100)
+
+	m := newMockSubmitter()
+	im, err := NewImporter(m, &Options{BatchSize: 5, MaxPending: 2, PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	progress, err := im.Import(context.Background(), file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !progress.Done || progress.Offset != 101 || progress.Imported != uint64(unique) || progress.Duplicated != 20 ||
+		progress.Invalid != 1 || progress.Batches != uint64(len(m.processed)) {
+		t.Fatal(progress)
+	}
+	verifyImported(t, m, unique)
+	if m.maxPending > 2 {
+		t.Fatal("max pending exceeded", m.maxPending)
+	}
+	for h := range m.processed {
+		if m.queried[h] < 2 {
+			t.Fatal("block is not confirmed", h)
+		}
+	}
+	if !IsDone(JournalOf(file)) {
+		t.Fatal("journal should be done")
+	}
+
+	// import again
+	m2 := newMockSubmitter()
+	im2, _ := NewImporter(m2, &Options{BatchSize: 5})
+	if p, err := im2.Import(context.Background(), file); err != nil || !p.Done || len(m2.processed) != 0 {
+		t.Fatal(p, err)
+	}
+}
+
+func TestImporter_Resume(t *testing.T) {
+	dir, clean := testDir(t)
+	defer clean()
+	file := filepath.Join(dir, "cdr.csv")
+	unique := writeCDRs(t, file, 100)
+
+	for _, exists := range []bool{false, true} {
+		_ = os.Remove(JournalOf(file))
+		m := newMockSubmitter()
+		m.failFrom = 5
+		im, _ := NewImporter(m, &Options{BatchSize: 5, Retries: 1, PollInterval: time.Millisecond})
+		progress, err := im.Import(context.Background(), file)
+		if err == nil || progress.Done || progress.Batches != 5 {
+			t.Fatal(progress, err)
+		}
+		last, _, _ := loadJournal(JournalOf(file))
+		if !last.Submitting {
+			t.Fatal(last)
+		}
+		if exists {
+			// the block was processed but the crash happened before the journal was updated
+			m.processed[last.Hash] = true
+		}
+
+		m.failFrom = -1
+		progress, err = im.Import(context.Background(), file)
+		if err != nil || !progress.Done {
+			t.Fatal(progress, err)
+		}
+		verifyImported(t, m, unique)
+	}
+}
+
+func TestImporter_Cancel(t *testing.T) {
+	dir, clean := testDir(t)
+	defer clean()
+	file := filepath.Join(dir, "cdr.jsonl")
+	if err := ioutil.WriteFile(file, []byte(`{"index":1,"smsDt":1581065280,"sender":"WeChat","destination":"85257***343"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	m := newMockSubmitter()
+	im, _ := NewImporter(m, &Options{PollInterval: time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// the block is processed, but the import is cancelled while waiting for the confirmation
+	if _, err := im.Import(ctx, file); err != context.Canceled {
+		t.Fatal(err)
+	}
+	if progress, err := im.Import(context.Background(), file); err != nil || !progress.Done || len(m.processed) != 1 {
+		t.Fatal(progress, err)
+	}
+
+	if _, err := NewImporter(m, &Options{Mapping: map[string]string{"idx": "id"}}); err == nil {
+		t.Fatal("invalid mapping")
+	}
+}
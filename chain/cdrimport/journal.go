@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdrimport
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// Progress is the progress of the import of a file, Offset is the count of records handled, which are
+// submitted to the chain, duplicated or invalid
+type Progress struct {
+	File       string     `json:"file"`
+	Offset     uint64     `json:"offset"`
+	Imported   uint64     `json:"imported"`
+	Duplicated uint64     `json:"duplicated"`
+	Invalid    uint64     `json:"invalid"`
+	Batches    uint64     `json:"batches"`
+	Hash       types.Hash `json:"hash"`
+	// the block of the batch is built but it is unknown whether it has been processed
+	Submitting bool `json:"submitting,omitempty"`
+	Done       bool `json:"done,omitempty"`
+}
+
+// journal appends the progress of each batch to the file, the last entry is the progress to resume from
+type journal struct {
+	file *os.File
+}
+
+// loadJournal returns the last progress in the journal and the last one which is not submitting, entries torn
+// by a crash are ignored
+func loadJournal(path string) (last, committed *Progress, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		p := new(Progress)
+		if err := json.Unmarshal(scanner.Bytes(), p); err != nil {
+			continue
+		}
+		last = p
+		if !p.Submitting {
+			committed = p
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return last, committed, nil
+}
+
+func openJournal(path string) (*journal, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	// terminate the entry torn by a crash, so that new entries are not appended to it
+	if info, err := f.Stat(); err == nil && info.Size() > 0 {
+		b := make([]byte, 1)
+		if _, err := f.ReadAt(b, info.Size()-1); err == nil && b[0] != '\n' {
+			if _, err := f.Write([]byte{'\n'}); err != nil {
+				_ = f.Close()
+				return nil, err
+			}
+		}
+	}
+	return &journal{file: f}, nil
+}
+
+func (j *journal) append(progress *Progress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	if _, err := j.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return j.file.Sync()
+}
+
+func (j *journal) close() error {
+	return j.file.Close()
+}
+
+// IsDone checks whether the import of the file with the journal is completed
+func IsDone(journalFile string) bool {
+	progress, _, err := loadJournal(journalFile)
+	return err == nil && progress != nil && progress.Done
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdrimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+func TestJournal(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "cdrimport", uuid.New().String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	path := filepath.Join(dir, "cdr.csv.journal")
+
+	if last, committed, err := loadJournal(path); err != nil || last != nil || committed != nil {
+		t.Fatal(last, committed, err)
+	}
+	if IsDone(path) {
+		t.Fatal("journal not exist")
+	}
+
+	j, err := openJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := j.append(&Progress{Offset: 10, Imported: 10, Batches: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.append(&Progress{Offset: 20, Imported: 20, Batches: 2, Hash: types.Hash{1}, Submitting: true}); err != nil {
+		t.Fatal(err)
+	}
+	// torn entry of a crash
+	if _, err := j.file.Write([]byte(`{"offset":30,"imp`)); err != nil {
+		t.Fatal(err)
+	}
+	_ = j.close()
+
+	last, committed, err := loadJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Offset != 20 || !last.Submitting || last.Hash != (types.Hash{1}) || committed.Offset != 10 {
+		t.Fatal(last, committed)
+	}
+
+	j, err = openJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := j.append(&Progress{Offset: 30, Imported: 30, Batches: 3, Done: true}); err != nil {
+		t.Fatal(err)
+	}
+	_ = j.close()
+
+	if last, committed, err = loadJournal(path); err != nil || last.Offset != 30 || committed.Offset != 30 {
+		t.Fatal(last, committed, err)
+	}
+	if !IsDone(path) {
+		t.Fatal("journal should be done")
+	}
+}
@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdrimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// fields of CDRParam which can be mapped, named by the json tags
+var fields = []string{"index", "smsDt", "account", "sender", "customer", "destination", "sendingStatus", "dlrStatus",
+	"preStop", "nextStop", "dstMcc", "dstMnc", "kind", "duration", "volume"}
+
+// FormatOf returns the format of the file by its extension
+func FormatOf(file string) (string, error) {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".jsonl", ".json":
+		return FormatJSONL, nil
+	default:
+		return "", fmt.Errorf("unknown format of %s", file)
+	}
+}
+
+// verifyMapping checks the mapping of CDRParam fields to the columns of CSV or the keys of JSONL
+func verifyMapping(mapping map[string]string) error {
+	for field, column := range mapping {
+		found := false
+		for _, f := range fields {
+			if f == field {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown CDR field %s, try [%s]", field, strings.Join(fields, ", "))
+		}
+		if column == "" {
+			return fmt.Errorf("empty column of CDR field %s", field)
+		}
+	}
+	return nil
+}
+
+// column returns the column of the field, fields without mapping use their own names
+func column(mapping map[string]string, field string) string {
+	if c, ok := mapping[field]; ok {
+		return c
+	}
+	return field
+}
+
+// reader reads CDR records one by one, it returns io.EOF at the end of the file
+type reader interface {
+	Read() (map[string]string, error)
+}
+
+func newReader(format string, r io.Reader) (reader, error) {
+	switch format {
+	case FormatCSV:
+		cr := csv.NewReader(r)
+		cr.ReuseRecord = true
+		header, err := cr.Read()
+		if err != nil {
+			return nil, fmt.Errorf("read csv header: %s", err)
+		}
+		columns := make([]string, len(header))
+		for i, h := range header {
+			columns[i] = strings.TrimSpace(h)
+		}
+		return &csvReader{reader: cr, columns: columns}, nil
+	case FormatJSONL:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		return &jsonlReader{scanner: scanner}, nil
+	default:
+		return nil, fmt.Errorf("invalid format %s, try [%s, %s]", format, FormatCSV, FormatJSONL)
+	}
+}
+
+type csvReader struct {
+	reader  *csv.Reader
+	columns []string
+}
+
+func (r *csvReader) Read() (map[string]string, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(r.columns))
+	for i, c := range r.columns {
+		if i < len(record) {
+			values[c] = strings.TrimSpace(record[i])
+		}
+	}
+	return values, nil
+}
+
+type jsonlReader struct {
+	scanner *bufio.Scanner
+}
+
+func (r *jsonlReader) Read() (map[string]string, error) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		values := make(map[string]string)
+		var record map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+		if err := decoder.Decode(&record); err != nil {
+			// keep the record to be counted as invalid
+			return values, nil
+		}
+		for k, v := range record {
+			switch value := v.(type) {
+			case nil:
+			case string:
+				values[k] = value
+			default:
+				values[k] = fmt.Sprint(value)
+			}
+		}
+		return values, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// toCDRParam converts the values of the record to CDRParam by the mapping, the date is unix seconds or RFC3339,
+// the statuses are names or numbers of the enums
+func toCDRParam(values map[string]string, mapping map[string]string) (*cabi.CDRParam, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("invalid record")
+	}
+	get := func(field string) string {
+		return values[column(mapping, field)]
+	}
+
+	param := &cabi.CDRParam{
+		Account:     get("account"),
+		Sender:      get("sender"),
+		Customer:    get("customer"),
+		Destination: get("destination"),
+		PreStop:     get("preStop"),
+		NextStop:    get("nextStop"),
+	}
+
+	var err error
+	if param.Index, err = parseUint(get("index")); err != nil {
+		return nil, fmt.Errorf("index: %s", err)
+	}
+	if param.SmsDt, err = parseDate(get("smsDt")); err != nil {
+		return nil, fmt.Errorf("smsDt: %s", err)
+	}
+	if param.DstMcc, err = parseUint(get("dstMcc")); err != nil {
+		return nil, fmt.Errorf("dstMcc: %s", err)
+	}
+	if param.DstMnc, err = parseUint(get("dstMnc")); err != nil {
+		return nil, fmt.Errorf("dstMnc: %s", err)
+	}
+	if param.Duration, err = parseUint(get("duration")); err != nil {
+		return nil, fmt.Errorf("duration: %s", err)
+	}
+	if param.Volume, err = parseUint(get("volume")); err != nil {
+		return nil, fmt.Errorf("volume: %s", err)
+	}
+
+	if s := get("kind"); s != "" {
+		if i, err := strconv.Atoi(s); err == nil {
+			param.Kind = cabi.CDRKind(i)
+		} else if param.Kind, err = cabi.ParseCDRKind(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if s := get("sendingStatus"); s != "" {
+		if i, err := strconv.Atoi(s); err == nil {
+			param.SendingStatus = cabi.SendingStatus(i)
+		} else if param.SendingStatus, err = cabi.ParseSendingStatus(s); err != nil {
+			return nil, err
+		}
+	}
+	if s := get("dlrStatus"); s != "" {
+		if i, err := strconv.Atoi(s); err == nil {
+			param.DlrStatus = cabi.DLRStatus(i)
+		} else if param.DlrStatus, err = cabi.ParseDLRStatus(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := param.Verify(); err != nil {
+		return nil, err
+	}
+	return param, nil
+}
+
+func parseUint(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func parseDate(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
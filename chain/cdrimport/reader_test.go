@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdrimport
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+func TestFormatOf(t *testing.T) {
+	for file, format := range map[string]string{"a.csv": FormatCSV, "b.CSV": FormatCSV, "c.jsonl": FormatJSONL, "d.json": FormatJSONL} {
+		if f, err := FormatOf(file); err != nil || f != format {
+			t.Fatal(file, f, err)
+		}
+	}
+	if _, err := FormatOf("e.txt"); err == nil {
+		t.Fatal("invalid format")
+	}
+}
+
+func TestVerifyMapping(t *testing.T) {
+	if err := verifyMapping(map[string]string{"index": "id", "smsDt": "date"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyMapping(map[string]string{"idx": "id"}); err == nil {
+		t.Fatal("invalid field")
+	}
+	if err := verifyMapping(map[string]string{"index": ""}); err == nil {
+		t.Fatal("invalid column")
+	}
+}
+
+func readAll(t *testing.T, format, data string, mapping map[string]string) ([]*cabi.CDRParam, int) {
+	r, err := newReader(format, strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var params []*cabi.CDRParam
+	invalid := 0
+	for {
+		values, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if param, err := toCDRParam(values, mapping); err != nil {
+			invalid++
+		} else {
+			params = append(params, param)
+		}
+	}
+	return params, invalid
+}
+
+func TestReader_CSV(t *testing.T) {
+	data := `id, date, sender, destination, status, dlr, nextStop
+1,1581065280,WeChat,85257***343,Sent,Delivered,A2P_PCCWG
+2,2020-02-07T08:48:00Z,WeChat,85257***344,2,0,A2P_PCCWG
+0,1581065280,WeChat,85257***345,Sent,Delivered,A2P_PCCWG
+3,1581065280,,85257***346,Sent,Delivered,A2P_PCCWG
+4,1581065280,WeChat,85257***347,Sending,Delivered,A2P_PCCWG
+`
+	mapping := map[string]string{"index": "id", "smsDt": "date", "sendingStatus": "status", "dlrStatus": "dlr"}
+	params, invalid := readAll(t, FormatCSV, data, mapping)
+	if len(params) != 2 || invalid != 3 {
+		t.Fatal(len(params), invalid)
+	}
+	if p := params[0]; p.Index != 1 || p.SmsDt != 1581065280 || p.Sender != "WeChat" || p.NextStop != "A2P_PCCWG" ||
+		p.SendingStatus != cabi.SendingStatusSent || p.DlrStatus != cabi.DLRStatusDelivered {
+		t.Fatal(p)
+	}
+	if p := params[1]; p.SmsDt != 1581065280 || p.SendingStatus != cabi.SendingStatus(2) || p.DlrStatus != cabi.DLRStatusDelivered {
+		t.Fatal(p)
+	}
+
+	if _, err := newReader(FormatCSV, strings.NewReader("")); err == nil {
+		t.Fatal("empty csv")
+	}
+}
+
+func TestReader_JSONL(t *testing.T) {
+	data := `{"index":1,"smsDt":1581065280,"sender":"WeChat","destination":"85257***343","sendingStatus":"Sent","dlrStatus":"Delivered","preStop":"MONTNETS"}
+
+{"index":2,"smsDt":1581065280,"sender":"WeChat","destination":"85257***344","sendingStatus":"Sent","dlrStatus":"Delivered","preStop":"MONTNETS","dstMcc":454}
+{"index":3,"smsDt"
+{"index":4,"smsDt":1581065280,"sender":null,"destination":"85257***346"}
+{"index":5,"smsDt":1581065280,"sender":"WeChat","destination":"85257***347","kind":"Voice","duration":61}
+{"index":6,"smsDt":1581065280,"sender":"WeChat","destination":"85257***348","kind":2,"duration":300,"volume":1048576}
+{"index":7,"smsDt":1581065280,"sender":"WeChat","destination":"85257***349","duration":61}
+`
+	params, invalid := readAll(t, FormatJSONL, data, nil)
+	if len(params) != 4 || invalid != 3 {
+		t.Fatal(len(params), invalid)
+	}
+	if p := params[1]; p.Index != 2 || p.PreStop != "MONTNETS" || p.DstMcc != 454 || p.Kind != cabi.CDRKindSMS {
+		t.Fatal(p)
+	}
+	if p := params[2]; p.Kind != cabi.CDRKindVoice || p.Duration != 61 {
+		t.Fatal(p)
+	}
+	if p := params[3]; p.Kind != cabi.CDRKindData || p.Duration != 300 || p.Volume != 1048576 {
+		t.Fatal(p)
+	}
+}
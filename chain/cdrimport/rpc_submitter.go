@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdrimport
+
+import (
+	"errors"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+// RPCSubmitter submits CDRs by the settlement RPC and signs the blocks with the account
+type RPCSubmitter struct {
+	client  *rpc.Client
+	account *types.Account
+}
+
+func NewRPCSubmitter(client *rpc.Client, account *types.Account) (*RPCSubmitter, error) {
+	if client == nil {
+		return nil, errors.New("invalid rpc client")
+	}
+	if account == nil {
+		return nil, errors.New("invalid account")
+	}
+	return &RPCSubmitter{client: client, account: account}, nil
+}
+
+func (s *RPCSubmitter) Build(params []*cabi.CDRParam) (*types.StateBlock, error) {
+	addr := s.account.Address()
+	block := new(types.StateBlock)
+	if err := s.client.Call(&block, "settlement_getProcessCDRBlock", &addr, params); err != nil {
+		return nil, err
+	}
+
+	var w types.Work
+	worker, err := types.NewWorker(w, block.Root())
+	if err != nil {
+		return nil, err
+	}
+	block.Work = worker.NewWork()
+
+	if block.Signature, err = s.account.SignHash(block.GetHash()); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (s *RPCSubmitter) Process(block *types.StateBlock) error {
+	var h types.Hash
+	return s.client.Call(&h, "ledger_process", block)
+}
+
+func (s *RPCSubmitter) Status(hash types.Hash) (bool, bool, error) {
+	var blocks []*api.APIBlock
+	if err := s.client.Call(&blocks, "ledger_blocksInfo", []types.Hash{hash}); err != nil {
+		return false, false, err
+	}
+	if len(blocks) == 0 {
+		return false, false, nil
+	}
+
+	var confirmed bool
+	if err := s.client.Call(&confirmed, "ledger_blockConfirmedStatus", hash); err != nil {
+		return true, false, err
+	}
+	return true, confirmed, nil
+}
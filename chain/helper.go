@@ -42,8 +42,13 @@ func RegisterServices(cc *context.ChainContext) error {
 		}
 		_ = cc.Register(context.P2PService, netService)
 	}
-	consensusService := NewConsensusService(cfgFile)
-	_ = cc.Register(context.ConsensusService, consensusService)
+	// light client follows PoV headers only, there is no DAG to confirm or mine on
+	lightMode := cfg.PoV.PovEnabled && cfg.PoV.LightMode
+
+	if !lightMode {
+		consensusService := NewConsensusService(cfgFile)
+		_ = cc.Register(context.ConsensusService, consensusService)
+	}
 	if rpcService, err := NewRPCService(cfgFile); err != nil {
 		return err
 	} else {
@@ -53,16 +58,23 @@ func RegisterServices(cc *context.ChainContext) error {
 	if cfg.PoV.PovEnabled {
 		povService := NewPoVService(cfgFile)
 		_ = cc.Register(context.PovService, povService)
-		minerService := NewMinerService(cfgFile, povService.GetPoVEngine())
-		_ = cc.Register(context.MinerService, minerService)
+		if !lightMode {
+			minerService := NewMinerService(cfgFile, povService.GetPoVEngine())
+			_ = cc.Register(context.MinerService, minerService)
+		}
 	}
 
 	accounts := cc.Accounts()
-	if len(accounts) > 0 && cfg.AutoGenerateReceive {
+	if len(accounts) > 0 && !lightMode {
 		autoReceiveService := NewAutoReceiveService(cfgFile)
 		_ = cc.Register(context.AutoReceiveService, autoReceiveService)
 	}
 
+	if len(accounts) > 0 && !lightMode && cfg.CDRImport != nil && cfg.CDRImport.Enabled {
+		cdrImportService := NewCDRImportService(cfgFile)
+		_ = cc.Register(context.CDRImportService, cdrImportService)
+	}
+
 	if cfg.Metrics.Enable {
 		metricsService := NewMetricsService(cfgFile)
 		_ = cc.Register(context.MetricsService, metricsService)
@@ -71,8 +83,10 @@ func RegisterServices(cc *context.ChainContext) error {
 	chainManageService := NewChainManageService(cfgFile)
 	_ = cc.Register(context.ChainManageService, chainManageService)
 
-	resendBlockService := NewResendBlockService(cfgFile)
-	_ = cc.Register(context.ResendBlockService, resendBlockService)
+	if !lightMode {
+		resendBlockService := NewResendBlockService(cfgFile)
+		_ = cc.Register(context.ResendBlockService, resendBlockService)
+	}
 
 	if cfg.Privacy.Enable {
 		privacyService := NewPrivacyService(cfgFile)
@@ -8,12 +8,14 @@
 package chain
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
-	"github.com/qlcchain/go-qlc/chain/context"
+	chaincontext "github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
@@ -29,15 +31,20 @@ type LedgerService struct {
 	Ledger *ledger.Ledger
 	logger *zap.SugaredLogger
 	cfg    *config.Config
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 func NewLedgerService(cfgFile string) *LedgerService {
-	cc := context.NewChainContext(cfgFile)
+	cc := chaincontext.NewChainContext(cfgFile)
 	cfg, _ := cc.Config()
+	ctx, cancel := context.WithCancel(context.Background())
 	return &LedgerService{
 		Ledger: ledger.NewLedger(cfgFile),
 		logger: log.NewLogger("ledger_service"),
 		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
 	}
 }
 
@@ -105,6 +112,9 @@ func (ls *LedgerService) Start() error {
 	if err := ls.registerRelation(); err != nil {
 		return fmt.Errorf("ledger start: %s", err)
 	}
+	if ls.cfg != nil && ls.cfg.DB != nil && ls.cfg.DB.Prune != nil && ls.cfg.DB.Prune.Enabled {
+		go ls.prune(ls.cfg.DB.Prune)
+	}
 	return nil
 }
 
@@ -114,6 +124,7 @@ func (ls *LedgerService) Stop() error {
 	}
 	defer ls.PostStop()
 
+	ls.cancel()
 	ls.Ledger.Close()
 	// close all ledger
 	ledger.CloseLedger()
@@ -131,3 +142,26 @@ func (ls *LedgerService) registerRelation() error {
 	//}
 	return nil
 }
+
+func (ls *LedgerService) prune(cfg *config.PruneConfig) {
+	interval := time.Duration(cfg.Interval) * time.Second
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ls.ctx.Done():
+			return
+		case <-ticker.C:
+			r, err := ls.Ledger.PruneBlocks(cfg.KeepHeights)
+			if err != nil {
+				ls.logger.Errorf("prune ledger: %s", err)
+				continue
+			}
+			ls.logger.Infof("pruned %d of %d blocks, pruned height %d", r.Pruned, r.Scanned, r.PrunedHeight)
+		}
+	}
+}
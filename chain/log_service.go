@@ -21,13 +21,16 @@ import (
 
 type LogService struct {
 	common.ServiceLifecycle
-	cfg *config.Config
+	cfg   *config.Config
+	cm    *config.CfgManager
+	subID int
 }
 
 func NewLogService(cfgFile string) *LogService {
 	cc := context.NewChainContext(cfgFile)
 	cfg, _ := cc.Config()
-	return &LogService{cfg: cfg}
+	cm, _ := cc.ConfigManager()
+	return &LogService{cfg: cfg, cm: cm}
 }
 
 func (ls *LogService) Init() error {
@@ -36,23 +39,35 @@ func (ls *LogService) Init() error {
 	}
 	defer ls.PostInit()
 
-	// enable rpc debug log
-	l := zap.ErrorLevel
-	if err := l.Set(ls.cfg.LogLevel); err == nil {
-		if l.Enabled(zap.DebugLevel) {
-			rpc.IsDebug = true
-		}
-	}
+	setRPCDebug(ls.cfg.LogLevel)
 
 	return log.Setup(ls.cfg)
 }
 
+// enable rpc debug log
+func setRPCDebug(logLevel string) {
+	l := zap.ErrorLevel
+	if err := l.Set(logLevel); err == nil {
+		rpc.IsDebug = l.Enabled(zap.DebugLevel)
+	}
+}
+
 func (ls *LogService) Start() error {
 	if !ls.PreStart() {
 		return errors.New("LogService pre start fail")
 	}
 	defer ls.PostStart()
 
+	if ls.cm != nil {
+		ls.subID = ls.cm.Subscribe(func(old, cur *config.Config) {
+			if err := log.SetLevel(cur.LogLevel); err != nil {
+				log.Root.Error(err)
+				return
+			}
+			setRPCDebug(cur.LogLevel)
+		}, config.SectionLog)
+	}
+
 	return nil
 }
 
@@ -62,6 +77,9 @@ func (ls *LogService) Stop() error {
 	}
 	defer ls.PostStop()
 
+	if ls.cm != nil {
+		ls.cm.Unsubscribe(ls.subID)
+	}
 	return log.Teardown()
 }
 
@@ -10,13 +10,23 @@ package chain
 import (
 	"context"
 	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/rcrowley/go-metrics"
+
 	ctx "github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/monitor"
 	"github.com/qlcchain/go-qlc/monitor/influxdb"
+	"github.com/qlcchain/go-qlc/monitor/prometheus"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 )
 
 func NewMetricsService(cfgFile string) *MetricsService {
@@ -25,6 +35,7 @@ func NewMetricsService(cfgFile string) *MetricsService {
 	ctx2, cancel := context.WithCancel(context.Background())
 	return &MetricsService{
 		cfg:    cfg,
+		cc:     cc,
 		ctx:    ctx2,
 		cancel: cancel,
 	}
@@ -33,8 +44,14 @@ func NewMetricsService(cfgFile string) *MetricsService {
 type MetricsService struct {
 	common.ServiceLifecycle
 	cfg    *config.Config
+	cc     *ctx.ChainContext
 	ctx    context.Context
 	cancel context.CancelFunc
+	tracer *tracing.Tracer
+
+	samplerLock   sync.Mutex
+	samplerCancel context.CancelFunc
+	cfgSubID      int
 }
 
 func (m *MetricsService) Init() error {
@@ -52,16 +69,73 @@ func (m *MetricsService) Start() error {
 	}
 	defer m.PostStart()
 
-	if m.cfg.Metrics.Enable {
-		d := time.Second * time.Duration(m.cfg.Metrics.SampleInterval)
-		go monitor.CaptureRuntimeCPUStats(m.ctx, d)
-		go monitor.CaptureRuntimeDiskStats(m.ctx, d)
-		go monitor.CaptureRuntimeNetStats(m.ctx, d)
+	m.startSamplers(m.cfg)
+	if cm, err := m.cc.ConfigManager(); err == nil {
+		m.cfgSubID = cm.Subscribe(func(_, cur *config.Config) {
+			m.startSamplers(cur)
+		}, config.SectionMetrics)
+	}
+
+	prom := m.cfg.Metrics.Prometheus
+	if prom != nil && prom.Enable {
+		logger := log.NewLogger("prometheus")
+		if err := prometheus.Prometheus(m.ctx, prom.ListenAddress, prom.Path, func(err error) {
+			logger.Error(err)
+		}, monitor.SystemRegistry, monitor.PerformanceRegistry); err != nil {
+			return err
+		}
+	}
+
+	if t := m.cfg.Metrics.Tracing; t != nil && t.Enable {
+		var exporter tracing.Exporter
+		switch t.Exporter {
+		case config.TracingExporterFile:
+			file := t.File
+			if !filepath.IsAbs(file) {
+				file = filepath.Join(m.cfg.DataDir, file)
+			}
+			e, err := tracing.NewFileExporter(file)
+			if err != nil {
+				return err
+			}
+			exporter = e
+		case config.TracingExporterOTLP:
+			exporter = tracing.NewOTLPExporter(t.Endpoint)
+		default:
+			return fmt.Errorf("invalid tracing exporter: %s", t.Exporter)
+		}
+		logger := log.NewLogger("tracing")
+		m.tracer = tracing.NewTracer(exporter, func(err error) {
+			logger.Error(err)
+		})
+		tracing.SetTracer(m.tracer)
+	}
+
+	return nil
+}
+
+// startSamplers (re)starts the metrics samplers and the influxdb reporter with the configured intervals
+func (m *MetricsService) startSamplers(cfg *config.Config) {
+	m.samplerLock.Lock()
+	defer m.samplerLock.Unlock()
+
+	if m.samplerCancel != nil {
+		m.samplerCancel()
+	}
+	ctx2, cancel := context.WithCancel(m.ctx)
+	m.samplerCancel = cancel
+
+	if cfg.Metrics.Enable {
+		d := time.Second * time.Duration(cfg.Metrics.SampleInterval)
+		go monitor.CaptureRuntimeCPUStats(ctx2, d)
+		go monitor.CaptureRuntimeDiskStats(ctx2, d)
+		go monitor.CaptureRuntimeNetStats(ctx2, d)
+		go m.captureChainStats(ctx2, d)
 	}
 
-	influx := m.cfg.Metrics.Influx
+	influx := cfg.Metrics.Influx
 	if influx != nil && influx.Enable && len(influx.URL) > 0 && len(influx.Database) > 0 {
-		go influxdb.InfluxDB(m.ctx,
+		go influxdb.InfluxDB(ctx2,
 			monitor.SystemRegistry,                     // metrics registry
 			time.Second*time.Duration(influx.Interval), // interval
 			influx.URL,      // the InfluxDB url
@@ -70,8 +144,45 @@ func (m *MetricsService) Start() error {
 			influx.Password, // your InfluxDB password
 		)
 	}
+}
 
-	return nil
+// captureChainStats samples the state of the ledger and the network into the system registry at each d interval
+func (m *MetricsService) captureChainStats(ctx2 context.Context, d time.Duration) {
+	var (
+		povHeight      = metrics.GetOrRegisterGauge("chain.pov.height", monitor.SystemRegistry)
+		connectedPeers = metrics.GetOrRegisterGauge("chain.peers.connected", monitor.SystemRegistry)
+		onlinePeers    = metrics.GetOrRegisterGauge("chain.peers.online", monitor.SystemRegistry)
+		p2pSyncState   = metrics.GetOrRegisterGauge("chain.sync.p2p", monitor.SystemRegistry)
+		povSyncState   = metrics.GetOrRegisterGauge("chain.sync.pov", monitor.SystemRegistry)
+		lsmSize        = metrics.GetOrRegisterGauge("ledger.size.lsm", monitor.SystemRegistry)
+		vlogSize       = metrics.GetOrRegisterGauge("ledger.size.vlog", monitor.SystemRegistry)
+	)
+
+	l := ledger.NewLedger(m.cc.ConfigFile())
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+
+	for {
+		connectedPeers.Update(int64(len(m.cc.GetConnectPeersInfo())))
+		onlinePeers.Update(int64(len(m.cc.GetOnlinePeersInfo())))
+		p2pSyncState.Update(int64(m.cc.P2PSyncState()))
+		povSyncState.Update(int64(m.cc.PoVState()))
+		if header, err := l.GetLatestPovHeader(); err == nil {
+			povHeight.Update(int64(header.GetHeight()))
+		}
+		if r, err := l.Action(storage.Size, 0); err == nil {
+			if size, ok := r.(map[string]int64); ok {
+				lsmSize.Update(size["lsm"])
+				vlogSize.Update(size["vlog"])
+			}
+		}
+
+		select {
+		case <-ctx2.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
 
 func (m *MetricsService) Stop() error {
@@ -80,7 +191,16 @@ func (m *MetricsService) Stop() error {
 	}
 	defer m.PostStop()
 
+	if cm, err := m.cc.ConfigManager(); err == nil {
+		cm.Unsubscribe(m.cfgSubID)
+	}
 	m.cancel()
+	if m.tracer != nil {
+		tracing.SetTracer(nil)
+		if err := m.tracer.Close(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -8,13 +8,20 @@
 package chain
 
 import (
+	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 )
 
 func TestMetricsService(t *testing.T) {
@@ -46,3 +53,89 @@ func TestMetricsService(t *testing.T) {
 		t.Fatal("metrics stop failed.")
 	}
 }
+
+func TestMetricsService_Prometheus(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	cfg, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cfg.Metrics.Enable = true
+	cfg.Metrics.Prometheus.Enable = true
+	cfg.Metrics.Prometheus.ListenAddress = "tcp://127.0.0.1:19748"
+	if err := cm.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	ms := NewMetricsService(cm.ConfigFile)
+	if err := ms.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ms.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = ms.Stop()
+		_ = ledger.NewLedger(cm.ConfigFile).Close()
+	}()
+
+	var body string
+	for i := 0; i < 20; i++ {
+		if resp, err := http.Get("http://127.0.0.1:19748/metrics"); err == nil {
+			b, _ := ioutil.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			body = string(b)
+			if strings.Contains(body, "qlc_system_ledger_size_lsm") {
+				break
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	for _, name := range []string{"qlc_system_chain_peers_connected", "qlc_system_chain_sync_pov", "qlc_system_ledger_size_vlog",
+		"qlc_system_runtime_MemStats_Alloc"} {
+		if !strings.Contains(body, name) {
+			t.Fatalf("%s not found in %s", name, body)
+		}
+	}
+}
+
+func TestMetricsService_Tracing(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	cfg, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cfg.Metrics.Tracing.Enable = true
+	if err := cm.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	ms := NewMetricsService(cm.ConfigFile)
+	if err := ms.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ms.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if !tracing.Enabled() {
+		t.Fatal("tracing should be enabled")
+	}
+	tracing.StartBlock(types.ZeroHash, "test").End()
+	if err := ms.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if tracing.Enabled() {
+		t.Fatal("tracing should be disabled")
+	}
+	if fi, err := os.Stat(filepath.Join(cfg.DataDir, cfg.Metrics.Tracing.File)); err != nil || fi.Size() == 0 {
+		t.Fatal("spans should be exported", err)
+	}
+}
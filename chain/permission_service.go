@@ -214,7 +214,11 @@ func (ps *PermissionService) addPermissionNode() {
 				blk.Work = worker.NewWork()
 
 				hash := blk.GetHash()
-				blk.Signature = ps.account.Sign(hash)
+				blk.Signature, err = ps.account.SignHash(hash)
+				if err != nil {
+					ps.logger.Error(err)
+					continue
+				}
 				ps.blkHash = append(ps.blkHash, hash)
 
 				var h types.Hash
@@ -17,6 +17,9 @@ import (
 	"github.com/qlcchain/go-qlc/log"
 )
 
+// Verify that *PoVService implements InterceptCall
+var _ common.InterceptCall = (*PoVService)(nil)
+
 type PoVService struct {
 	common.ServiceLifecycle
 	povEngine *pov.PoVEngine
@@ -64,3 +67,7 @@ func (pov *PoVService) Stop() error {
 func (pov *PoVService) Status() int32 {
 	return pov.State()
 }
+
+func (pov *PoVService) RpcCall(kind uint, in, out interface{}) {
+	pov.povEngine.RPC(kind, in, out)
+}
@@ -19,5 +19,6 @@ func addcommands() {
 	addPrivacyCmd()
 	addPtmKeyCmd()
 	addDoDSettlementCmd()
+	addSettlementCmd()
 	addKYCCmd()
 }
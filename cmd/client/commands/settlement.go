@@ -0,0 +1,33 @@
+// +build testnet
+
+package commands
+
+import (
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+)
+
+func addSettlementCmd() {
+	if interactive {
+		cmd := &ishell.Cmd{
+			Name: "settlement",
+			Help: "settlement commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(cmd)
+
+		addSettlementImportCDRByIshell(cmd)
+	} else {
+		var cmd = &cobra.Command{
+			Use:   "settlement",
+			Short: "settlement commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(cmd)
+
+		addSettlementImportCDRByCobra(cmd)
+	}
+}
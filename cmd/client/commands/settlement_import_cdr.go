@@ -0,0 +1,190 @@
+// +build testnet
+
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/abiosoft/ishell"
+	rpc "github.com/qlcchain/jsonrpc2"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain/cdrimport"
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	cutil "github.com/qlcchain/go-qlc/common/util"
+)
+
+func addSettlementImportCDRByIshell(parentCmd *ishell.Cmd) {
+	account := util.Flag{
+		Name:  "account",
+		Must:  true,
+		Usage: "private key hex string of the account which uploads CDRs",
+		Value: "",
+	}
+	file := util.Flag{
+		Name:  "file",
+		Must:  true,
+		Usage: "csv or jsonl file of CDRs",
+		Value: "",
+	}
+	format := util.Flag{
+		Name:  "format",
+		Must:  false,
+		Usage: "file format (csv/jsonl), decided by the file extension if empty",
+		Value: "",
+	}
+	mapping := util.Flag{
+		Name:  "mapping",
+		Must:  false,
+		Usage: "CDR fields to columns, such as index=id,smsDt=date, fields without mapping use their own names",
+		Value: "",
+	}
+	batchSize := util.Flag{
+		Name:  "batchSize",
+		Must:  false,
+		Usage: "max count of CDRs in a block",
+		Value: 100,
+	}
+	maxPending := util.Flag{
+		Name:  "maxPending",
+		Must:  false,
+		Usage: "max count of unconfirmed blocks",
+		Value: 10,
+	}
+	journal := util.Flag{
+		Name:  "journal",
+		Must:  false,
+		Usage: "progress journal to resume the import, file.journal if empty",
+		Value: "",
+	}
+
+	args := []util.Flag{account, file, format, mapping, batchSize, maxPending, journal}
+	cmd := &ishell.Cmd{
+		Name:                "import-cdr",
+		Help:                "import CDRs from a csv or jsonl file",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+
+			batchSizeP, err := util.IntVar(c.Args, batchSize)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+			maxPendingP, err := util.IntVar(c.Args, maxPending)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+
+			if err := importCDR(util.StringVar(c.Args, account), util.StringVar(c.Args, file), util.StringVar(c.Args, format),
+				util.StringVar(c.Args, mapping), batchSizeP, maxPendingP, util.StringVar(c.Args, journal)); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(cmd)
+}
+
+func addSettlementImportCDRByCobra(parentCmd *cobra.Command) {
+	var accountP, fileP, formatP, mappingP, journalP string
+	var batchSizeP, maxPendingP int
+	var cmd = &cobra.Command{
+		Use:   "import-cdr",
+		Short: "import CDRs from a csv or jsonl file",
+		Run: func(cmd *cobra.Command, args []string) {
+			if accountP == "" || fileP == "" {
+				cmd.Println("account and file are required")
+				return
+			}
+			if err := importCDR(accountP, fileP, formatP, mappingP, batchSizeP, maxPendingP, journalP); err != nil {
+				cmd.Println(err)
+				return
+			}
+		},
+	}
+	cmd.Flags().StringVar(&accountP, "account", "", "private key hex string of the account which uploads CDRs")
+	cmd.Flags().StringVar(&fileP, "file", "", "csv or jsonl file of CDRs")
+	cmd.Flags().StringVar(&formatP, "format", "", "file format (csv/jsonl), decided by the file extension if empty")
+	cmd.Flags().StringVar(&mappingP, "mapping", "", "CDR fields to columns, such as index=id,smsDt=date, fields without mapping use their own names")
+	cmd.Flags().IntVar(&batchSizeP, "batchSize", 100, "max count of CDRs in a block")
+	cmd.Flags().IntVar(&maxPendingP, "maxPending", 10, "max count of unconfirmed blocks")
+	cmd.Flags().StringVar(&journalP, "journal", "", "progress journal to resume the import, file.journal if empty")
+	parentCmd.AddCommand(cmd)
+}
+
+func parseCDRMapping(s string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid mapping %s, should be field=column", item)
+		}
+		mapping[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return mapping, nil
+}
+
+func importCDR(accountP, fileP, formatP, mappingP string, batchSizeP, maxPendingP int, journalP string) error {
+	accBytes, err := hex.DecodeString(accountP)
+	if err != nil {
+		return err
+	}
+	acc := types.NewAccount(accBytes)
+	if acc == nil {
+		return fmt.Errorf("account format err")
+	}
+
+	mapping, err := parseCDRMapping(mappingP)
+	if err != nil {
+		return err
+	}
+
+	client, err := rpc.Dial(endpointP)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	submitter, err := cdrimport.NewRPCSubmitter(client, acc)
+	if err != nil {
+		return err
+	}
+	importer, err := cdrimport.NewImporter(submitter, &cdrimport.Options{
+		Format:     formatP,
+		Mapping:    mapping,
+		BatchSize:  batchSizeP,
+		MaxPending: maxPendingP,
+		Journal:    journalP,
+	})
+	if err != nil {
+		return err
+	}
+
+	progress, err := importer.Import(context.Background(), fileP)
+	if progress != nil {
+		fmt.Println(cutil.ToIndentString(progress))
+	}
+	return err
+}
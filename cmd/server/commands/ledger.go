@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+)
+
+func addLedgerCmd() {
+	if interactive {
+		ledgerCmd := &ishell.Cmd{
+			Name: "ledger",
+			Help: "ledger maintenance commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(ledgerCmd)
+		addLedgerPruneCmdByShell(ledgerCmd)
+		addLedgerGCCmdByShell(ledgerCmd)
+	} else {
+		ledgerCmd := &cobra.Command{
+			Use:   "ledger",
+			Short: "ledger maintenance commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(ledgerCmd)
+		addLedgerPruneCmdByCobra(ledgerCmd)
+		addLedgerGCCmdByCobra(ledgerCmd)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"errors"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+func addLedgerGCCmdByShell(parentCmd *ishell.Cmd) {
+	keep := cmdutil.Flag{
+		Name:  "keep",
+		Must:  false,
+		Usage: "number of latest pov state roots to keep, default is the value in config",
+		Value: 0,
+	}
+	args := []cmdutil.Flag{keep}
+	s := &ishell.Cmd{
+		Name:                "gc",
+		Help:                "remove pov state trie nodes unreachable from the kept state roots",
+		CompleterWithPrefix: cmdutil.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if cmdutil.HelpText(c, args) {
+				return
+			}
+			if err := cmdutil.CheckArgs(c, args); err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			keepP, err := cmdutil.IntVar(c.Args, keep)
+			if err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			if err := gcLedgerAction(keepP); err != nil {
+				cmdutil.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addLedgerGCCmdByCobra(parentCmd *cobra.Command) {
+	var keepP int
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "remove pov state trie nodes unreachable from the kept state roots",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := gcLedgerAction(keepP); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	cmd.Flags().IntVarP(&keepP, "keep", "k", 0, "number of latest pov state roots to keep, default is the value in config")
+	parentCmd.AddCommand(cmd)
+}
+
+func gcLedgerAction(keep int) error {
+	if keep < 0 {
+		return errors.New("invalid keep value")
+	}
+
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		return err
+	}
+	cfg, err := cm.Config()
+	if err != nil {
+		return err
+	}
+	if keep == 0 && cfg.PoV != nil && cfg.PoV.StateGC != nil {
+		keep = int(cfg.PoV.StateGC.KeepRoots)
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+	cmdutil.Info("starting to collect state trie garbage, keep", keep, "state roots, please wait...")
+
+	ledgerService := chain.NewLedgerService(cm.ConfigFile)
+	defer ledger.CloseLedger()
+
+	r, err := statedb.NewPovStateGC(ledgerService.Ledger, uint64(keep), false).Run(nil)
+	if err != nil {
+		return err
+	}
+
+	cmdutil.Info("roots", r.Roots, "reachable nodes", r.Reachable, "missing nodes", r.Missing)
+	cmdutil.Info("scanned", r.Scanned, "keys, removed", r.Removed, "keys, reclaimed", r.ReclaimedBytes, "bytes in", r.Duration)
+	cmdutil.Info("finished to collect state trie garbage.")
+	return nil
+}
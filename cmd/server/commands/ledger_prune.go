@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"errors"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+func addLedgerPruneCmdByShell(parentCmd *ishell.Cmd) {
+	keep := cmdutil.Flag{
+		Name:  "keep",
+		Must:  false,
+		Usage: "number of latest pov heights to keep, default is the value in config",
+		Value: 0,
+	}
+	args := []cmdutil.Flag{keep}
+	s := &ishell.Cmd{
+		Name:                "prune",
+		Help:                "prune account-chain blocks older than the kept pov heights",
+		CompleterWithPrefix: cmdutil.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if cmdutil.HelpText(c, args) {
+				return
+			}
+			if err := cmdutil.CheckArgs(c, args); err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			keepP, err := cmdutil.IntVar(c.Args, keep)
+			if err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			if err := pruneLedgerAction(keepP); err != nil {
+				cmdutil.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addLedgerPruneCmdByCobra(parentCmd *cobra.Command) {
+	var keepP int
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "prune account-chain blocks older than the kept pov heights",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := pruneLedgerAction(keepP); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	cmd.Flags().IntVarP(&keepP, "keep", "k", 0, "number of latest pov heights to keep, default is the value in config")
+	parentCmd.AddCommand(cmd)
+}
+
+func pruneLedgerAction(keep int) error {
+	if keep < 0 {
+		return errors.New("invalid keep value")
+	}
+
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		return err
+	}
+	cfg, err := cm.Config()
+	if err != nil {
+		return err
+	}
+	if keep == 0 && cfg.DB != nil && cfg.DB.Prune != nil {
+		keep = int(cfg.DB.Prune.KeepHeights)
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+	cmdutil.Info("starting to prune ledger, keep", keep, "pov heights, please wait...")
+
+	ledgerService := chain.NewLedgerService(cm.ConfigFile)
+	defer ledger.CloseLedger()
+
+	r, err := ledgerService.Ledger.PruneBlocks(uint64(keep))
+	if err != nil {
+		return err
+	}
+
+	cmdutil.Info("pov height", r.PovHeight, "pruned height", r.PrunedHeight)
+	cmdutil.Info("scanned", r.Scanned, "blocks, pruned", r.Pruned, "blocks")
+	cmdutil.Info("finished to prune ledger.")
+	return nil
+}
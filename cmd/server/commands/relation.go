@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+func addRelationCmd() {
+	if interactive {
+		relationCmd := &ishell.Cmd{
+			Name: "relation",
+			Help: "relation index commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(relationCmd)
+		addRelationRebuildCmdByShell(relationCmd)
+	} else {
+		relationCmd := &cobra.Command{
+			Use:   "relation",
+			Short: "relation index commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(relationCmd)
+		addRelationRebuildCmdByCobra(relationCmd)
+	}
+}
+
+func addRelationRebuildCmdByShell(parentCmd *ishell.Cmd) {
+	s := &ishell.Cmd{
+		Name: "rebuild",
+		Help: "empty the relation index and index all blocks of the ledger again",
+		Func: func(c *ishell.Context) {
+			if err := rebuildRelationAction(); err != nil {
+				cmdutil.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addRelationRebuildCmdByCobra(parentCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "empty the relation index and index all blocks of the ledger again",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := rebuildRelationAction(); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	parentCmd.AddCommand(cmd)
+}
+
+func rebuildRelationAction() error {
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		return err
+	}
+	cfg, err := cm.Config()
+	if err != nil {
+		return err
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+	cmdutil.Info("Driver", cfg.DB.Driver)
+	cmdutil.Info("starting to rebuild relation index, please wait...")
+
+	ledgerService := chain.NewLedgerService(cm.ConfigFile)
+	defer ledger.CloseLedger()
+
+	count, err := ledgerService.Ledger.RebuildRelation()
+	if err != nil {
+		return err
+	}
+
+	cmdutil.Info("indexed", count, "blocks")
+	cmdutil.Info("finished to rebuild relation index.")
+	return nil
+}
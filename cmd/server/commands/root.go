@@ -34,6 +34,7 @@ import (
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/wallet"
+	"github.com/qlcchain/go-qlc/wallet/signer"
 )
 
 var (
@@ -127,6 +128,9 @@ func addCommand() {
 	chainVersion()
 	removeDB()
 	purgePov()
+	addSnapshotCmd()
+	addLedgerCmd()
+	addRelationCmd()
 }
 
 func start() error {
@@ -209,12 +213,14 @@ func start() error {
 		}()
 
 		if b, err := session.VerifyPassword(passwordP); b && err == nil {
-			bytes, err := session.GetSeed()
-			tmp, err := seedToAccounts(bytes)
+			// the private keys are derived from the wallet seed only when signing
+			signers, err := session.Signers(maxAccountSize)
 			if err != nil {
 				return err
 			}
-			accounts = append(accounts, tmp...)
+			for _, s := range signers {
+				accounts = append(accounts, types.NewAccountWithSigner(s))
+			}
 		} else {
 			return fmt.Errorf("invalid wallet password of %s", accountP)
 		}
@@ -222,6 +228,16 @@ func start() error {
 		log.Root.Info("run node without account")
 	}
 
+	// accounts whose private keys are kept by the remote signer
+	if cfg, _ := cm.Config(); cfg.Signer != nil && cfg.Signer.Enable {
+		log.Root.Info("run node with REMOTE SIGNER ", cfg.Signer.Endpoint)
+		tmp, err := signer.NewAccounts(cfg.Signer)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, tmp...)
+	}
+
 	if isProfileP {
 		go func() {
 			//view result in http://localhost:6060/debug/pprof/
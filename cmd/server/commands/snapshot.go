@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+)
+
+func addSnapshotCmd() {
+	if interactive {
+		snapshotCmd := &ishell.Cmd{
+			Name: "snapshot",
+			Help: "ledger snapshot commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(snapshotCmd)
+		addSnapshotExportCmdByShell(snapshotCmd)
+		addSnapshotImportCmdByShell(snapshotCmd)
+	} else {
+		snapshotCmd := &cobra.Command{
+			Use:   "snapshot",
+			Short: "ledger snapshot commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(snapshotCmd)
+		addSnapshotExportCmdByCobra(snapshotCmd)
+		addSnapshotImportCmdByCobra(snapshotCmd)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+func addSnapshotExportCmdByShell(parentCmd *ishell.Cmd) {
+	file := cmdutil.Flag{
+		Name:  "file",
+		Must:  false,
+		Usage: "snapshot file, default is under the snapshot folder of data dir",
+		Value: "",
+	}
+	args := []cmdutil.Flag{file}
+	s := &ishell.Cmd{
+		Name:                "export",
+		Help:                "export ledger snapshot of a stopped node, use debug_exportSnapshot on a running node",
+		CompleterWithPrefix: cmdutil.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if cmdutil.HelpText(c, args) {
+				return
+			}
+			if err := cmdutil.CheckArgs(c, args); err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			fileP := cmdutil.StringVar(c.Args, file)
+			if err := exportSnapshotAction(fileP); err != nil {
+				cmdutil.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addSnapshotExportCmdByCobra(parentCmd *cobra.Command) {
+	var fileP string
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export ledger snapshot of a stopped node, use debug_exportSnapshot on a running node",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := exportSnapshotAction(fileP); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&fileP, "file", "f", "", "snapshot file, default is under the snapshot folder of data dir")
+	parentCmd.AddCommand(cmd)
+}
+
+func exportSnapshotAction(file string) error {
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		return err
+	}
+	cfg, err := cm.Config()
+	if err != nil {
+		return err
+	}
+
+	if file == "" {
+		file = ledger.DefaultSnapshotFile(cfg.DataDir)
+		if err := util.CreateDirIfNotExist(filepath.Dir(file)); err != nil {
+			return err
+		}
+	}
+	if _, err := os.Stat(file); err == nil {
+		return fmt.Errorf("snapshot file %s already exists", file)
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+	cmdutil.Info("starting to export snapshot, please wait...")
+
+	ledgerService := chain.NewLedgerService(cm.ConfigFile)
+	defer ledger.CloseLedger()
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	info, err := ledgerService.Ledger.ExportSnapshot(f)
+	if e := f.Close(); e != nil && err == nil {
+		err = e
+	}
+	if err != nil {
+		_ = os.Remove(file)
+		return err
+	}
+
+	cmdutil.Info("snapshot file", file)
+	cmdutil.Info("pov height", info.PovHeight, "pov hash", info.PovHash, "state hash", info.StateHash)
+	cmdutil.Info("records", info.Records, "checksum", info.Checksum)
+	cmdutil.Info("finished to export snapshot.")
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+func addSnapshotImportCmdByShell(parentCmd *ishell.Cmd) {
+	file := cmdutil.Flag{
+		Name:  "file",
+		Must:  true,
+		Usage: "snapshot file",
+		Value: "",
+	}
+	args := []cmdutil.Flag{file}
+	s := &ishell.Cmd{
+		Name:                "import",
+		Help:                "import ledger snapshot into an empty ledger",
+		CompleterWithPrefix: cmdutil.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if cmdutil.HelpText(c, args) {
+				return
+			}
+			if err := cmdutil.CheckArgs(c, args); err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			fileP := cmdutil.StringVar(c.Args, file)
+			if err := importSnapshotAction(fileP); err != nil {
+				cmdutil.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addSnapshotImportCmdByCobra(parentCmd *cobra.Command) {
+	var fileP string
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "import ledger snapshot into an empty ledger",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := importSnapshotAction(fileP); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&fileP, "file", "f", "", "snapshot file")
+	parentCmd.AddCommand(cmd)
+}
+
+func importSnapshotAction(file string) error {
+	if file == "" {
+		return errors.New("invalid snapshot file")
+	}
+
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		return err
+	}
+	cfg, err := cm.Config()
+	if err != nil {
+		return err
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+	cmdutil.Info("verifying snapshot, please wait...")
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	info, err := ledger.VerifySnapshot(f)
+	_ = f.Close()
+	if err != nil {
+		return err
+	}
+	cmdutil.Info("pov height", info.PovHeight, "records", info.Records, "checksum", info.Checksum)
+
+	cmdutil.Info("starting to import snapshot, please wait...")
+	ledgerService := chain.NewLedgerService(cm.ConfigFile)
+	defer ledger.CloseLedger()
+	l := ledgerService.Ledger
+
+	if f, err = os.Open(file); err != nil {
+		return err
+	}
+	_, err = l.ImportSnapshot(f)
+	_ = f.Close()
+	if err != nil {
+		return err
+	}
+
+	if err := verifyImportedSnapshot(l, info); err != nil {
+		cmdutil.Warn("verify imported snapshot failed, drop all data in ledger ...")
+		if e := l.DBStore().Drop(nil); e != nil {
+			cmdutil.Warn(e)
+		}
+		if e := l.EmptyRelation(); e != nil {
+			cmdutil.Warn(e)
+		}
+		return err
+	}
+
+	cmdutil.Info("finished to import snapshot.")
+	return nil
+}
+
+func verifyImportedSnapshot(l *ledger.Ledger, info *ledger.SnapshotInfo) error {
+	cmdutil.Info("verifying frontiers ...")
+	if err := l.VerifyFrontiers(); err != nil {
+		return fmt.Errorf("verify frontiers: %s", err)
+	}
+
+	if info.PovHash.IsZero() {
+		return nil
+	}
+	cmdutil.Info("verifying pov state", info.StateHash, "at height", info.PovHeight)
+	header, err := l.GetPovHeaderByHeight(info.PovHeight)
+	if err != nil {
+		return fmt.Errorf("get pov header: %s", err)
+	}
+	if header.GetHash() != info.PovHash || header.GetStateHash() != info.StateHash {
+		return fmt.Errorf("pov header %d mismatch", info.PovHeight)
+	}
+	stateHash := header.GetStateHash()
+	t := trie.NewTrie(l.DBStore(), &stateHash, nil)
+	if h := t.Hash(); h == nil || *h != stateHash {
+		return errors.New("pov state root mismatch")
+	}
+	if err := t.Verify(); err != nil {
+		return fmt.Errorf("verify pov state: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// signer runs the remote signer, it keeps the private keys out of the node process and signs for the
+// node by the protocol of package wallet/signer. Secrets are read from the environment:
+//
+//	QLC_SIGNER_TOKEN     token required from the node
+//	QLC_SIGNER_SEED      hex seed, the first accounts of the seed are served
+//	QLC_SIGNER_KEY       hex private key
+//	QLC_SIGNER_PASSWORD  password of the wallet given by --wallet
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/wallet"
+	"github.com/qlcchain/go-qlc/wallet/signer"
+)
+
+var logger = log.NewLogger("signer")
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:9739", "listen address")
+	certFile := flag.String("cert", "", "TLS certificate file")
+	keyFile := flag.String("key", "", "TLS key file")
+	walletAddr := flag.String("wallet", "", "wallet address, the accounts of the wallet seed are served")
+	cfgPath := flag.String("config", config.DefaultDataDir(), "data dir of the wallet")
+	count := flag.Int("count", 1, "number of accounts of the seed")
+	flag.Parse()
+
+	seed, err := loadSeed(*walletAddr, *cfgPath)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	var signers []types.Signer
+	if len(seed) > 0 {
+		s, err := types.BytesToSeed(seed)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		for i := 0; i < *count; i++ {
+			acc, err := s.Account(uint32(i))
+			if err != nil {
+				logger.Fatal(err)
+			}
+			signers = append(signers, acc)
+		}
+	}
+	if key := os.Getenv("QLC_SIGNER_KEY"); key != "" {
+		prk, err := hex.DecodeString(key)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		signers = append(signers, types.NewAccount(prk))
+	}
+	if len(signers) == 0 {
+		logger.Fatal("no account to serve")
+	}
+	for _, s := range signers {
+		logger.Infof("serving %s", s.Address())
+	}
+
+	token := os.Getenv("QLC_SIGNER_TOKEN")
+	if token == "" {
+		logger.Warn("QLC_SIGNER_TOKEN is empty, requests are not authenticated")
+	}
+	server := &http.Server{Addr: *listen, Handler: signer.NewServer(token, signers...)}
+	logger.Infof("remote signer listening on %s", *listen)
+	if *certFile != "" {
+		err = server.ListenAndServeTLS(*certFile, *keyFile)
+	} else {
+		err = server.ListenAndServe()
+	}
+	logger.Fatal(err)
+}
+
+func loadSeed(walletAddr, cfgPath string) ([]byte, error) {
+	if walletAddr == "" {
+		if seed := os.Getenv("QLC_SIGNER_SEED"); seed != "" {
+			return hex.DecodeString(seed)
+		}
+		return nil, nil
+	}
+
+	address, err := types.HexToAddress(walletAddr)
+	if err != nil {
+		return nil, err
+	}
+	cm := config.NewCfgManager(cfgPath)
+	if _, err := cm.Load(); err != nil {
+		return nil, err
+	}
+	w := wallet.NewWalletStore(cm.ConfigFile)
+	defer func() {
+		_ = w.Close()
+	}()
+	session := w.NewSession(address)
+	if b, err := session.VerifyPassword(os.Getenv("QLC_SIGNER_PASSWORD")); !b || err != nil {
+		return nil, errors.New("invalid wallet password")
+	}
+	return session.GetSeed()
+}
@@ -9,4 +9,6 @@ const (
 	RpcDPoSGetConsPerf
 	RpcDPoSFeed
 	RpcDPoSDebug
+	RpcDPoSConfirmDepth
+	RpcPovLightState
 )
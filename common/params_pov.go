@@ -68,9 +68,13 @@ var (
 	PovMaxCoinbaseExtraSize = 100
 )
 
+// PovIsAlgoSupported reports the algos which can be mined and verified,
+// X16R is deferred until its Hamsi, Fugue and Shabal stages are implemented
 func PovIsAlgoSupported(algoType types.PovAlgoType) bool {
 	if algoType == types.ALGO_SHA256D ||
 		algoType == types.ALGO_SCRYPT ||
+		algoType == types.ALGO_NIST5 ||
+		algoType == types.ALGO_LYRA2Z ||
 		algoType == types.ALGO_X11 {
 		return true
 	}
@@ -28,6 +28,24 @@ func TestPovIsAlgoSupported(t *testing.T) {
 				algoType: types.ALGO_SHA256D,
 			},
 			want: true,
+		}, {
+			name: "nist5",
+			args: args{
+				algoType: types.ALGO_NIST5,
+			},
+			want: true,
+		}, {
+			name: "lyra2z",
+			args: args{
+				algoType: types.ALGO_LYRA2Z,
+			},
+			want: true,
+		}, {
+			name: "x16r",
+			args: args{
+				algoType: types.ALGO_X16R,
+			},
+			want: false,
 		}, {
 			name: "f",
 			args: args{
@@ -0,0 +1,102 @@
+package statedb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+var ErrStateGCKeep = errors.New("state gc keep roots must be greater than zero")
+
+// PovStateGC removes trie nodes which are neither reachable from the state roots of the
+// latest `keep` PoV heights (side chains included) nor from the extra of a contract block
+type PovStateGC struct {
+	l         ledger.Store
+	keep      uint64
+	collector *trie.Collector
+}
+
+// NewPovStateGC creates a state gc, online gc only removes nodes which stay unreachable in two rounds
+func NewPovStateGC(l ledger.Store, keep uint64, online bool) *PovStateGC {
+	return &PovStateGC{
+		l:         l,
+		keep:      keep,
+		collector: trie.NewCollector(l.DBStore(), online),
+	}
+}
+
+// Run marks and sweeps one round, locker pauses the trie writers for the whole round and
+// the ledger cache is flushed so that roots of blocks processed before the round are marked
+func (g *PovStateGC) Run(locker sync.Locker) (*trie.GCResult, error) {
+	if g.keep == 0 {
+		return nil, ErrStateGCKeep
+	}
+	if locker != nil {
+		locker.Lock()
+		defer locker.Unlock()
+	}
+	if err := g.l.Flush(); err != nil {
+		return nil, fmt.Errorf("flush ledger cache: %s", err)
+	}
+	latest, err := g.l.GetPovLatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("get pov latest height: %s", err)
+	}
+	start := uint64(0)
+	if latest+1 > g.keep {
+		start = latest + 1 - g.keep
+	}
+
+	db := g.l.DBStore()
+	for height := start; height <= latest; height++ {
+		prefix, err := storage.GetKeyOfParts(storage.KeyPrefixPovHeader, height)
+		if err != nil {
+			return nil, err
+		}
+		if err := db.Iterator(prefix, nil, func(key []byte, val []byte) error {
+			header := new(types.PovHeader)
+			if err := header.Deserialize(val); err != nil {
+				return fmt.Errorf("pov header deserialize: %s", err)
+			}
+			return g.markGlobalState(header.GetStateHash())
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// vm storage tries of contract blocks
+	for _, p := range []storage.KeyPrefix{storage.KeyPrefixBlock, storage.KeyPrefixBlockCache} {
+		prefix, _ := storage.GetKeyOfParts(p)
+		if err := db.Iterator(prefix, nil, func(key []byte, val []byte) error {
+			blk := new(types.StateBlock)
+			if err := blk.Deserialize(val); err != nil {
+				return fmt.Errorf("block deserialize: %s", err)
+			}
+			return g.collector.Mark(blk.GetExtra(), nil)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.collector.Sweep()
+}
+
+func (g *PovStateGC) markGlobalState(root types.Hash) error {
+	csPrefix := PovCreateGlobalStateKey(PovGlobalStatePrefixCS, nil)
+	return g.collector.Mark(root, func(key, value []byte) error {
+		if !bytes.HasPrefix(key, csPrefix) {
+			return nil
+		}
+		cs := types.NewPovContractState()
+		if err := cs.Deserialize(value); err != nil {
+			return fmt.Errorf("contract state deserialize: %s", err)
+		}
+		return g.collector.Mark(cs.StateHash, nil)
+	})
+}
@@ -0,0 +1,95 @@
+package statedb
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+func addPovStateGCBlock(t *testing.T, md *povStateDBMockData, prev *types.PovBlock, stateHash types.Hash) *types.PovBlock {
+	blk, td := mock.GeneratePovBlock(prev, 0)
+	blk.Header.CbTx.StateHash = stateHash
+	blk.Header.CbTx.Hash = blk.Header.CbTx.ComputeHash()
+	blk.Header.BasHdr.Hash = blk.ComputeHash()
+	if err := md.l.AddPovBlock(blk, td); err != nil {
+		t.Fatal(err)
+	}
+	if err := md.l.AddPovBestHash(blk.GetHeight(), blk.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	if err := md.l.SetPovLatestHeight(blk.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+	return blk
+}
+
+func commitPovStateGC(t *testing.T, md *povStateDBMockData, gsdb *PovGlobalStateDB) types.Hash {
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := md.l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := md.l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+	return gsdb.GetCurHash()
+}
+
+func TestPovStateGC_Run(t *testing.T) {
+	teardownTestCase, md := setupPovStateDBTestCase(t)
+	defer teardownTestCase(t)
+
+	if _, err := NewPovStateGC(md.l, 0, false).Run(nil); err != ErrStateGCKeep {
+		t.Fatal(err)
+	}
+
+	ac := mock.Address()
+	contract := mock.Address()
+	gsdb := NewPovGlobalStateDB(md.l.DBStore(), types.ZeroHash)
+	as := types.NewPovAccountState()
+	as.Balance = types.NewBalance(1)
+	if err := gsdb.SetAccountState(ac, as); err != nil {
+		t.Fatal(err)
+	}
+	if err := gsdb.SetContractValue(contract, []byte("key"), []byte("value1")); err != nil {
+		t.Fatal(err)
+	}
+	oldHash := commitPovStateGC(t, md, gsdb)
+	blk1 := addPovStateGCBlock(t, md, nil, oldHash)
+
+	gsdb = NewPovGlobalStateDB(md.l.DBStore(), oldHash)
+	as.Balance = types.NewBalance(2)
+	if err := gsdb.SetAccountState(ac, as); err != nil {
+		t.Fatal(err)
+	}
+	if err := gsdb.SetContractValue(contract, []byte("key"), []byte("value2")); err != nil {
+		t.Fatal(err)
+	}
+	curHash := commitPovStateGC(t, md, gsdb)
+	addPovStateGCBlock(t, md, blk1, curHash)
+
+	r, err := NewPovStateGC(md.l, 1, false).Run(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Removed == 0 || r.Missing != 0 {
+		t.Fatal(r)
+	}
+
+	if err := trie.NewTrie(md.l.DBStore(), &curHash, trie.NewSimpleTrieNodePool()).Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if err := trie.NewTrie(md.l.DBStore(), &oldHash, trie.NewSimpleTrieNodePool()).Verify(); err == nil {
+		t.Fatal("old state trie should be collected")
+	}
+
+	gsdb = NewPovGlobalStateDB(md.l.DBStore(), curHash)
+	val, err := gsdb.GetContractValue(contract, []byte("key"))
+	if err != nil || string(val) != "value2" {
+		t.Fatal(string(val), err)
+	}
+}
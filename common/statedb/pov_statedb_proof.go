@@ -0,0 +1,69 @@
+package statedb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+// PovProveState returns the proof of key in the global state trie of stateHash, if subKey is not nil,
+// key must be a contract state key and the proof of subKey in the contract state trie is returned too
+func PovProveState(db storage.Store, stateHash types.Hash, key []byte, subKey []byte) ([][]byte, [][]byte, error) {
+	proof, err := trie.NewTrie(db, &stateHash, nil).Prove(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if subKey == nil {
+		return proof, nil, nil
+	}
+
+	cs, err := povContractStateFromProof(stateHash, key, proof)
+	if err != nil {
+		return nil, nil, err
+	}
+	subProof, err := trie.NewTrie(db, &cs.StateHash, nil).Prove(subKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proof, subProof, nil
+}
+
+// PovVerifyState verifies the proofs returned by PovProveState against stateHash,
+// a nil value means the key does not exist
+func PovVerifyState(stateHash types.Hash, key []byte, subKey []byte, proof [][]byte, subProof [][]byte) ([]byte, error) {
+	if subKey == nil {
+		return trie.VerifyProof(stateHash, key, proof)
+	}
+
+	cs, err := povContractStateFromProof(stateHash, key, proof)
+	if err != nil {
+		return nil, err
+	}
+	return trie.VerifyProof(cs.StateHash, subKey, subProof)
+}
+
+func povContractStateFromProof(stateHash types.Hash, key []byte, proof [][]byte) (*types.PovContractState, error) {
+	if !bytes.HasPrefix(key, PovCreateGlobalStateKey(PovGlobalStatePrefixCS, nil)) {
+		return nil, errors.New("key is not a contract state key")
+	}
+	value, err := trie.VerifyProof(stateHash, key, proof)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, errors.New("contract state not exist")
+	}
+
+	cs := types.NewPovContractState()
+	if err := cs.Deserialize(value); err != nil {
+		return nil, fmt.Errorf("deserialize contract state err %s", err)
+	}
+	if cs.StateHash.IsZero() {
+		return nil, errors.New("contract state trie not exist")
+	}
+	return cs, nil
+}
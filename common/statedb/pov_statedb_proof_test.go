@@ -0,0 +1,60 @@
+package statedb
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestPovProveState(t *testing.T) {
+	teardownTestCase, md := setupPovStateDBTestCase(t)
+	defer teardownTestCase(t)
+
+	ac := mock.Address()
+	contract := mock.Address()
+	gsdb := NewPovGlobalStateDB(md.l.DBStore(), types.ZeroHash)
+	as := types.NewPovAccountState()
+	as.Balance = types.NewBalance(1)
+	if err := gsdb.SetAccountState(ac, as); err != nil {
+		t.Fatal(err)
+	}
+	if err := gsdb.SetContractValue(contract, []byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	stateHash := commitPovStateGC(t, md, gsdb)
+
+	asKey := PovCreateAccountStateKey(ac)
+	proof, subProof, err := PovProveState(md.l.DBStore(), stateHash, asKey, nil)
+	if err != nil || subProof != nil {
+		t.Fatal(err)
+	}
+	value, err := PovVerifyState(stateHash, asKey, nil, proof, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retAs := types.NewPovAccountState()
+	if err := retAs.Deserialize(value); err != nil || retAs.Balance.Compare(as.Balance) != types.BalanceCompEqual {
+		t.Fatal(retAs, err)
+	}
+	if _, err := PovVerifyState(mock.Hash(), asKey, nil, proof, nil); err == nil {
+		t.Fatal("proof should not match other state hash")
+	}
+
+	csKey := PovCreateContractStateKey(contract)
+	proof, subProof, err = PovProveState(md.l.DBStore(), stateHash, csKey, []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err = PovVerifyState(stateHash, csKey, []byte("key"), proof, subProof)
+	if err != nil || string(value) != "value" {
+		t.Fatal(string(value), err)
+	}
+	if value, err := PovVerifyState(stateHash, csKey, []byte("none"), proof, subProof); err == nil && value != nil {
+		t.Fatal("proof should not prove other key")
+	}
+
+	if _, _, err := PovProveState(md.l.DBStore(), stateHash, asKey, []byte("key")); err == nil {
+		t.Fatal("account state has no sub trie")
+	}
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+)
+
+type batchReader interface {
+	Get(k []byte) ([]byte, error)
+	Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error
+}
+
+type batchOp struct {
+	value  []byte
+	delete bool
+}
+
+// bufferedBatch keeps all changes in memory until the owner store applies them in one step,
+// reads go through the pending changes first and then fall back to the store
+type bufferedBatch struct {
+	owner   batchReader
+	canRead bool
+	ops     map[string]*batchOp
+}
+
+func newBufferedBatch(owner batchReader, canRead bool) *bufferedBatch {
+	return &bufferedBatch{
+		owner:   owner,
+		canRead: canRead,
+		ops:     make(map[string]*batchOp),
+	}
+}
+
+func (b *bufferedBatch) Put(k []byte, v interface{}) error {
+	val, ok := v.([]byte)
+	if !ok {
+		return errors.New("batch value should be bytes")
+	}
+	b.ops[string(k)] = &batchOp{value: copyBytes(val)}
+	return nil
+}
+
+func (b *bufferedBatch) Delete(k []byte) error {
+	b.ops[string(k)] = &batchOp{delete: true}
+	return nil
+}
+
+func (b *bufferedBatch) Get(k []byte) (interface{}, error) {
+	if !b.canRead {
+		return nil, errors.New("BatchWrite can write only")
+	}
+	if op, ok := b.ops[string(k)]; ok {
+		if op.delete {
+			return nil, storage.KeyNotFound
+		}
+		return copyBytes(op.value), nil
+	}
+	return b.owner.Get(k)
+}
+
+func (b *bufferedBatch) Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error {
+	if !b.canRead {
+		return errors.New("BatchWrite can write only")
+	}
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+
+	merged := make(map[string][]byte)
+	if err := b.owner.Iterator(prefix, end, func(k, v []byte) error {
+		merged[string(k)] = copyBytes(v)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for k, op := range b.ops {
+		if !inRange([]byte(k), prefix, end) {
+			continue
+		}
+		if op.delete {
+			delete(merged, k)
+		} else {
+			merged[k] = op.value
+		}
+	}
+
+	keys := make([]string, 0, len(merged))
+	for k := range merged {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := fn([]byte(k), merged[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *bufferedBatch) Drop(prefix []byte) error {
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+	if err := b.owner.Iterator(prefix, nil, func(k, v []byte) error {
+		return b.Delete(k)
+	}); err != nil {
+		return err
+	}
+	for k := range b.ops {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			b.ops[k] = &batchOp{delete: true}
+		}
+	}
+	return nil
+}
+
+func (b *bufferedBatch) Discard() {
+	b.ops = make(map[string]*batchOp)
+}
+
+// inRange follows the badger iterator rules: with a nil end all keys must carry the prefix,
+// otherwise iteration starts at prefix and stops before end
+func inRange(k, prefix, end []byte) bool {
+	if end == nil {
+		return bytes.HasPrefix(k, prefix)
+	}
+	return bytes.Compare(k, prefix) >= 0 && bytes.Compare(k, end) < 0
+}
+
+func copyBytes(src []byte) []byte {
+	if src == nil {
+		return nil
+	}
+	dst := make([]byte, len(src))
+	copy(dst, src)
+	return dst
+}
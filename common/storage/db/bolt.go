@@ -0,0 +1,220 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/util"
+)
+
+const boltFile = "qlc.bolt"
+
+var boltBucket = []byte("qlc")
+
+// BoltStore is a storage.Store backed by a single bbolt file, it has no value log to collect
+// and keeps a much smaller memory footprint than badger.
+type BoltStore struct {
+	db   *bolt.DB
+	file string
+}
+
+// NewBoltStore initializes/opens a bolt database in the given directory.
+func NewBoltStore(dir string) (storage.Store, error) {
+	if err := util.CreateDirIfNotExist(dir); err != nil {
+		return nil, err
+	}
+	file := filepath.Join(dir, boltFile)
+	db, err := bolt.Open(file, 0600, &bolt.Options{Timeout: 3 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	}); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &BoltStore{db: db, file: file}, nil
+}
+
+func (b *BoltStore) Get(k []byte) ([]byte, error) {
+	var v []byte
+	err := b.db.View(func(tx *bolt.Tx) error {
+		v = copyBytes(tx.Bucket(boltBucket).Get(k))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, storage.KeyNotFound
+	}
+	return v, nil
+}
+
+func (b *BoltStore) Put(k, v []byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).Put(k, v)
+	})
+}
+
+func (b *BoltStore) Delete(k []byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).Delete(k)
+	})
+}
+
+func (b *BoltStore) Has(k []byte) (bool, error) {
+	var r bool
+	err := b.db.View(func(tx *bolt.Tx) error {
+		r = tx.Bucket(boltBucket).Get(k) != nil
+		return nil
+	})
+	return r, err
+}
+
+// Batch buffers changes in memory, bolt allows only one writable transaction at a time
+// and callers are used to write through the store while a batch is open
+func (b *BoltStore) Batch(canRead bool) storage.Batch {
+	return newBufferedBatch(b, canRead)
+}
+
+func (b *BoltStore) PutBatch(batch storage.Batch) error {
+	if bb, ok := batch.(*bufferedBatch); ok && bb.owner == b {
+		return b.apply(bb)
+	}
+	return errors.New("error batch type")
+}
+
+func (b *BoltStore) BatchWrite(canRead bool, fn func(batch storage.Batch) error) error {
+	batch := newBufferedBatch(b, canRead)
+	if err := fn(batch); err != nil {
+		batch.Discard()
+		return err
+	}
+	return b.apply(batch)
+}
+
+func (b *BoltStore) apply(batch *bufferedBatch) error {
+	defer batch.Discard()
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		for k, op := range batch.ops {
+			if op.delete {
+				if err := bucket.Delete([]byte(k)); err != nil {
+					return err
+				}
+			} else {
+				if err := bucket.Put([]byte(k), op.value); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Iterator collects the matched pairs in a read transaction before calling fn,
+// so fn can write to the store without dead locking bolt
+func (b *BoltStore) Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error {
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	err := b.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(boltBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && inRange(k, prefix, end); k, v = c.Next() {
+			keys = append(keys, copyBytes(k))
+			values = append(values, copyBytes(v))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		if err := fn(keys[i], values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BoltStore) Count(prefix []byte) (uint64, error) {
+	var i uint64
+	err := b.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(boltBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			i++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return i, nil
+}
+
+func (b *BoltStore) Purge() error {
+	return nil
+}
+
+func (b *BoltStore) Drop(prefix []byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		if prefix == nil {
+			if err := tx.DeleteBucket(boltBucket); err != nil {
+				return err
+			}
+			_, err := tx.CreateBucket(boltBucket)
+			return err
+		}
+		bucket := tx.Bucket(boltBucket)
+		keys := make([][]byte, 0)
+		c := bucket.Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			keys = append(keys, copyBytes(k))
+		}
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Upgrade is a no-op, bolt stores never held the legacy unchecked format
+func (b *BoltStore) Upgrade(version int) error {
+	return nil
+}
+
+func (b *BoltStore) Action(at storage.ActionType) (interface{}, error) {
+	switch at {
+	case storage.GC:
+		return nil, nil
+	case storage.Size:
+		fi, err := os.Stat(b.file)
+		if err != nil {
+			return nil, err
+		}
+		s := make(map[string]int64)
+		s["lsm"] = fi.Size()
+		s["vlog"] = 0
+		return s, nil
+	default:
+		return "", errors.New("invalid action type")
+	}
+}
+
+func (b *BoltStore) Close() error {
+	return b.db.Close()
+}
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+)
+
+// MemoryStore is a non-persistent storage.Store, all data is lost after Close.
+type MemoryStore struct {
+	data map[string][]byte
+	lock sync.RWMutex
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() storage.Store {
+	return &MemoryStore{data: make(map[string][]byte)}
+}
+
+func (m *MemoryStore) Get(k []byte) ([]byte, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if v, ok := m.data[string(k)]; ok {
+		return copyBytes(v), nil
+	}
+	return nil, storage.KeyNotFound
+}
+
+func (m *MemoryStore) Put(k, v []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.data[string(k)] = copyBytes(v)
+	return nil
+}
+
+func (m *MemoryStore) Delete(k []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.data, string(k))
+	return nil
+}
+
+func (m *MemoryStore) Has(k []byte) (bool, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	_, ok := m.data[string(k)]
+	return ok, nil
+}
+
+func (m *MemoryStore) Batch(canRead bool) storage.Batch {
+	return newBufferedBatch(m, canRead)
+}
+
+func (m *MemoryStore) PutBatch(batch storage.Batch) error {
+	if bb, ok := batch.(*bufferedBatch); ok && bb.owner == m {
+		m.apply(bb)
+		return nil
+	}
+	return errors.New("error batch type")
+}
+
+func (m *MemoryStore) BatchWrite(canRead bool, fn func(batch storage.Batch) error) error {
+	b := newBufferedBatch(m, canRead)
+	if err := fn(b); err != nil {
+		b.Discard()
+		return err
+	}
+	m.apply(b)
+	return nil
+}
+
+func (m *MemoryStore) apply(b *bufferedBatch) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for k, op := range b.ops {
+		if op.delete {
+			delete(m.data, k)
+		} else {
+			m.data[k] = op.value
+		}
+	}
+	b.ops = make(map[string]*batchOp)
+}
+
+// Iterator walks a snapshot of the matched keys, so fn is free to modify the store
+func (m *MemoryStore) Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error {
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+
+	m.lock.RLock()
+	keys := make([]string, 0)
+	values := make(map[string][]byte)
+	for k, v := range m.data {
+		if inRange([]byte(k), prefix, end) {
+			keys = append(keys, k)
+			values[k] = v
+		}
+	}
+	m.lock.RUnlock()
+
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := fn([]byte(k), copyBytes(values[k])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) Count(prefix []byte) (uint64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	var i uint64
+	for k := range m.data {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			i++
+		}
+	}
+	return i, nil
+}
+
+func (m *MemoryStore) Purge() error {
+	return nil
+}
+
+func (m *MemoryStore) Drop(prefix []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if prefix == nil {
+		m.data = make(map[string][]byte)
+		return nil
+	}
+	for k := range m.data {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			delete(m.data, k)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) Upgrade(version int) error {
+	return nil
+}
+
+func (m *MemoryStore) Action(at storage.ActionType) (interface{}, error) {
+	switch at {
+	case storage.GC:
+		return nil, nil
+	case storage.Size:
+		m.lock.RLock()
+		defer m.lock.RUnlock()
+		var size int64
+		for k, v := range m.data {
+			size += int64(len(k) + len(v))
+		}
+		s := make(map[string]int64)
+		s["lsm"] = size
+		s["vlog"] = 0
+		return s, nil
+	default:
+		return "", errors.New("invalid action type")
+	}
+}
+
+func (m *MemoryStore) Close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.data = make(map[string][]byte)
+	return nil
+}
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+)
+
+const (
+	EngineBadger = "badger"
+	EngineBolt   = "bolt"
+	EngineMemory = "memory"
+)
+
+// NewStore opens a storage.Store using the engine selected by name, an empty name falls back to badger.
+func NewStore(engine string, dir string) (storage.Store, error) {
+	switch engine {
+	case "", EngineBadger:
+		return NewBadgerStore(dir)
+	case EngineBolt, "boltdb":
+		return NewBoltStore(dir)
+	case EngineMemory:
+		return NewMemoryStore(), nil
+	}
+	return nil, fmt.Errorf("unsupported storage engine: %s", engine)
+}
@@ -0,0 +1,243 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+var engines = []string{EngineBadger, EngineBolt, EngineMemory}
+
+func setupEngineTestCase(t *testing.T, engine string) (func(t *testing.T), storage.Store) {
+	dir := filepath.Join(config.QlcTestDataDir(), "store", engine, uuid.New().String())
+	db, err := NewStore(engine, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return func(t *testing.T) {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}, db
+}
+
+// runConformance runs fn against every supported engine
+func runConformance(t *testing.T, fn func(t *testing.T, db storage.Store)) {
+	for _, engine := range engines {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			teardownTestCase, db := setupEngineTestCase(t, engine)
+			defer teardownTestCase(t)
+			fn(t, db)
+		})
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	if _, err := NewStore("unknown", ""); err == nil {
+		t.Fatal("unknown engine should fail")
+	}
+}
+
+func TestStore_PutGet(t *testing.T) {
+	runConformance(t, func(t *testing.T, db storage.Store) {
+		key := []byte{1, 2, 3}
+		if _, err := db.Get(key); err != storage.KeyNotFound {
+			t.Fatal(err)
+		}
+		if err := db.Put(key, []byte{4, 5, 6}); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := db.Get(key); err != nil || !bytes.Equal(v, []byte{4, 5, 6}) {
+			t.Fatal(v, err)
+		}
+		if b, err := db.Has(key); err != nil || !b {
+			t.Fatal(b, err)
+		}
+		if err := db.Delete(key); err != nil {
+			t.Fatal(err)
+		}
+		if b, err := db.Has(key); err != nil || b {
+			t.Fatal(b, err)
+		}
+	})
+}
+
+func TestStore_Iterator(t *testing.T) {
+	runConformance(t, func(t *testing.T, db storage.Store) {
+		keys := [][]byte{{1, 3}, {1, 1}, {1, 2}, {2, 1}, {1, 4}}
+		for _, k := range keys {
+			if err := db.Put(k, k); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := db.Iterator(nil, nil, func(k, v []byte) error { return nil }); err == nil {
+			t.Fatal("empty prefix should fail")
+		}
+
+		var result [][]byte
+		if err := db.Iterator([]byte{1}, nil, func(k, v []byte) error {
+			if !bytes.Equal(k, v) {
+				t.Fatal(k, v)
+			}
+			result = append(result, k)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 4 || !bytes.Equal(result[0], []byte{1, 1}) || !bytes.Equal(result[3], []byte{1, 4}) {
+			t.Fatal(result)
+		}
+
+		result = result[:0]
+		if err := db.Iterator([]byte{1, 2}, []byte{2, 1}, func(k, v []byte) error {
+			result = append(result, k)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 3 || !bytes.Equal(result[0], []byte{1, 2}) {
+			t.Fatal(result)
+		}
+
+		stop := errors.New("stop")
+		if err := db.Iterator([]byte{1}, nil, func(k, v []byte) error {
+			return stop
+		}); err != stop {
+			t.Fatal(err)
+		}
+
+		if c, err := db.Count([]byte{1}); c != 4 || err != nil {
+			t.Fatal(c, err)
+		}
+		if err := db.Drop([]byte{1}); err != nil {
+			t.Fatal(err)
+		}
+		if c, err := db.Count([]byte{1}); c != 0 || err != nil {
+			t.Fatal(c, err)
+		}
+		if c, err := db.Count([]byte{2}); c != 1 || err != nil {
+			t.Fatal(c, err)
+		}
+	})
+}
+
+func TestStore_Batch(t *testing.T) {
+	runConformance(t, func(t *testing.T, db storage.Store) {
+		if err := db.Put([]byte{1, 1}, []byte{1}); err != nil {
+			t.Fatal(err)
+		}
+		batch := db.Batch(true)
+		if err := batch.Put([]byte{1, 2}, []byte{2}); err != nil {
+			t.Fatal(err)
+		}
+		if err := batch.Delete([]byte{1, 1}); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := batch.Get([]byte{1, 2}); err != nil || !bytes.Equal(v.([]byte), []byte{2}) {
+			t.Fatal(v, err)
+		}
+		if _, err := batch.Get([]byte{1, 1}); err != storage.KeyNotFound {
+			t.Fatal(err)
+		}
+		count := 0
+		if err := batch.Iterator([]byte{1}, nil, func(k, v []byte) error {
+			count++
+			return nil
+		}); err != nil || count != 1 {
+			t.Fatal(count, err)
+		}
+		// nothing is visible before the batch is committed
+		if b, _ := db.Has([]byte{1, 2}); b {
+			t.Fatal("batch should not be committed")
+		}
+		if err := db.PutBatch(batch); err != nil {
+			t.Fatal(err)
+		}
+		if b, _ := db.Has([]byte{1, 2}); !b {
+			t.Fatal("batch should be committed")
+		}
+		if b, _ := db.Has([]byte{1, 1}); b {
+			t.Fatal("key should be deleted")
+		}
+
+		wb := db.Batch(false)
+		if err := wb.Put([]byte{1, 3}, []byte{3}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.PutBatch(wb); err != nil {
+			t.Fatal(err)
+		}
+		if c, err := db.Count([]byte{1}); c != 2 || err != nil {
+			t.Fatal(c, err)
+		}
+
+		dropBatch := db.Batch(true)
+		if err := dropBatch.Drop([]byte{1}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.PutBatch(dropBatch); err != nil {
+			t.Fatal(err)
+		}
+		if c, err := db.Count([]byte{1}); c != 0 || err != nil {
+			t.Fatal(c, err)
+		}
+	})
+}
+
+func TestStore_BatchWrite(t *testing.T) {
+	runConformance(t, func(t *testing.T, db storage.Store) {
+		if err := db.BatchWrite(true, func(batch storage.Batch) error {
+			if err := batch.Put([]byte{1, 1}, []byte{1}); err != nil {
+				return err
+			}
+			return db.Put([]byte{1, 2}, []byte{2})
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if c, err := db.Count([]byte{1}); c != 2 || err != nil {
+			t.Fatal(c, err)
+		}
+
+		if err := db.BatchWrite(false, func(batch storage.Batch) error {
+			if err := batch.Put([]byte{1, 3}, []byte{3}); err != nil {
+				return err
+			}
+			return errors.New("rollback")
+		}); err == nil {
+			t.Fatal("error should be returned")
+		}
+		if b, _ := db.Has([]byte{1, 3}); b {
+			t.Fatal("batch should be discarded")
+		}
+	})
+}
+
+func TestStore_Action(t *testing.T) {
+	runConformance(t, func(t *testing.T, db storage.Store) {
+		if err := db.Put([]byte{1, 1}, []byte{1}); err != nil {
+			t.Fatal(err)
+		}
+		r, err := db.Action(storage.Size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := r.(map[string]int64); !ok {
+			t.Fatal(r)
+		}
+		if _, err := db.Action(storage.Dump); err == nil {
+			t.Fatal("dump is not supported by store")
+		}
+	})
+}
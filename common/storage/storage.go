@@ -85,6 +85,9 @@ const (
 	KeyPrefixPrivatePayload
 	KeyPrefixGapDoDSettleState
 	KeyPrefixGapPovHeight
+	KeyPrefixPrunedHeight // prefix => pov height, blocks below it may be pruned
+	KeyPrefixPeerBan      // prefix+peerID => peerBan
+	KeyPrefixEquivocation // prefix+account+root => equivocation
 
 	// Trie key space should be different
 	KeyPrefixTrieVMStorage = 100 // Deprecated vm_store.go, idPrefixStorage
@@ -27,6 +27,8 @@ const (
 	PovPublishReq
 	PovBulkPullReq
 	PovBulkPullRsp
+	PovStateProofReq
+	PovStateProofRsp
 )
 
 type EventPovRecvBlockMsg struct {
@@ -55,6 +57,38 @@ type EventConfirmReqMsg struct {
 	From   string
 }
 
+// Misbehaviour of a peer which is penalized by the peer score
+type Misbehaviour byte
+
+const (
+	MisbehaviourInvalidMessage Misbehaviour = iota
+	MisbehaviourInvalidBlock
+	MisbehaviourBadVote
+	MisbehaviourInvalidPovBlock
+	MisbehaviourBulkPullFlood
+)
+
+var misbehaviours = [...]string{
+	MisbehaviourInvalidMessage:  "invalidMessage",
+	MisbehaviourInvalidBlock:    "invalidBlock",
+	MisbehaviourBadVote:         "badVote",
+	MisbehaviourInvalidPovBlock: "invalidPovBlock",
+	MisbehaviourBulkPullFlood:   "bulkPullFlood",
+}
+
+func (m Misbehaviour) String() string {
+	if int(m) >= len(misbehaviours) {
+		return "unknown misbehaviour"
+	}
+	return misbehaviours[m]
+}
+
+type EventPeerMisbehaviourMsg struct {
+	PeerID string
+	Kind   Misbehaviour
+	Reason string
+}
+
 type EventAddP2PStreamMsg struct {
 	PeerID   string
 	PeerInfo string
@@ -37,6 +37,9 @@ const (
 	EventPovBulkPullRsp  TopicType = "povBulkPullRsp"
 	EventPovSyncState    TopicType = "povSyncState"
 
+	EventPovStateProofReq TopicType = "povStateProofReq"
+	EventPovStateProofRsp TopicType = "povStateProofRsp"
+
 	EventPovConnectBestBlock    TopicType = "povConnectBestBlock"
 	EventPovDisconnectBestBlock TopicType = "povDisconnectBestBlock"
 	EventRpcSyncCall            TopicType = "rpcSyncCall"
@@ -54,6 +57,9 @@ const (
 	EventPrivacySendRsp TopicType = "privacySendRsp"
 	EventPrivacyRecvReq TopicType = "privacyRecvReq"
 	EventPrivacyRecvRsp TopicType = "privacyRecvRsp"
+
+	EventPeerMisbehaviour TopicType = "peerMisbehaviour"
+	EventRepEquivocation  TopicType = "repEquivocation"
 )
 
 // Sync state
@@ -18,6 +18,14 @@ import (
 type Account struct {
 	pubKey  ed25519.PublicKey
 	privKey ed25519.PrivateKey
+	signer  Signer
+}
+
+// Signer signs data on behalf of an address, the private key may be kept out of the node process,
+// Account is the local implementation
+type Signer interface {
+	Address() Address
+	SignBytes(data []byte) (Signature, error)
 }
 
 //go:generate msgp
@@ -184,6 +192,15 @@ func NewAccount(key ed25519.PrivateKey) *Account {
 	}
 }
 
+// NewAccountWithSigner creates an account whose private key is kept by signer, e.g. a remote signer.
+func NewAccountWithSigner(signer Signer) *Account {
+	addr := signer.Address()
+	return &Account{
+		pubKey: ed25519.PublicKey(addr[:]),
+		signer: signer,
+	}
+}
+
 // Address returns the public key of this account as an Address type.
 func (a *Account) Address() Address {
 	var address Address
@@ -191,18 +208,43 @@ func (a *Account) Address() Address {
 	return address
 }
 
+// PrivateKey returns nil if the private key is kept by a signer
 func (a *Account) PrivateKey() ed25519.PrivateKey {
 	return a.privKey
 }
 
+// IsExternal reports whether the private key is kept by a signer out of the account
+func (a *Account) IsExternal() bool {
+	return a.signer != nil
+}
+
+// Sign returns ZeroSignature if the signer fails, use SignHash to get the error
 func (a *Account) Sign(hash Hash) Signature {
 	return a.SignData(hash[:])
 }
 
+// SignData returns ZeroSignature if the signer fails, use SignBytes to get the error
 func (a *Account) SignData(data []byte) Signature {
+	sig, _ := a.SignBytes(data)
+	return sig
+}
+
+func (a *Account) SignHash(hash Hash) (Signature, error) {
+	return a.SignBytes(hash[:])
+}
+
+// SignBytes implements Signer
+func (a *Account) SignBytes(data []byte) (Signature, error) {
+	if a.signer != nil {
+		sig, err := a.signer.SignBytes(data)
+		if err != nil {
+			return ZeroSignature, err
+		}
+		return sig, nil
+	}
 	var sig Signature
 	copy(sig[:], ed25519.Sign(a.privKey, data))
-	return sig
+	return sig, nil
 }
 
 // String implements the fmt.Stringer interface.
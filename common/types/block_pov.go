@@ -452,6 +452,12 @@ func (h *PovHeader) ComputePowHash() Hash {
 	case ALGO_SCRYPT:
 		powHash := ScryptHashData(d)
 		return powHash
+	case ALGO_NIST5:
+		powHash := Nist5HashData(d)
+		return powHash
+	case ALGO_LYRA2Z:
+		powHash := Lyra2ZHashData(d)
+		return powHash
 	case ALGO_X11:
 		powHash := X11HashData(d)
 		return powHash
@@ -460,6 +466,7 @@ func (h *PovHeader) ComputePowHash() Hash {
 		return powHash
 	}
 
+	// X16R is deferred and not supported yet, hash of unsupported algos never meets the target
 	return FFFFHash
 }
 
@@ -809,6 +816,16 @@ type PovDiffDayStat struct {
 	MinDiffRatio uint64 `msg:"mindr" json:"minDiffRatio"`
 	MaxBlockTime uint32 `msg:"maxbt" json:"maxBlockTime"`
 	MinBlockTime uint32 `msg:"minbt" json:"minBlockTime"`
+
+	AlgoStats map[string]*PovDiffAlgoStatItem `msg:"as" json:"algoStats"`
+}
+
+// PovDiffAlgoStatItem is the difficulty stat of blocks mined by an algorithm, ratios are of the algo target
+type PovDiffAlgoStatItem struct {
+	BlockNum     uint32 `msg:"bn" json:"blockNum"`
+	AvgDiffRatio uint64 `msg:"avgdr" json:"avgDiffRatio"`
+	MaxDiffRatio uint64 `msg:"maxdr" json:"maxDiffRatio"`
+	MinDiffRatio uint64 `msg:"mindr" json:"minDiffRatio"`
 }
 
 func (ds *PovDiffDayStat) Serialize() ([]byte, error) {
@@ -825,5 +842,6 @@ func (ds *PovDiffDayStat) Deserialize(text []byte) error {
 
 func NewPovDiffDayStat() *PovDiffDayStat {
 	ds := new(PovDiffDayStat)
+	ds.AlgoStats = make(map[string]*PovDiffAlgoStatItem)
 	return ds
 }
@@ -50,6 +50,12 @@ func (h *PovBtcHeader) ComputePowHash(algo PovAlgoType) Hash {
 	case ALGO_SCRYPT:
 		powHash := ScryptHashData(d)
 		return powHash
+	case ALGO_NIST5:
+		powHash := Nist5HashData(d)
+		return powHash
+	case ALGO_LYRA2Z:
+		powHash := Lyra2ZHashData(d)
+		return powHash
 	case ALGO_X11:
 		powHash := X11HashData(d)
 		return powHash
@@ -58,6 +64,7 @@ func (h *PovBtcHeader) ComputePowHash(algo PovAlgoType) Hash {
 		return powHash
 	}
 
+	// X16R is deferred and not supported yet, hash of unsupported algos never meets the target
 	return FFFFHash
 }
 
@@ -46,6 +46,12 @@ func TestPovBtcHeader_Serialize(t *testing.T) {
 		t.Fatalf("exp: %v, act: %v", hdr1.ComputePowHash(algo3), hdr2.ComputePowHash(algo3))
 	}
 
+	for _, algo := range []PovAlgoType{ALGO_NIST5, ALGO_LYRA2Z} {
+		if hdr1.ComputePowHash(algo) != hdr2.ComputePowHash(algo) || hdr1.ComputePowHash(algo) == FFFFHash {
+			t.Fatalf("%s exp: %v, act: %v", algo, hdr1.ComputePowHash(algo), hdr2.ComputePowHash(algo))
+		}
+	}
+
 	if hdr1.ComputeHash() != hdr2.ComputeHash() {
 		t.Fatalf("exp: %v, act: %v", hdr1.ComputeHash(), hdr2.ComputeHash())
 	}
@@ -2158,6 +2158,184 @@ func (z PovCoinBaseTxOut) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *PovDiffAlgoStatItem) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "bn":
+			z.BlockNum, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "BlockNum")
+				return
+			}
+		case "avgdr":
+			z.AvgDiffRatio, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "AvgDiffRatio")
+				return
+			}
+		case "maxdr":
+			z.MaxDiffRatio, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "MaxDiffRatio")
+				return
+			}
+		case "mindr":
+			z.MinDiffRatio, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "MinDiffRatio")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *PovDiffAlgoStatItem) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "bn"
+	err = en.Append(0x84, 0xa2, 0x62, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.BlockNum)
+	if err != nil {
+		err = msgp.WrapError(err, "BlockNum")
+		return
+	}
+	// write "avgdr"
+	err = en.Append(0xa5, 0x61, 0x76, 0x67, 0x64, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.AvgDiffRatio)
+	if err != nil {
+		err = msgp.WrapError(err, "AvgDiffRatio")
+		return
+	}
+	// write "maxdr"
+	err = en.Append(0xa5, 0x6d, 0x61, 0x78, 0x64, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.MaxDiffRatio)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxDiffRatio")
+		return
+	}
+	// write "mindr"
+	err = en.Append(0xa5, 0x6d, 0x69, 0x6e, 0x64, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.MinDiffRatio)
+	if err != nil {
+		err = msgp.WrapError(err, "MinDiffRatio")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *PovDiffAlgoStatItem) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "bn"
+	o = append(o, 0x84, 0xa2, 0x62, 0x6e)
+	o = msgp.AppendUint32(o, z.BlockNum)
+	// string "avgdr"
+	o = append(o, 0xa5, 0x61, 0x76, 0x67, 0x64, 0x72)
+	o = msgp.AppendUint64(o, z.AvgDiffRatio)
+	// string "maxdr"
+	o = append(o, 0xa5, 0x6d, 0x61, 0x78, 0x64, 0x72)
+	o = msgp.AppendUint64(o, z.MaxDiffRatio)
+	// string "mindr"
+	o = append(o, 0xa5, 0x6d, 0x69, 0x6e, 0x64, 0x72)
+	o = msgp.AppendUint64(o, z.MinDiffRatio)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *PovDiffAlgoStatItem) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "bn":
+			z.BlockNum, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BlockNum")
+				return
+			}
+		case "avgdr":
+			z.AvgDiffRatio, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AvgDiffRatio")
+				return
+			}
+		case "maxdr":
+			z.MaxDiffRatio, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxDiffRatio")
+				return
+			}
+		case "mindr":
+			z.MinDiffRatio, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinDiffRatio")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *PovDiffAlgoStatItem) Msgsize() (s int) {
+	s = 1 + 3 + msgp.Uint32Size + 6 + msgp.Uint64Size + 6 + msgp.Uint64Size + 6 + msgp.Uint64Size
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *PovDiffDayStat) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -2212,6 +2390,48 @@ func (z *PovDiffDayStat) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "MinBlockTime")
 				return
 			}
+		case "as":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "AlgoStats")
+				return
+			}
+			if z.AlgoStats == nil {
+				z.AlgoStats = make(map[string]*PovDiffAlgoStatItem, zb0002)
+			} else if len(z.AlgoStats) > 0 {
+				for key := range z.AlgoStats {
+					delete(z.AlgoStats, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 *PovDiffAlgoStatItem
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "AlgoStats")
+					return
+				}
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "AlgoStats", za0001)
+						return
+					}
+					za0002 = nil
+				} else {
+					if za0002 == nil {
+						za0002 = new(PovDiffAlgoStatItem)
+					}
+					err = za0002.DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "AlgoStats", za0001)
+						return
+					}
+				}
+				z.AlgoStats[za0001] = za0002
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -2225,9 +2445,9 @@ func (z *PovDiffDayStat) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *PovDiffDayStat) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 6
+	// map header, size 7
 	// write "di"
-	err = en.Append(0x86, 0xa2, 0x64, 0x69)
+	err = en.Append(0x87, 0xa2, 0x64, 0x69)
 	if err != nil {
 		return
 	}
@@ -2286,15 +2506,44 @@ func (z *PovDiffDayStat) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "MinBlockTime")
 		return
 	}
+	// write "as"
+	err = en.Append(0xa2, 0x61, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.AlgoStats)))
+	if err != nil {
+		err = msgp.WrapError(err, "AlgoStats")
+		return
+	}
+	for za0001, za0002 := range z.AlgoStats {
+		err = en.WriteString(za0001)
+		if err != nil {
+			err = msgp.WrapError(err, "AlgoStats")
+			return
+		}
+		if za0002 == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = za0002.EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "AlgoStats", za0001)
+				return
+			}
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *PovDiffDayStat) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 7
 	// string "di"
-	o = append(o, 0x86, 0xa2, 0x64, 0x69)
+	o = append(o, 0x87, 0xa2, 0x64, 0x69)
 	o = msgp.AppendUint32(o, z.DayIndex)
 	// string "avgdr"
 	o = append(o, 0xa5, 0x61, 0x76, 0x67, 0x64, 0x72)
@@ -2311,6 +2560,21 @@ func (z *PovDiffDayStat) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "minbt"
 	o = append(o, 0xa5, 0x6d, 0x69, 0x6e, 0x62, 0x74)
 	o = msgp.AppendUint32(o, z.MinBlockTime)
+	// string "as"
+	o = append(o, 0xa2, 0x61, 0x73)
+	o = msgp.AppendMapHeader(o, uint32(len(z.AlgoStats)))
+	for za0001, za0002 := range z.AlgoStats {
+		o = msgp.AppendString(o, za0001)
+		if za0002 == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = za0002.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "AlgoStats", za0001)
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -2368,6 +2632,47 @@ func (z *PovDiffDayStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "MinBlockTime")
 				return
 			}
+		case "as":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AlgoStats")
+				return
+			}
+			if z.AlgoStats == nil {
+				z.AlgoStats = make(map[string]*PovDiffAlgoStatItem, zb0002)
+			} else if len(z.AlgoStats) > 0 {
+				for key := range z.AlgoStats {
+					delete(z.AlgoStats, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 *PovDiffAlgoStatItem
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AlgoStats")
+					return
+				}
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					za0002 = nil
+				} else {
+					if za0002 == nil {
+						za0002 = new(PovDiffAlgoStatItem)
+					}
+					bts, err = za0002.UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "AlgoStats", za0001)
+						return
+					}
+				}
+				z.AlgoStats[za0001] = za0002
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -2382,7 +2687,18 @@ func (z *PovDiffDayStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *PovDiffDayStat) Msgsize() (s int) {
-	s = 1 + 3 + msgp.Uint32Size + 6 + msgp.Uint64Size + 6 + msgp.Uint64Size + 6 + msgp.Uint64Size + 6 + msgp.Uint32Size + 6 + msgp.Uint32Size
+	s = 1 + 3 + msgp.Uint32Size + 6 + msgp.Uint64Size + 6 + msgp.Uint64Size + 6 + msgp.Uint64Size + 6 + msgp.Uint32Size + 6 + msgp.Uint32Size + 3 + msgp.MapHeaderSize
+	if z.AlgoStats != nil {
+		for za0001, za0002 := range z.AlgoStats {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001)
+			if za0002 == nil {
+				s += msgp.NilSize
+			} else {
+				s += za0002.Msgsize()
+			}
+		}
+	}
 	return
 }
 
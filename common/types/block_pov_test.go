@@ -385,3 +385,28 @@ func TestPovDiffDayStat_Serialize(t *testing.T) {
 		t.Fatalf("exp: %v, act: %v", ds1.AvgDiffRatio, ds2.AvgDiffRatio)
 	}
 }
+
+func TestPovHeader_ComputePowHash(t *testing.T) {
+	hdr := NewPovHeader()
+	hdr.BasHdr.Timestamp = uint32(time.Now().Unix())
+	hdr.BasHdr.Nonce = uint32(rand.Int31())
+	hdr.BasHdr.Bits = uint32(rand.Int31())
+
+	tests := []struct {
+		algo PovAlgoType
+		fn   func([]byte) Hash
+	}{
+		{ALGO_SHA256D, Sha256DHashData},
+		{ALGO_NIST5, Nist5HashData},
+		{ALGO_LYRA2Z, Lyra2ZHashData},
+		{ALGO_X11, X11HashData},
+		{ALGO_X16R, func([]byte) Hash { return FFFFHash }},
+	}
+	for _, tt := range tests {
+		hdr.BasHdr.Version = uint32(tt.algo)
+		want := tt.fn(hdr.BuildHashData())
+		if got := hdr.ComputePowHash(); got != want {
+			t.Fatalf("%s exp: %v, act: %v", tt.algo, want, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -345,22 +346,37 @@ func (bs *StateBlockList) Deserialize(text []byte) error {
 	return nil
 }
 
+// ConvertToSchema converts the block to relation schema, Amount is "0" as it depends on the previous block,
+// it is set by the ledger when the block is indexed
 func (b *StateBlock) ConvertToSchema() ([]Schema, error) {
+	method := ""
+	if b.Type == ContractSend && len(b.Data) >= 4 {
+		method = hex.EncodeToString(b.Data[:4])
+	}
 	return []Schema{
 		&BlockHash{
 			Type:      b.Type.String(),
 			Address:   b.Address.String(),
 			Timestamp: b.Timestamp,
 			Hash:      b.GetHash().String(),
+			Token:     b.Token.String(),
+			Link:      b.Link.String(),
+			Amount:    "0",
+			Method:    method,
 		}}, nil
 }
 
 type BlockHash struct {
 	Id        int64  `db:"id" typ:"integer"`
-	Hash      string `db:"hash" typ:"char(64)"`
-	Type      string `db:"type"  typ:"varchar(15)"`
-	Address   string `db:"address" typ:"char(64)"`
-	Timestamp int64  `db:"timestamp" typ:"integer"`
+	Hash      string `db:"hash" typ:"char(64)" index:""`
+	Type      string `db:"type"  typ:"varchar(15)" index:""`
+	Address   string `db:"address" typ:"char(64)" index:""`
+	Timestamp int64  `db:"timestamp" typ:"integer" index:""`
+	Token     string `db:"token" typ:"char(64)" index:""`
+	Link      string `db:"link" typ:"char(64)" index:""`
+	Amount    string `db:"amount" typ:"decimal(40,0)" index:""`
+	// selector of contract method, hex of the first 4 bytes of data of ContractSend blocks
+	Method string `db:"method" typ:"varchar(8)" index:""`
 }
 
 func (s *BlockHash) DeleteKey() string {
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+//go:generate msgp
+
+// SignedVote is a ConfirmAck signed by a representative, Block is the voted block of Hashes
+// which conflicts with the other vote of the evidence
+type SignedVote struct {
+	Sequence  uint32      `msg:"seq" json:"sequence"`
+	Hashes    []Hash      `msg:"hashes" json:"hashes"`
+	Signature Signature   `msg:"signature,extension" json:"signature"`
+	Block     *StateBlock `msg:"block" json:"block"`
+}
+
+// Equivocation is the evidence of a representative voting for two blocks on the same root,
+// Root is the previous hash of the blocks, or hash(address, token) for open blocks
+type Equivocation struct {
+	Account   Address     `msg:"account,extension" json:"account"`
+	Root      Hash        `msg:"root,extension" json:"root"`
+	First     *SignedVote `msg:"first" json:"first"`
+	Second    *SignedVote `msg:"second" json:"second"`
+	Timestamp int64       `msg:"timestamp" json:"timestamp"`
+}
+
+// VoteRoot returns the root which the votes of forked blocks share
+func VoteRoot(block *StateBlock) Hash {
+	if block.IsOpen() {
+		hash, _ := HashBytes(block.Address[:], block.Token[:])
+		return hash
+	}
+	return block.Previous
+}
+
+// Verify checks both votes are signed by Account and vote for different blocks of Root
+func (e *Equivocation) Verify() error {
+	if e.Account.IsZero() {
+		return errors.New("invalid account")
+	}
+	if e.First == nil || e.Second == nil || e.First.Block == nil || e.Second.Block == nil {
+		return errors.New("missing vote")
+	}
+	if e.First.Block.GetHash() == e.Second.Block.GetHash() {
+		return errors.New("votes for the same block")
+	}
+	for _, v := range []*SignedVote{e.First, e.Second} {
+		if root := VoteRoot(v.Block); root != e.Root {
+			return fmt.Errorf("block %s is not on root %s", v.Block.GetHash(), e.Root)
+		}
+		if err := v.verify(e.Account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *SignedVote) verify(account Address) error {
+	blkHash := v.Block.GetHash()
+	found := false
+	hashBytes := make([]byte, 0, len(v.Hashes)*HashSize)
+	for _, h := range v.Hashes {
+		if h == blkHash {
+			found = true
+		}
+		hashBytes = append(hashBytes, h[:]...)
+	}
+	if !found {
+		return fmt.Errorf("block %s is not voted", blkHash)
+	}
+	signHash, err := HashBytes(hashBytes)
+	if err != nil {
+		return err
+	}
+	if !account.Verify(signHash[:], v.Signature[:]) {
+		return fmt.Errorf("invalid signature of vote for %s", blkHash)
+	}
+	return nil
+}
+
+func (e *Equivocation) Serialize() ([]byte, error) {
+	return e.MarshalMsg(nil)
+}
+
+func (e *Equivocation) Deserialize(text []byte) error {
+	_, err := e.UnmarshalMsg(text)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,575 @@
+package types
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *Equivocation) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "account":
+			err = dc.ReadExtension(&z.Account)
+			if err != nil {
+				err = msgp.WrapError(err, "Account")
+				return
+			}
+		case "root":
+			err = dc.ReadExtension(&z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "first":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "First")
+					return
+				}
+				z.First = nil
+			} else {
+				if z.First == nil {
+					z.First = new(SignedVote)
+				}
+				err = z.First.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "First")
+					return
+				}
+			}
+		case "second":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Second")
+					return
+				}
+				z.Second = nil
+			} else {
+				if z.Second == nil {
+					z.Second = new(SignedVote)
+				}
+				err = z.Second.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Second")
+					return
+				}
+			}
+		case "timestamp":
+			z.Timestamp, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Equivocation) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "account"
+	err = en.Append(0x85, 0xa7, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Account)
+	if err != nil {
+		err = msgp.WrapError(err, "Account")
+		return
+	}
+	// write "root"
+	err = en.Append(0xa4, 0x72, 0x6f, 0x6f, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// write "first"
+	err = en.Append(0xa5, 0x66, 0x69, 0x72, 0x73, 0x74)
+	if err != nil {
+		return
+	}
+	if z.First == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.First.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "First")
+			return
+		}
+	}
+	// write "second"
+	err = en.Append(0xa6, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64)
+	if err != nil {
+		return
+	}
+	if z.Second == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Second.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Second")
+			return
+		}
+	}
+	// write "timestamp"
+	err = en.Append(0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Timestamp)
+	if err != nil {
+		err = msgp.WrapError(err, "Timestamp")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Equivocation) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "account"
+	o = append(o, 0x85, 0xa7, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+	o, err = msgp.AppendExtension(o, &z.Account)
+	if err != nil {
+		err = msgp.WrapError(err, "Account")
+		return
+	}
+	// string "root"
+	o = append(o, 0xa4, 0x72, 0x6f, 0x6f, 0x74)
+	o, err = msgp.AppendExtension(o, &z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// string "first"
+	o = append(o, 0xa5, 0x66, 0x69, 0x72, 0x73, 0x74)
+	if z.First == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.First.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "First")
+			return
+		}
+	}
+	// string "second"
+	o = append(o, 0xa6, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64)
+	if z.Second == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Second.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Second")
+			return
+		}
+	}
+	// string "timestamp"
+	o = append(o, 0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+	o = msgp.AppendInt64(o, z.Timestamp)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Equivocation) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "account":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Account)
+			if err != nil {
+				err = msgp.WrapError(err, "Account")
+				return
+			}
+		case "root":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "first":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.First = nil
+			} else {
+				if z.First == nil {
+					z.First = new(SignedVote)
+				}
+				bts, err = z.First.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "First")
+					return
+				}
+			}
+		case "second":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Second = nil
+			} else {
+				if z.Second == nil {
+					z.Second = new(SignedVote)
+				}
+				bts, err = z.Second.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Second")
+					return
+				}
+			}
+		case "timestamp":
+			z.Timestamp, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Equivocation) Msgsize() (s int) {
+	s = 1 + 8 + msgp.ExtensionPrefixSize + z.Account.Len() + 5 + msgp.ExtensionPrefixSize + z.Root.Len() + 6
+	if z.First == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.First.Msgsize()
+	}
+	s += 7
+	if z.Second == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Second.Msgsize()
+	}
+	s += 10 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SignedVote) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "seq":
+			z.Sequence, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "Sequence")
+				return
+			}
+		case "hashes":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Hashes")
+				return
+			}
+			if cap(z.Hashes) >= int(zb0002) {
+				z.Hashes = (z.Hashes)[:zb0002]
+			} else {
+				z.Hashes = make([]Hash, zb0002)
+			}
+			for za0001 := range z.Hashes {
+				err = z.Hashes[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Hashes", za0001)
+					return
+				}
+			}
+		case "signature":
+			err = dc.ReadExtension(&z.Signature)
+			if err != nil {
+				err = msgp.WrapError(err, "Signature")
+				return
+			}
+		case "block":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Block")
+					return
+				}
+				z.Block = nil
+			} else {
+				if z.Block == nil {
+					z.Block = new(StateBlock)
+				}
+				err = z.Block.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Block")
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SignedVote) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "seq"
+	err = en.Append(0x84, 0xa3, 0x73, 0x65, 0x71)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.Sequence)
+	if err != nil {
+		err = msgp.WrapError(err, "Sequence")
+		return
+	}
+	// write "hashes"
+	err = en.Append(0xa6, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Hashes)))
+	if err != nil {
+		err = msgp.WrapError(err, "Hashes")
+		return
+	}
+	for za0001 := range z.Hashes {
+		err = z.Hashes[za0001].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Hashes", za0001)
+			return
+		}
+	}
+	// write "signature"
+	err = en.Append(0xa9, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Signature)
+	if err != nil {
+		err = msgp.WrapError(err, "Signature")
+		return
+	}
+	// write "block"
+	err = en.Append(0xa5, 0x62, 0x6c, 0x6f, 0x63, 0x6b)
+	if err != nil {
+		return
+	}
+	if z.Block == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Block.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Block")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SignedVote) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "seq"
+	o = append(o, 0x84, 0xa3, 0x73, 0x65, 0x71)
+	o = msgp.AppendUint32(o, z.Sequence)
+	// string "hashes"
+	o = append(o, 0xa6, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Hashes)))
+	for za0001 := range z.Hashes {
+		o, err = z.Hashes[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Hashes", za0001)
+			return
+		}
+	}
+	// string "signature"
+	o = append(o, 0xa9, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65)
+	o, err = msgp.AppendExtension(o, &z.Signature)
+	if err != nil {
+		err = msgp.WrapError(err, "Signature")
+		return
+	}
+	// string "block"
+	o = append(o, 0xa5, 0x62, 0x6c, 0x6f, 0x63, 0x6b)
+	if z.Block == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Block.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Block")
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SignedVote) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "seq":
+			z.Sequence, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Sequence")
+				return
+			}
+		case "hashes":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Hashes")
+				return
+			}
+			if cap(z.Hashes) >= int(zb0002) {
+				z.Hashes = (z.Hashes)[:zb0002]
+			} else {
+				z.Hashes = make([]Hash, zb0002)
+			}
+			for za0001 := range z.Hashes {
+				bts, err = z.Hashes[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Hashes", za0001)
+					return
+				}
+			}
+		case "signature":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Signature)
+			if err != nil {
+				err = msgp.WrapError(err, "Signature")
+				return
+			}
+		case "block":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Block = nil
+			} else {
+				if z.Block == nil {
+					z.Block = new(StateBlock)
+				}
+				bts, err = z.Block.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Block")
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SignedVote) Msgsize() (s int) {
+	s = 1 + 4 + msgp.Uint32Size + 7 + msgp.ArrayHeaderSize
+	for za0001 := range z.Hashes {
+		s += z.Hashes[za0001].Msgsize()
+	}
+	s += 10 + msgp.ExtensionPrefixSize + z.Signature.Len() + 6
+	if z.Block == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Block.Msgsize()
+	}
+	return
+}
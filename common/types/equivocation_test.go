@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/crypto/random"
+)
+
+func signVote(t *testing.T, account *Account, blk *StateBlock, hashes ...Hash) *SignedVote {
+	hashes = append(hashes, blk.GetHash())
+	hashBytes := make([]byte, 0)
+	for _, h := range hashes {
+		hashBytes = append(hashBytes, h[:]...)
+	}
+	signHash, err := HashBytes(hashBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &SignedVote{
+		Sequence:  1,
+		Hashes:    hashes,
+		Signature: account.Sign(signHash),
+		Block:     blk,
+	}
+}
+
+func TestEquivocation_Verify(t *testing.T) {
+	_, priv, err := KeypairFromSeed(seed, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rep := NewAccount(priv)
+
+	var previous, other Hash
+	_ = random.Bytes(previous[:])
+	_ = random.Bytes(other[:])
+	blk1 := &StateBlock{
+		Type:     Send,
+		Previous: previous,
+		Balance:  NewBalance(100),
+		Vote:     NewBalance(0),
+		Network:  NewBalance(0),
+		Oracle:   NewBalance(0),
+		Storage:  NewBalance(0),
+	}
+	blk2 := blk1.Clone()
+	blk2.Balance = NewBalance(99)
+
+	e := &Equivocation{
+		Account: rep.Address(),
+		Root:    VoteRoot(blk1),
+		First:   signVote(t, rep, blk1),
+		Second:  signVote(t, rep, blk2, other),
+	}
+	if err := e.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := e.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e2 := new(Equivocation)
+	if err := e2.Deserialize(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := e2.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	// signed by another account
+	_, priv2, _ := KeypairFromSeed(seed, 2)
+	e.Second = signVote(t, NewAccount(priv2), blk2)
+	if err := e.Verify(); err == nil {
+		t.Fatal("vote of another account should fail")
+	}
+
+	// block is not in the signed hashes
+	e.Second = signVote(t, rep, blk2)
+	e.Second.Block = blk1.Clone()
+	e.Second.Block.Balance = NewBalance(98)
+	if err := e.Verify(); err == nil {
+		t.Fatal("block not voted should fail")
+	}
+
+	// votes for the same block
+	e.Second = signVote(t, rep, blk1)
+	if err := e.Verify(); err == nil {
+		t.Fatal("votes for the same block should fail")
+	}
+
+	// blocks on different roots
+	blk3 := blk2.Clone()
+	blk3.Previous = other
+	e.Second = signVote(t, rep, blk3)
+	if err := e.Verify(); err == nil {
+		t.Fatal("blocks on different roots should fail")
+	}
+}
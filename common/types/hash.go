@@ -15,12 +15,19 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/decred/dcrd/crypto/blake256"
 	"github.com/tinylib/msgp/msgp"
 	"gitlab.com/samli88/go-x11-hash"
+	"gitlab.com/samli88/go-x11-hash/blake"
+	"gitlab.com/samli88/go-x11-hash/groestl"
+	"gitlab.com/samli88/go-x11-hash/jh"
+	"gitlab.com/samli88/go-x11-hash/keccak"
+	"gitlab.com/samli88/go-x11-hash/skein"
 	"golang.org/x/crypto/blake2b"
 	"golang.org/x/crypto/scrypt"
 
 	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/crypto/lyra2"
 )
 
 func init() {
@@ -283,6 +290,52 @@ func X11HashData(data []byte) Hash {
 	return result
 }
 
+// Nist5HashData chains blake512, groestl512, jh512, keccak512 and skein512, the result is the first 32 bytes
+func Nist5HashData(data []byte) Hash {
+	tha := make([]byte, 64)
+	thb := make([]byte, 64)
+
+	hs := blake.New()
+	hs.Write(data)
+	hs.Close(tha, 0, 0)
+
+	hs = groestl.New()
+	hs.Write(tha)
+	hs.Close(thb, 0, 0)
+
+	hs = jh.New()
+	hs.Write(thb)
+	hs.Close(tha, 0, 0)
+
+	hs = keccak.New()
+	hs.Write(tha)
+	hs.Close(thb, 0, 0)
+
+	hs = skein.New()
+	hs.Write(thb)
+	hs.Close(tha, 0, 0)
+
+	var result Hash
+	copy(result[:], tha)
+	return result
+}
+
+// Lyra2ZHashData is Lyra2 of blake256 of data, with time cost 8 and a matrix of 8 rows and 8 columns
+func Lyra2ZHashData(data []byte) Hash {
+	hs := blake256.New()
+	hs.Write(data)
+	h := hs.Sum(nil)
+
+	out := make([]byte, HashSize)
+	if err := lyra2.Sum(out, h, h, 8, 8, 8); err != nil {
+		return FFFFHash
+	}
+
+	var result Hash
+	copy(result[:], out)
+	return result
+}
+
 func Sha256HashData(data []byte) (Hash, error) {
 	h := sha256.New()
 	h.Write(data)
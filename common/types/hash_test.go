@@ -16,6 +16,13 @@ import (
 	"strings"
 	"testing"
 
+	"gitlab.com/samli88/go-x11-hash/blake"
+	"gitlab.com/samli88/go-x11-hash/groestl"
+	"gitlab.com/samli88/go-x11-hash/hash"
+	"gitlab.com/samli88/go-x11-hash/jh"
+	"gitlab.com/samli88/go-x11-hash/keccak"
+	"gitlab.com/samli88/go-x11-hash/skein"
+
 	"github.com/qlcchain/go-qlc/crypto/random"
 )
 
@@ -244,6 +251,75 @@ func TestX11HashData(t *testing.T) {
 	}
 }
 
+// the 512-bit KAT vectors (Len=0 and Len=640) published with the SHA-3 submissions of each NIST5 stage
+func TestNist5Stages(t *testing.T) {
+	msg, _ := hex.DecodeString("871a0d7a5f36c3da1dfce57acd8ab8487c274fad336bc137ebd6ff4658b547c1dcfab65f037aa58f35ef16aff4abe77ba61f65826f7be681b5b6d5a1ea8085e2ae9cd5cf0991878a311b549a6d6af230")
+
+	tests := []struct {
+		name  string
+		new   func() hash.Digest
+		empty string
+		msg   string
+	}{
+		{"blake", blake.New,
+			"a8cfbbd73726062df0c6864dda65defe58ef0cc52a5625090fa17601e1eecd1b628e94f396ae402a00acc9eab77b4d4c2e852aaaa25a636d80af3fc7913ef5b8",
+			"9262d860468ee8d565544a255b800111c55a95ae681bafc694d66bc244921bb8d1b280e845e5f87ebe9e06da246361a09742563c0978b97d0ec22799e66729f6"},
+		{"groestl", groestl.New,
+			"6d3ad29d279110eef3adbd66de2a0345a77baede1557f5d099fce0c03d6dc2ba8e6d4a6633dfbd66053c20faa87d1a11f39a7fbe4a6c2f009801370308fc4ad8",
+			"cc4f22049e9b963545313754144adc7979b8ece6926d2638496b5bc56a6924058fd6b48fc8c8b8e6624230dbdf806c666668a23e2ad3a5094bf8dc1fd3a470fb"},
+		{"jh", jh.New,
+			"90ecf2f76f9d2c8017d979ad5ab96b87d58fc8fc4b83060f3f900774faa2c8fabe69c5f4ff1ec2b61d6b316941cedee117fb04b1f4c5bc1b919ae841c50eec4f",
+			"f61a8b9d8e7bc97f08bef2ea34acd5f9d849620cd11cfdc29b56efbf8d1b55e12bb0be2b3a63f38c68694766a174f0366ebcb00031612186ba7f33bd427aee4b"},
+		{"keccak", keccak.New,
+			"0eab42de4c3ceb9235fc91acffe746b29c29a8c366b7c60e4e67c466f36a4304c00fa9caf9d87976ba469bcbe06713b435f091ef2769fb160cdab33d3670680e",
+			"5fbe194557b0426f96ba60712176df073eafe04f2a50515455412ea3d80c116758ad952598f48031612181d82a16efe4668ffb3bcce9563a772fe416ff6db3b3"},
+		{"skein", skein.New,
+			"bc5b4c50925519c290cc634277ae3d6257212395cba733bbad37a4af0fa06af41fca7903d06564fea7a2d3730dbdb80c1f85562dfcc070334ea4d1d9e72cba7a",
+			"06ef93e8d37636f73d67f4230160914ed05cd0cdbade77bd69b32e02c6a419fc220f6e71e1b269a76a1f7f4c4e3cb49c0e4c3ca3466c58c25e2930c71dd1bf0c"},
+	}
+	for _, tt := range tests {
+		for data, want := range map[string]string{"": tt.empty, string(msg): tt.msg} {
+			out := make([]byte, 64)
+			hs := tt.new()
+			hs.Write([]byte(data))
+			hs.Close(out, 0, 0)
+			if got := hex.EncodeToString(out); got != want {
+				t.Fatalf("%s(%d bytes) = %s, want %s", tt.name, len(data), got, want)
+			}
+		}
+	}
+}
+
+// the stages are checked by TestNist5Stages, the vectors are cross-checked with an independent implementation of the chain
+func TestNist5HashData(t *testing.T) {
+	d1 := make([]byte, 80)
+	for i := range d1 {
+		d1[i] = byte(i)
+	}
+
+	if h := Nist5HashData(d1); h.String() != "613f61e8346b89ae0429f1d49108b588f3ad050de7f0ad13589bf75840e6e3d3" {
+		t.Fatal(h)
+	}
+	if h := Nist5HashData(make([]byte, 80)); h.String() != "f793aee4ec7c83ad3fb06661fc514201ea8865a222eb7cc550fb0fcb7644435d" {
+		t.Fatal(h)
+	}
+}
+
+// lyra2 is checked by the lyra2 package, the vectors are cross-checked with an independent implementation of the chain
+func TestLyra2ZHashData(t *testing.T) {
+	d1 := make([]byte, 80)
+	for i := range d1 {
+		d1[i] = byte(i)
+	}
+
+	if h := Lyra2ZHashData(d1); h.String() != "6b0ded5afb3b27cf0e601243ffd9b37ee65331a2d46c7add2a6a826958ab1c0b" {
+		t.Fatal(h)
+	}
+	if h := Lyra2ZHashData(make([]byte, 80)); h.String() != "9b63bf262ec6f678d73e101f57dadcfe07b6d1f01c2b6ebfbc84ed3fa2be947d" {
+		t.Fatal(h)
+	}
+}
+
 func TestSha256HashData(t *testing.T) {
 	d1 := make([]byte, 30)
 	_ = random.Bytes(d1)
@@ -20,3 +20,24 @@ func (p *PeerInfo) Deserialize(text []byte) error {
 	}
 	return nil
 }
+
+// PeerBan is a temporary ban of a misbehaving peer, Until is the unix time when the ban expires
+type PeerBan struct {
+	PeerID  string `json:"peerid"`
+	Address string `json:"address"`
+	Score   int64  `json:"score"`
+	Reason  string `json:"reason"`
+	Until   int64  `json:"until"`
+}
+
+func (p *PeerBan) Serialize() ([]byte, error) {
+	return p.MarshalMsg(nil)
+}
+
+func (p *PeerBan) Deserialize(text []byte) error {
+	_, err := p.UnmarshalMsg(text)
+	if err != nil {
+		return err
+	}
+	return nil
+}
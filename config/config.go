@@ -9,18 +9,6 @@ import (
 	ic "github.com/libp2p/go-libp2p-core/crypto"
 )
 
-type Config ConfigV8
-
-func DefaultConfig(dir string) (*Config, error) {
-	v8, err := DefaultConfigV8(dir)
-	if err != nil {
-		return &Config{}, err
-	}
-	cfg := Config(*v8)
-
-	return &cfg, nil
-}
-
 func (c Config) Clone() (*Config, error) {
 	data, err := json.Marshal(c)
 	if err != nil {
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// AutoReceiveConfig filters the pendings received automatically when AutoGenerateReceive is enabled,
+// accounts without a policy receive all pendings as soon as they are confirmed
+type AutoReceiveConfig struct {
+	Policies []*ReceivePolicy `json:"policies"`
+}
+
+// ReceivePolicy filters the pendings of an account, tokens are matched by name or hash
+type ReceivePolicy struct {
+	Account string `json:"account" validate:"nonzero,address"`
+	// tokens received, empty means all tokens
+	AllowTokens []string `json:"allowTokens"`
+	// tokens never received, it takes precedence over AllowTokens
+	DenyTokens []string `json:"denyTokens"`
+	// minimum raw amount received, empty means no limit
+	MinAmount string `json:"minAmount"`
+	// senders received from, empty means all senders
+	FromAddresses []string `json:"fromAddresses"`
+	// skip the pendings created by contract calls, such as refunds of withdrawn pledges
+	SkipContractRefunds bool `json:"skipContractRefunds"`
+	// pendings are received in batches every interval seconds, 0 receives them once confirmed
+	Interval int `json:"interval" validate:"min=0"`
+	// log the pendings which match the policy without receiving them
+	DryRun bool `json:"dryRun"`
+}
+
+func defaultAutoReceiveConfig() *AutoReceiveConfig {
+	return &AutoReceiveConfig{
+		Policies: make([]*ReceivePolicy, 0),
+	}
+}
+
+// Verify checks the addresses and the amount of the policy
+func (p *ReceivePolicy) Verify() error {
+	if _, err := types.HexToAddress(p.Account); err != nil {
+		return fmt.Errorf("invalid account %s", p.Account)
+	}
+	for _, addr := range p.FromAddresses {
+		if _, err := types.HexToAddress(addr); err != nil {
+			return fmt.Errorf("invalid from address %s", addr)
+		}
+	}
+	if p.MinAmount != "" {
+		if v, ok := new(big.Int).SetString(p.MinAmount, 10); !ok || v.Sign() < 0 {
+			return fmt.Errorf("invalid min amount %s", p.MinAmount)
+		}
+	}
+	if p.Interval < 0 {
+		return errors.New("interval can not be negative")
+	}
+	return nil
+}
+
+// ReceivePolicies returns the auto receive policies, configs saved without the autoReceive section have none
+func (c *Config) ReceivePolicies() []*ReceivePolicy {
+	if c.AutoReceive == nil {
+		return nil
+	}
+	return c.AutoReceive.Policies
+}
+
+// SetReceivePolicy adds the policy or replaces the policy of the same account
+func (c *Config) SetReceivePolicy(policy *ReceivePolicy) error {
+	if err := policy.Verify(); err != nil {
+		return err
+	}
+	if c.AutoReceive == nil {
+		c.AutoReceive = defaultAutoReceiveConfig()
+	}
+	for i, p := range c.AutoReceive.Policies {
+		if p.Account == policy.Account {
+			c.AutoReceive.Policies[i] = policy
+			return nil
+		}
+	}
+	c.AutoReceive.Policies = append(c.AutoReceive.Policies, policy)
+	return nil
+}
+
+// RemoveReceivePolicy removes the policy of the account, it returns false if the account has no policy
+func (c *Config) RemoveReceivePolicy(account string) bool {
+	if c.AutoReceive == nil {
+		return false
+	}
+	for i, p := range c.AutoReceive.Policies {
+		if p.Account == account {
+			c.AutoReceive.Policies = append(c.AutoReceive.Policies[:i], c.AutoReceive.Policies[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+import (
+	"path/filepath"
+)
+
+// CDRImportConfig imports CDR files of the dir to settlement contracts, files are imported by the account once,
+// the progress of each file is saved in `file.journal` beside it
+type CDRImportConfig struct {
+	Enabled bool `json:"enabled"`
+	// address of the account which uploads CDRs, it must be one of the accounts of the node
+	Account string `json:"account"`
+	Dir     string `json:"dir"`
+	// csv or jsonl, decided by the extension of the file if empty
+	Format string `json:"format"`
+	// CDR field => column of csv or key of jsonl, fields without mapping use their own names
+	Mapping map[string]string `json:"mapping"`
+	// max count of CDRs in a block
+	BatchSize int `json:"batchSize" validate:"min=1"`
+	// max count of unconfirmed blocks
+	MaxPending int `json:"maxPending" validate:"min=1"`
+	// seconds between scans of the dir
+	Interval int `json:"interval" validate:"min=1"`
+}
+
+func defaultCDRImportConfig(dir string) *CDRImportConfig {
+	return &CDRImportConfig{
+		Enabled:    false,
+		Dir:        filepath.Join(dir, "cdr"),
+		Mapping:    make(map[string]string),
+		BatchSize:  100,
+		MaxPending: 10,
+		Interval:   60,
+	}
+}
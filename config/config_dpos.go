@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+import "time"
+
+const (
+	QuorumBaseSupply = "supply" // quorum is a percent of the genesis supply
+	QuorumBaseOnline = "online" // quorum is a percent of the weight of online reps
+)
+
+// DPoSConfig tunes the representative voting of DPoS
+type DPoSConfig struct {
+	// seconds a representative voting for two forked blocks is excluded from the vote tally
+	EquivocationExclusion int64 `json:"equivocationExclusion"`
+	// weight the quorum is computed from, supply or online
+	QuorumBase string `json:"quorumBase"`
+	// a block is confirmed once its votes exceed the percent of the quorum base, at least 50
+	// so that two forked blocks can not both be confirmed
+	QuorumPercent int64 `json:"quorumPercent"`
+	// minimum distinct reps voting for a block before it is confirmed, 0 means no limit
+	QuorumMinReps int `json:"quorumMinReps"`
+}
+
+func defaultDPoSConfig() *DPoSConfig {
+	return &DPoSConfig{
+		EquivocationExclusion: 7 * 24 * 3600,
+		QuorumBase:            QuorumBaseSupply,
+		QuorumPercent:         50,
+		QuorumMinReps:         0,
+	}
+}
+
+// EquivocationExclusionTime returns how long an equivocating representative is excluded from the vote tally,
+// configs saved without the dpos section use the default
+func (c *Config) EquivocationExclusionTime() time.Duration {
+	return time.Duration(c.dposConfig().EquivocationExclusion) * time.Second
+}
+
+// QuorumPolicy returns the quorum base, percent and minimum reps used to confirm blocks,
+// invalid values fall back to the default policy. A percent below 50 is invalid, votes must
+// exceed it, so 50 already asks for a strict majority.
+func (c *Config) QuorumPolicy() (string, int64, int) {
+	dpos := c.dposConfig()
+	def := defaultDPoSConfig()
+
+	base := dpos.QuorumBase
+	if base != QuorumBaseSupply && base != QuorumBaseOnline {
+		base = def.QuorumBase
+	}
+	percent := dpos.QuorumPercent
+	if percent < 50 || percent > 100 {
+		percent = def.QuorumPercent
+	}
+	minReps := dpos.QuorumMinReps
+	if minReps < 0 {
+		minReps = def.QuorumMinReps
+	}
+	return base, percent, minReps
+}
+
+func (c *Config) dposConfig() *DPoSConfig {
+	if c.DPoS == nil {
+		return defaultDPoSConfig()
+	}
+	return c.DPoS
+}
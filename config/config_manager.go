@@ -24,10 +24,15 @@ import (
 type CfgManager struct {
 	ConfigFile string
 	v          *viper.Viper
-	cfg        *Config
-	cfgB       *Config
-	locker     sync.Mutex
-	isDirty    *atomic.Bool
+	// cfg holds the *Config in use, a reload publishes a new pointer instead of changing the config in place
+	cfg     atomic.Value
+	cfgB    *Config
+	locker  sync.Mutex
+	isDirty *atomic.Bool
+
+	subLocker   sync.RWMutex
+	subscribers []*cfgSubscriber
+	subID       int
 }
 
 func NewCfgManager(path string) *CfgManager {
@@ -54,11 +59,16 @@ func NewCfgManagerWithConfig(cfgFile string, cfg *Config) *CfgManager {
 		ConfigFile: cfgFile,
 		locker:     sync.Mutex{},
 		isDirty:    atomic.NewBool(false),
-		cfg:        cfg,
 	}
+	cm.cfg.Store(cfg)
 	return cm
 }
 
+func (cm *CfgManager) current() *Config {
+	cfg, _ := cm.cfg.Load().(*Config)
+	return cfg
+}
+
 func (cm *CfgManager) ConfigDir() string {
 	return filepath.Dir(cm.ConfigFile)
 }
@@ -153,7 +163,7 @@ func (cm *CfgManager) Commit() error {
 func (cm *CfgManager) commitCfg() error {
 	if cm.isDirty.Load() && cm.cfgB != nil {
 		if cfg, err := cm.cfgB.Clone(); err == nil {
-			cm.cfg = cfg
+			cm.cfg.Store(cfg)
 			// clear buff vars
 			cm.cfgB = nil
 			cm.v = nil
@@ -172,7 +182,7 @@ func (cm *CfgManager) CommitAndSave() error {
 	defer cm.locker.Unlock()
 
 	if err := cm.commitCfg(); err == nil {
-		if err := cm.Save(cm.cfg); err == nil {
+		if err := cm.Save(cm.current()); err == nil {
 			return nil
 		} else {
 			return err
@@ -182,10 +192,11 @@ func (cm *CfgManager) CommitAndSave() error {
 	}
 }
 
-// Config get current used config
+// Config get current used config, the returned config must not be modified, and it is replaced
+// by a new one when the config is reloaded, so services should get it again to read reloadable sections
 func (cm *CfgManager) Config() (*Config, error) {
-	if cm.cfg != nil {
-		return cm.cfg, nil
+	if cfg := cm.current(); cfg != nil {
+		return cfg, nil
 	} else {
 		return nil, fmt.Errorf("invalid cfg ,cfg path is [%s]", cm.ConfigDir())
 	}
@@ -277,10 +288,10 @@ func (cm *CfgManager) Load(migrations ...CfgMigrate) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
-	cm.cfg = &cfg
+	cm.cfg.Store(&cfg)
 	err = cm.verify(nil)
 	if err != nil {
-		cm.cfg = nil
+		cm.cfg.Store((*Config)(nil))
 		return nil, err
 	}
 
@@ -364,7 +375,7 @@ func (cm *CfgManager) createAndSave() error {
 		return err
 	}
 
-	cm.cfg = cfg
+	cm.cfg.Store(cfg)
 	err = cm.Save()
 	if err != nil {
 		return err
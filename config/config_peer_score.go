@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+// PeerScoreConfig penalizes misbehaving peers, a peer is banned temporarily when its score drops to BanThreshold,
+// penalties recover by one point every minute
+type PeerScoreConfig struct {
+	Enable       bool  `json:"enabled"`
+	BanThreshold int64 `json:"banThreshold"`
+	// ban duration in seconds
+	BanDuration int64 `json:"banDuration"`
+	// maximum BulkPullRequest of a peer per minute, 0 means no limit
+	BulkPullLimit int `json:"bulkPullLimit"`
+}
+
+func defaultPeerScoreConfig() *PeerScoreConfig {
+	return &PeerScoreConfig{
+		Enable:        true,
+		BanThreshold:  -100,
+		BanDuration:   3600,
+		BulkPullLimit: 60,
+	}
+}
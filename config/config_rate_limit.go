@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+// RateLimitConfig limits requests of http/websocket/gRPC clients by token buckets, a client is identified by
+// its credential if authentication is enabled, otherwise by its IP
+type RateLimitConfig struct {
+	Enable bool `json:"enabled"`
+	// requests per second and burst of methods not in any class
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+	// every client has a bucket for each class
+	Classes []*MethodClass `json:"classes"`
+	// maximum requests of a client per day, 0 means no quota
+	DailyQuota int `json:"dailyQuota"`
+	// maximum concurrent subscriptions of a websocket connection, 0 means no limit
+	MaxSubscriptions int `json:"maxSubscriptions"`
+	// maximum size of a response in bytes, 0 means no limit
+	MaxResponseSize int `json:"maxResponseSize"`
+}
+
+// MethodClass is a class of methods sharing the rate limit, methods are matched like RolePermission
+type MethodClass struct {
+	Name    string   `json:"name"`
+	Methods []string `json:"methods"`
+	Rate    float64  `json:"rate"`
+	Burst   int      `json:"burst"`
+}
+
+func defaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enable: false,
+		Rate:   20,
+		Burst:  50,
+		Classes: []*MethodClass{
+			{
+				Name: "expensive",
+				Methods: []string{"ledger_blocks", "ledger_accounts", "ledger_accountsPending", "ledger_queryBlocks",
+					"pov_dump*", "pov_checkAllAccountStates", "pov_getAllRepStates*", "settlement_generate*",
+					"settlement_getAll*", "DoDSettlement_generate*", "debug_*"},
+				Rate:  1,
+				Burst: 5,
+			},
+		},
+		DailyQuota:       0,
+		MaxSubscriptions: 10,
+		MaxResponseSize:  5 << 20,
+	}
+}
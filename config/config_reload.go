@@ -0,0 +1,311 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Section is a part of the config which can be applied to the running chain without restarting it,
+// services subscribe to the sections they own by CfgManager.Subscribe
+type Section string
+
+const (
+	// LogLevel
+	SectionLog Section = "log"
+	// RPC.HTTPCors, RPC.HttpVirtualHosts and RPC.PublicModules
+	SectionRPC Section = "rpc"
+	// WhiteList.WhiteListInfos, enabling or disabling the whitelist still requires a restart
+	SectionWhiteList Section = "whiteList"
+	// Metrics.SampleInterval and Metrics.Influx.Interval
+	SectionMetrics Section = "metrics"
+	// AutoGenerateReceive and AutoReceive.Policies
+	SectionAutoReceive Section = "autoReceive"
+	// PoV.MinerEnabled, PoV.Coinbase and PoV.AlgoName
+	SectionMiner Section = "miner"
+	// Privacy.PtmNode
+	SectionPrivacy Section = "privacy"
+)
+
+type reloadableSection struct {
+	section Section
+	// get returns the values of the section which are compared to detect changes
+	get func(c *Config) interface{}
+	// set copies the values of the section from src to dst
+	set func(dst, src *Config)
+}
+
+var reloadableSections = []reloadableSection{
+	{
+		section: SectionLog,
+		get: func(c *Config) interface{} {
+			return c.LogLevel
+		},
+		set: func(dst, src *Config) {
+			dst.LogLevel = src.LogLevel
+		},
+	},
+	{
+		section: SectionRPC,
+		get: func(c *Config) interface{} {
+			if c.RPC == nil {
+				return nil
+			}
+			return []interface{}{c.RPC.HTTPCors, c.RPC.HttpVirtualHosts, c.RPC.PublicModules}
+		},
+		set: func(dst, src *Config) {
+			if dst.RPC != nil && src.RPC != nil {
+				dst.RPC.HTTPCors = src.RPC.HTTPCors
+				dst.RPC.HttpVirtualHosts = src.RPC.HttpVirtualHosts
+				dst.RPC.PublicModules = src.RPC.PublicModules
+			}
+		},
+	},
+	{
+		section: SectionWhiteList,
+		get: func(c *Config) interface{} {
+			if c.WhiteList == nil {
+				return nil
+			}
+			return c.WhiteList.WhiteListInfos
+		},
+		set: func(dst, src *Config) {
+			if dst.WhiteList != nil && src.WhiteList != nil {
+				dst.WhiteList.WhiteListInfos = src.WhiteList.WhiteListInfos
+			}
+		},
+	},
+	{
+		section: SectionMetrics,
+		get: func(c *Config) interface{} {
+			if c.Metrics == nil {
+				return nil
+			}
+			if c.Metrics.Influx == nil {
+				return []int{c.Metrics.SampleInterval}
+			}
+			return []int{c.Metrics.SampleInterval, c.Metrics.Influx.Interval}
+		},
+		set: func(dst, src *Config) {
+			if dst.Metrics != nil && src.Metrics != nil {
+				dst.Metrics.SampleInterval = src.Metrics.SampleInterval
+				if dst.Metrics.Influx != nil && src.Metrics.Influx != nil {
+					dst.Metrics.Influx.Interval = src.Metrics.Influx.Interval
+				}
+			}
+		},
+	},
+	{
+		section: SectionAutoReceive,
+		get: func(c *Config) interface{} {
+			return []interface{}{c.AutoGenerateReceive, c.ReceivePolicies()}
+		},
+		set: func(dst, src *Config) {
+			dst.AutoGenerateReceive = src.AutoGenerateReceive
+			dst.AutoReceive = src.AutoReceive
+		},
+	},
+	{
+		section: SectionMiner,
+		get: func(c *Config) interface{} {
+			if c.PoV == nil {
+				return nil
+			}
+			return []interface{}{c.PoV.MinerEnabled, c.PoV.Coinbase, c.PoV.AlgoName}
+		},
+		set: func(dst, src *Config) {
+			if dst.PoV != nil && src.PoV != nil {
+				dst.PoV.MinerEnabled = src.PoV.MinerEnabled
+				dst.PoV.Coinbase = src.PoV.Coinbase
+				dst.PoV.AlgoName = src.PoV.AlgoName
+			}
+		},
+	},
+	{
+		section: SectionPrivacy,
+		get: func(c *Config) interface{} {
+			if c.Privacy == nil {
+				return nil
+			}
+			return c.Privacy.PtmNode
+		},
+		set: func(dst, src *Config) {
+			if dst.Privacy != nil && src.Privacy != nil {
+				dst.Privacy.PtmNode = src.Privacy.PtmNode
+			}
+		},
+	},
+}
+
+func jsonEqual(a, b interface{}) bool {
+	da, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	db, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(da, db)
+}
+
+// ChangedSections returns the reloadable sections changed from old to cur, restart is true if
+// any other setting is changed, which can only be applied by restarting the chain
+func ChangedSections(old, cur *Config) (sections []Section, restart bool) {
+	rest, err := cur.Clone()
+	if err != nil {
+		return nil, true
+	}
+	for _, s := range reloadableSections {
+		if !jsonEqual(s.get(old), s.get(cur)) {
+			sections = append(sections, s.section)
+		}
+		s.set(rest, old)
+	}
+	return sections, !jsonEqual(rest, old)
+}
+
+// ChangeHandler is notified with the config before the change and the config in use, neither of them
+// may be modified
+type ChangeHandler func(old, cur *Config)
+
+type cfgSubscriber struct {
+	id       int
+	sections []Section
+	handler  ChangeHandler
+}
+
+// Subscribe registers handler to be notified when any of sections is reloaded, it returns
+// the ID to unsubscribe
+func (cm *CfgManager) Subscribe(handler ChangeHandler, sections ...Section) int {
+	cm.subLocker.Lock()
+	defer cm.subLocker.Unlock()
+
+	cm.subID++
+	cm.subscribers = append(cm.subscribers, &cfgSubscriber{id: cm.subID, sections: sections, handler: handler})
+	return cm.subID
+}
+
+func (cm *CfgManager) Unsubscribe(id int) {
+	cm.subLocker.Lock()
+	defer cm.subLocker.Unlock()
+
+	for i, s := range cm.subscribers {
+		if s.id == id {
+			cm.subscribers = append(cm.subscribers[:i], cm.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+func (cm *CfgManager) notify(sections []Section, old, cur *Config) {
+	cm.subLocker.RLock()
+	subscribers := make([]*cfgSubscriber, len(cm.subscribers))
+	copy(subscribers, cm.subscribers)
+	cm.subLocker.RUnlock()
+
+	for _, s := range subscribers {
+		if s.interested(sections) {
+			s.handler(old, cur)
+		}
+	}
+}
+
+func (s *cfgSubscriber) interested(sections []Section) bool {
+	for _, section := range sections {
+		for _, v := range s.sections {
+			if v == section {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Reload applies the changed config to runtime without restarting the chain and notifies the subscribers
+// of the changed sections, the config is also written to file if save is true. It returns false and keeps
+// the changes uncommitted if any of them requires a restart.
+func (cm *CfgManager) Reload(save bool) (bool, error) {
+	cm.locker.Lock()
+
+	if !cm.isDirty.Load() || cm.cfgB == nil {
+		defer cm.locker.Unlock()
+		if save {
+			return true, cm.Save()
+		}
+		return true, nil
+	}
+
+	cur := cm.current()
+	sections, restart := ChangedSections(cur, cm.cfgB)
+	if restart {
+		cm.locker.Unlock()
+		return false, nil
+	}
+
+	// publish the staged config as a new pointer, services get it by Config or from the notification
+	old := cur
+	cur = cm.cfgB
+	cm.cfg.Store(cur)
+	cm.cfgB = nil
+	cm.v = nil
+	cm.isDirty.Store(false)
+	if save {
+		if err := cm.Save(cur); err != nil {
+			cm.locker.Unlock()
+			return false, err
+		}
+	}
+	cm.locker.Unlock()
+
+	cm.notify(sections, old, cur)
+	return true, nil
+}
+
+// UpdateSection changes a reloadable section of the config in use by fn, saves the config and notifies the
+// subscribers of the section. Changes staged by UpdateParams are kept and also updated by fn.
+func (cm *CfgManager) UpdateSection(section Section, fn func(cfg *Config) error) error {
+	cm.locker.Lock()
+
+	old := cm.current()
+	if old == nil {
+		cm.locker.Unlock()
+		return fmt.Errorf("invalid cfg ,cfg path is [%s]", cm.ConfigDir())
+	}
+	cur, err := old.Clone()
+	if err != nil {
+		cm.locker.Unlock()
+		return err
+	}
+	if err := fn(cur); err != nil {
+		cm.locker.Unlock()
+		return err
+	}
+	if err := cm.verify(cur); err != nil {
+		cm.locker.Unlock()
+		return err
+	}
+	if cm.cfgB != nil {
+		if err := fn(cm.cfgB); err != nil {
+			cm.locker.Unlock()
+			return err
+		}
+	}
+
+	if err := cm.Save(cur); err != nil {
+		cm.locker.Unlock()
+		return err
+	}
+	cm.cfg.Store(cur)
+	cm.locker.Unlock()
+
+	cm.notify([]Section{section}, old, cur)
+	return nil
+}
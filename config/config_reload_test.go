@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestChangedSections(t *testing.T) {
+	dir := filepath.Join(QlcTestDataDir(), "config", uuid.New().String())
+	old, err := DefaultConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cur, _ := old.Clone()
+	if sections, restart := ChangedSections(old, cur); len(sections) != 0 || restart {
+		t.Fatal(sections, restart)
+	}
+
+	cur.LogLevel = "debug"
+	cur.RPC.HTTPCors = []string{"http://localhost"}
+	cur.Metrics.SampleInterval = 30
+	cur.PoV.MinerEnabled = true
+	cur.Privacy.PtmNode = "http://127.0.0.1:9183"
+	cur.WhiteList.WhiteListInfos = append(cur.WhiteList.WhiteListInfos, &WhiteListInfo{PeerId: "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"})
+	cur.AutoGenerateReceive = !old.AutoGenerateReceive
+	sections, restart := ChangedSections(old, cur)
+	exp := []Section{SectionLog, SectionRPC, SectionWhiteList, SectionMetrics, SectionAutoReceive, SectionMiner, SectionPrivacy}
+	if !reflect.DeepEqual(sections, exp) || restart {
+		t.Fatal(sections, restart)
+	}
+
+	cur.RPC.HTTPEndpoint = "tcp://0.0.0.0:19735"
+	if _, restart := ChangedSections(old, cur); !restart {
+		t.Fatal("endpoint change should require restart")
+	}
+
+	cur, _ = old.Clone()
+	cur.WhiteList.Enable = !old.WhiteList.Enable
+	if sections, restart := ChangedSections(old, cur); len(sections) != 0 || !restart {
+		t.Fatal(sections, restart)
+	}
+}
+
+func TestCfgManager_Reload(t *testing.T) {
+	dir := filepath.Join(QlcTestDataDir(), "config", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cm := NewCfgManager(dir)
+	cfg, err := cm.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var logNotified, minerNotified int
+	var oldLevel, newLevel string
+	id := cm.Subscribe(func(old, cur *Config) {
+		logNotified++
+		oldLevel = old.LogLevel
+		newLevel = cur.LogLevel
+	}, SectionLog, SectionRPC)
+	cm.Subscribe(func(old, cur *Config) {
+		minerNotified++
+	}, SectionMiner)
+
+	if _, err := cm.UpdateParams([]string{"logLevel=debug"}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := cm.Reload(false); !ok || err != nil {
+		t.Fatal(ok, err)
+	}
+	if logNotified != 1 || minerNotified != 0 || oldLevel != "error" || newLevel != "debug" {
+		t.Fatal(logNotified, minerNotified, oldLevel, newLevel)
+	}
+	// the reloaded config is published as a new one, the config in use before is not changed
+	if c, _ := cm.Config(); c == cfg || c.LogLevel != "debug" || cfg.LogLevel != "error" {
+		t.Fatal("reloaded config should be published")
+	}
+	cfg, _ = cm.Config()
+
+	// changes which require restart are kept uncommitted
+	if _, err := cm.UpdateParams([]string{"logLevel=info", "rpc.httpEndpoint=tcp://0.0.0.0:19735"}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := cm.Reload(false); ok || err != nil {
+		t.Fatal(ok, err)
+	}
+	if cfg.LogLevel != "debug" || logNotified != 1 {
+		t.Fatal("config should not be changed")
+	}
+	cm.Discard()
+
+	cm.Unsubscribe(id)
+	if _, err := cm.UpdateParams([]string{"logLevel=warn"}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := cm.Reload(true); !ok || err != nil {
+		t.Fatal(ok, err)
+	}
+	if logNotified != 1 {
+		t.Fatal("unsubscribed handler should not be notified")
+	}
+	cfg2, err := NewCfgManager(dir).Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg2.LogLevel != "warn" {
+		t.Fatal("config should be saved")
+	}
+}
+
+func TestCfgManager_UpdateSection(t *testing.T) {
+	dir := filepath.Join(QlcTestDataDir(), "config", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cm := NewCfgManager(dir)
+	cfg, err := cm.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var notified int
+	var policies []*ReceivePolicy
+	cm.Subscribe(func(old, cur *Config) {
+		notified++
+		policies = cur.ReceivePolicies()
+	}, SectionAutoReceive)
+
+	policy := &ReceivePolicy{
+		Account:   "qlc_3qjky1ptg9qkzm8iertdzrnx9btjbaea33snh1w4g395xqqczye4kgcfyfs1",
+		MinAmount: "100",
+		Interval:  10,
+	}
+	if err := cm.UpdateSection(SectionAutoReceive, func(cfg *Config) error {
+		return cfg.SetReceivePolicy(policy)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if notified != 1 || len(policies) != 1 || len(cfg.ReceivePolicies()) != 0 {
+		t.Fatal(notified, policies)
+	}
+	cfg, _ = cm.Config()
+	if len(cfg.ReceivePolicies()) != 1 {
+		t.Fatal(cfg.ReceivePolicies())
+	}
+
+	invalid := &ReceivePolicy{Account: policy.Account, MinAmount: "-1"}
+	if err := cm.UpdateSection(SectionAutoReceive, func(cfg *Config) error {
+		return cfg.SetReceivePolicy(invalid)
+	}); err == nil {
+		t.Fatal("invalid policy should be rejected")
+	}
+	if notified != 1 || cfg.ReceivePolicies()[0].MinAmount != "100" {
+		t.Fatal("config should not be changed")
+	}
+
+	cfg2, err := NewCfgManager(dir).Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps := cfg2.ReceivePolicies(); len(ps) != 1 || ps[0].Interval != 10 {
+		t.Fatal("config should be saved", ps)
+	}
+
+	if err := cm.UpdateSection(SectionAutoReceive, func(cfg *Config) error {
+		if !cfg.RemoveReceivePolicy(policy.Account) {
+			t.Fatal("policy should be removed")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if notified != 2 || len(policies) != 0 {
+		t.Fatal(notified, policies)
+	}
+}
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+// SignerConfig is the remote signer which keeps the private keys of Accounts out of the node process,
+// e.g. a hardened process or an HSM bridge speaking the protocol of package wallet/signer
+type SignerConfig struct {
+	Enable bool `json:"enabled"`
+	// http(s) endpoint of the remote signer, e.g. https://127.0.0.1:9739
+	Endpoint string `json:"endpoint"`
+	// sent by header `Authorization: Bearer <token>`
+	Token string `json:"token"`
+	// timeout of a signing request in seconds
+	Timeout int `json:"timeout"`
+	// addresses signed by the remote signer, they are used as the node accounts like --account
+	Accounts []string `json:"accounts"`
+}
+
+func defaultSignerConfig() *SignerConfig {
+	return &SignerConfig{
+		Enable:   false,
+		Endpoint: "http://127.0.0.1:9739",
+		Timeout:  5,
+		Accounts: []string{},
+	}
+}
@@ -7,15 +7,42 @@ import (
 )
 
 func TestConfig_Dir(t *testing.T) {
-	cfg, err := DefaultConfigV8(DefaultDataDir())
+	cfg, err := DefaultConfig(DefaultDataDir())
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(util.ToIndentString(cfg))
 	t.Log(cfg.DataDir)
-	c := Config(*cfg)
+	c := cfg
 	t.Log(c.LogDir())
 	t.Log(c.LedgerDir())
 	t.Log(c.WalletDir())
 	t.Log(QlcTestDataDir())
 }
+
+func TestConfig_QuorumPolicy(t *testing.T) {
+	cfg, err := DefaultConfig(DefaultDataDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if base, percent, minReps := cfg.QuorumPolicy(); base != QuorumBaseSupply || percent != 50 || minReps != 0 {
+		t.Fatal("invalid default policy", base, percent, minReps)
+	}
+
+	cfg.DPoS = &DPoSConfig{QuorumBase: QuorumBaseOnline, QuorumPercent: 67, QuorumMinReps: 3}
+	if base, percent, minReps := cfg.QuorumPolicy(); base != QuorumBaseOnline || percent != 67 || minReps != 3 {
+		t.Fatal("invalid policy", base, percent, minReps)
+	}
+
+	// configs saved before the quorum policy
+	cfg.DPoS = &DPoSConfig{EquivocationExclusion: 3600, QuorumBase: "weight", QuorumPercent: 101}
+	if base, percent, _ := cfg.QuorumPolicy(); base != QuorumBaseSupply || percent != 50 {
+		t.Fatal("invalid policy should fall back to default", base, percent)
+	}
+
+	// a minority quorum could confirm both of two forked blocks
+	cfg.DPoS = &DPoSConfig{QuorumBase: QuorumBaseSupply, QuorumPercent: 34}
+	if _, percent, _ := cfg.QuorumPolicy(); percent != 50 {
+		t.Fatal("minority quorum should fall back to default", percent)
+	}
+}
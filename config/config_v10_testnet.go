@@ -0,0 +1,36 @@
+// +build testnet
+
+package config
+
+type ConfigV10 struct {
+	ConfigV9    `mapstructure:",squash"`
+	RateLimit   *RateLimitConfig   `json:"rateLimit"`
+	Signer      *SignerConfig      `json:"signer,omitempty"`
+	DPoS        *DPoSConfig        `json:"dpos,omitempty"`
+	AutoReceive *AutoReceiveConfig `json:"autoReceive,omitempty"`
+	CDRImport   *CDRImportConfig   `json:"cdrImport,omitempty"`
+}
+
+func DefaultConfigV10(dir string) (*ConfigV10, error) {
+	var cfg ConfigV10
+	cfg9, _ := DefaultConfigV9(dir)
+	cfg.ConfigV9 = *cfg9
+	cfg.RateLimit = defaultRateLimitConfig()
+	cfg.Signer = defaultSignerConfig()
+	cfg.DPoS = defaultDPoSConfig()
+	cfg.AutoReceive = defaultAutoReceiveConfig()
+	cfg.CDRImport = defaultCDRImportConfig(dir)
+	return &cfg, nil
+}
+
+type Config ConfigV10
+
+func DefaultConfig(dir string) (*Config, error) {
+	v10, err := DefaultConfigV10(dir)
+	if err != nil {
+		return &Config{}, err
+	}
+	cfg := Config(*v10)
+
+	return &cfg, nil
+}
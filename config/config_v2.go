@@ -30,6 +30,7 @@ type P2PConfigV2 struct {
 	SyncInterval int                `json:"syncInterval"`
 	Discovery    *DiscoveryConfigV2 `json:"discovery"`
 	ID           *IdentityConfigV2  `json:"identity" mapstructure:"identity"`
+	PeerScore    *PeerScoreConfig   `json:"peerScore,omitempty"`
 }
 
 type RPCConfigV2 struct {
@@ -47,6 +48,8 @@ type RPCConfigV2 struct {
 	IPCEnabled    bool        `json:"ipcEnabled"`
 	PublicModules []string    `json:"publicModules"`
 	GRPCConfig    *GRPCConfig `json:"gRPCConfig"`
+	// nil means authentication is disabled
+	Auth *RPCAuthConfig `json:"auth,omitempty"`
 }
 
 type GRPCConfig struct {
@@ -67,7 +67,7 @@ func defaultGRPCConfig() *GRPCConfig {
 
 func defaultModules() []string {
 	modules := []string{"ledger", "account", "net", "util", "mintage", "contract", "pledge",
-		"rewards", "pov", "miner", "config", "debug", "destroy", "metrics", "rep", "chain", "dpki",
+		"rewards", "pov", "miner", "config", "autoReceive", "debug", "destroy", "metrics", "rep", "chain", "dpki",
 		"permission", "privacy", "ptmkey"}
 	return modules
 }
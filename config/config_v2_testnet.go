@@ -66,7 +66,7 @@ func defaultGRPCConfig() *GRPCConfig {
 
 func defaultModules() []string {
 	modules := []string{"ledger", "account", "net", "util", "mintage", "contract", "pledge",
-		"rewards", "pov", "miner", "config", "debug", "destroy", "metrics", "rep", "chain", "dpki", "settlement",
+		"rewards", "pov", "miner", "config", "autoReceive", "debug", "destroy", "metrics", "rep", "chain", "dpki", "settlement",
 		"permission", "privacy", "ptmkey", "DoDSettlement"}
 	return modules
 }
@@ -16,6 +16,18 @@ type PoVConfig struct {
 	Coinbase     string       `json:"coinbase" validate:"address"`
 	AlgoName     string       `json:"algoName"`
 	ChainParams  *ChainParams `json:"chainParams"`
+	// light client only follows PoV headers and fetches state with merkle proofs from full peers
+	LightMode bool `json:"lightMode"`
+	// garbage collection of state trie nodes, nil means disabled
+	StateGC *StateGCConfig `json:"stateGC"`
+}
+
+type StateGCConfig struct {
+	Enabled bool `json:"enabled"`
+	// state roots of the latest KeepRoots PoV heights are kept
+	KeepRoots uint64 `json:"keepRoots"`
+	// seconds between two gc rounds
+	Interval int `json:"interval"`
 }
 
 type ChainParams struct {
@@ -44,5 +56,14 @@ func defaultPoV() *PoVConfig {
 		ChainParams: &ChainParams{
 			MinerPledge: common.PovMinerPledgeAmountMin,
 		},
+		StateGC: defaultStateGC(),
+	}
+}
+
+func defaultStateGC() *StateGCConfig {
+	return &StateGCConfig{
+		Enabled:   false,
+		KeepRoots: uint64(common.POVChainBlocksPerDay),
+		Interval:  3600,
 	}
 }
@@ -18,9 +18,11 @@ type ConfigV5 struct {
 }
 
 type MetricsConfig struct {
-	Enable         bool    `json:"enable"`
-	SampleInterval int     `json:"sampleInterval" validate:"min=1"`
-	Influx         *Influx `json:"influx"`
+	Enable         bool        `json:"enable"`
+	SampleInterval int         `json:"sampleInterval" validate:"min=1"`
+	Influx         *Influx     `json:"influx"`
+	Prometheus     *Prometheus `json:"prometheus,omitempty"`
+	Tracing        *Tracing    `json:"tracing,omitempty"`
 }
 
 type Influx struct {
@@ -32,6 +34,26 @@ type Influx struct {
 	Interval int    `json:"interval" validate:"min=1"`
 }
 
+// Prometheus serves the metrics registries in the Prometheus text format at Path of ListenAddress
+type Prometheus struct {
+	Enable        bool   `json:"enable"`
+	ListenAddress string `json:"listenAddress" validate:"nonzero"`
+	Path          string `json:"path" validate:"nonzero"`
+}
+
+// Tracing exports spans of the block lifecycle to File, relative to the data dir, or to the OTLP/HTTP Endpoint
+type Tracing struct {
+	Enable   bool   `json:"enable"`
+	Exporter string `json:"exporter"`
+	File     string `json:"file"`
+	Endpoint string `json:"endpoint"`
+}
+
+const (
+	TracingExporterFile = "file"
+	TracingExporterOTLP = "otlp"
+)
+
 type Manager struct {
 	AdminToken string `json:"adminToken"`
 }
@@ -60,5 +82,16 @@ func defaultMetrics() *MetricsConfig {
 			Password: "",
 			Interval: 10,
 		},
+		Prometheus: &Prometheus{
+			Enable:        false,
+			ListenAddress: "tcp://0.0.0.0:9747",
+			Path:          "/metrics",
+		},
+		Tracing: &Tracing{
+			Enable:   false,
+			Exporter: TracingExporterFile,
+			File:     "traces.json",
+			Endpoint: "http://localhost:4318/v1/traces",
+		},
 	}
 }
@@ -4,11 +4,73 @@ type ConfigV8 struct {
 	ConfigV7 `mapstructure:",squash"`
 }
 
+// RPCAuthConfig is the authentication of http/websocket/gRPC requests, credentials are API keys or
+// HS256 signed JWT with the claim `role`, both are sent by header `Authorization: Bearer <credential>`
+type RPCAuthConfig struct {
+	Enable bool `json:"enabled"`
+	// secret of JWT, JWT is not accepted if it is empty
+	JWTSecret string `json:"jwtSecret"`
+	// role of requests without credential, empty means these requests are rejected
+	AnonymousRole string `json:"anonymousRole"`
+	// API key to role
+	APIKeys map[string]string `json:"apiKeys"`
+	// permissions of roles, roles not in the map use the default permissions
+	Roles map[string]*RolePermission `json:"roles"`
+}
+
+// RolePermission matches methods like `ledger_accountInfo` with patterns like `ledger_*`,
+// a method is allowed if it matches any pattern of Allow and no pattern of Deny
+type RolePermission struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+const (
+	RoleReadOnly = "readonly"
+	RoleWallet   = "wallet"
+	RoleMiner    = "miner"
+	RoleAdmin    = "admin"
+)
+
+var (
+	walletMethods = []string{"wallet_*", "account_*", "ledger_generate*", "ledger_process", "contract_generate*",
+		"util_encrypt", "util_decrypt", "privacy_distributeRawPayload"}
+	minerMethods = []string{"pov_startMining", "pov_stopMining", "pov_getWork", "pov_submitWork"}
+	adminMethods = []string{"config_*", "debug_*", "autoReceive_set*", "autoReceive_remove*", "net_banPeer", "net_unbanPeer"}
+)
+
 func DefaultConfigV8(dir string) (*ConfigV8, error) {
 	var cfg ConfigV8
 	cfg7, _ := DefaultConfigV7(dir)
 	cfg.ConfigV7 = *cfg7
 	cfg.RPC.PublicModules = defaultModules()
 	cfg.RPC.GRPCConfig = defaultGRPCConfig()
+	cfg.RPC.Auth = defaultRPCAuthConfig()
+	cfg.P2P.PeerScore = defaultPeerScoreConfig()
 	return &cfg, nil
 }
+
+func defaultRPCAuthConfig() *RPCAuthConfig {
+	return &RPCAuthConfig{
+		Enable:  false,
+		APIKeys: make(map[string]string),
+		Roles:   DefaultRolePermissions(),
+	}
+}
+
+// DefaultRolePermissions returns the permissions of builtin roles
+func DefaultRolePermissions() map[string]*RolePermission {
+	join := func(methods ...[]string) []string {
+		var r []string
+		for _, m := range methods {
+			r = append(r, m...)
+		}
+		return r
+	}
+	return map[string]*RolePermission{
+		RoleReadOnly: {Allow: []string{"*"}, Deny: join(walletMethods, minerMethods, adminMethods)},
+		RoleWallet:   {Allow: []string{"*"}, Deny: join(minerMethods, adminMethods)},
+		RoleMiner:    {Allow: []string{"*"}, Deny: join(walletMethods, adminMethods)},
+		RoleAdmin:    {Allow: []string{"*"}},
+	}
+}
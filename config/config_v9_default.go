@@ -0,0 +1,43 @@
+// +build !testnet
+
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package config
+
+type ConfigV9 struct {
+	ConfigV8    `mapstructure:",squash"`
+	RateLimit   *RateLimitConfig   `json:"rateLimit"`
+	Signer      *SignerConfig      `json:"signer,omitempty"`
+	DPoS        *DPoSConfig        `json:"dpos,omitempty"`
+	AutoReceive *AutoReceiveConfig `json:"autoReceive,omitempty"`
+	CDRImport   *CDRImportConfig   `json:"cdrImport,omitempty"`
+}
+
+func DefaultConfigV9(dir string) (*ConfigV9, error) {
+	var cfg ConfigV9
+	cfg8, _ := DefaultConfigV8(dir)
+	cfg.ConfigV8 = *cfg8
+	cfg.RateLimit = defaultRateLimitConfig()
+	cfg.Signer = defaultSignerConfig()
+	cfg.DPoS = defaultDPoSConfig()
+	cfg.AutoReceive = defaultAutoReceiveConfig()
+	cfg.CDRImport = defaultCDRImportConfig(dir)
+	return &cfg, nil
+}
+
+type Config ConfigV9
+
+func DefaultConfig(dir string) (*Config, error) {
+	v9, err := DefaultConfigV9(dir)
+	if err != nil {
+		return &Config{}, err
+	}
+	cfg := Config(*v9)
+
+	return &cfg, nil
+}
@@ -4,6 +4,7 @@ package dpos
 
 import (
 	"context"
+	"fmt"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -25,6 +26,7 @@ import (
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/ledger/process"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 	"github.com/qlcchain/go-qlc/p2p"
 	"github.com/qlcchain/go-qlc/p2p/protos"
 	"github.com/qlcchain/go-qlc/vm/contract"
@@ -40,6 +42,7 @@ const (
 	maxStatisticsPeriod   = 3
 	confirmedCacheMaxLen  = 1024000
 	confirmedCacheMaxTime = 10 * time.Minute
+	confirmDepthCacheLen  = 102400
 	hashNumPerAck         = 1024
 	blockNumPerReq        = 128
 )
@@ -106,7 +109,11 @@ type DPoS struct {
 	povSyncState        topic.SyncState
 	blockSyncState      topic.SyncState
 	minVoteWeight       types.Balance
-	voteThreshold       types.Balance
+	voteThreshold       atomic.Value
+	quorumBase          string
+	quorumPercent       int64
+	quorumMinReps       int
+	confirmDepths       *cache
 	subAck              gcache.Cache
 	subMsg              chan *subMsg
 	voteCache           gcache.Cache //vote blocks
@@ -139,6 +146,8 @@ type DPoS struct {
 	febRpcMsgCh         chan *topic.EventRPCSyncCallMsg
 	repVH               chan *repVoteHeart
 	exited              *sync.WaitGroup
+	seenAcks            gcache.Cache
+	excludedReps        *sync.Map
 
 	privateRecvBlocks chan *consensus.BlockSource
 	privateRecvRspCh  chan *topic.EventPrivacyRecvRspMsg
@@ -178,6 +187,7 @@ func NewDPoS(cfgFile string) *DPoS {
 		syncStateNotifyWait: new(sync.WaitGroup),
 		online:              gcache.New(maxStatisticsPeriod).LRU().Build(),
 		confirmedBlocks:     newCache(confirmedCacheMaxLen, confirmedCacheMaxTime),
+		confirmDepths:       newCache(confirmDepthCacheLen, confirmedCacheMaxTime),
 		lastSendHeight:      1,
 		curPovHeight:        0,
 		checkFinish:         make(chan struct{}, 10240),
@@ -195,12 +205,16 @@ func NewDPoS(cfgFile string) *DPoS {
 		repVH:               make(chan *repVoteHeart, 409600),
 		exited:              new(sync.WaitGroup),
 		gapHeight:           make(chan uint64, 10240),
+		seenAcks:            gcache.New(seenAckMaxSize).Expiration(seenAckMaxTime).LRU().Build(),
+		excludedReps:        new(sync.Map),
 
 		privateRecvBlocks: make(chan *consensus.BlockSource, common.DPoSMaxBlocks),
 		privateRecvRspCh:  make(chan *topic.EventPrivacyRecvRspMsg, common.DPoSMaxBlocks),
 	}
 
 	dps.pf.status.Store(perfTypeClose)
+	dps.quorumBase, dps.quorumPercent, dps.quorumMinReps = cfg.QuorumPolicy()
+	dps.updateVoteThreshold()
 
 	dps.acTrx.setDPoSService(dps)
 	for _, p := range dps.processors {
@@ -227,7 +241,7 @@ func NewDPoS(cfgFile string) *DPoS {
 func (dps *DPoS) Init() {
 	supply := config.GenesisBlock().Balance
 	dps.minVoteWeight, _ = supply.Div(common.DposVoteDivisor)
-	dps.voteThreshold, _ = supply.Div(2)
+	dps.loadEquivocations()
 
 	subscriber := event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
 		switch msg := c.Message().(type) {
@@ -237,10 +251,12 @@ func (dps *DPoS) Init() {
 			dps.onPovHeightChange(msg)
 		case *types.Tuple:
 			dps.onFrontierConfirmed(msg.First.(types.Hash), msg.Second.(*bool))
+		case *p2p.EventRepEquivocationMsg:
+			dps.onEquivocation(msg.Evidence, msg.From)
 		}
 	}), dps.eb)
 
-	if err := subscriber.Subscribe(topic.EventRollback, topic.EventPovConnectBestBlock); err != nil {
+	if err := subscriber.Subscribe(topic.EventRollback, topic.EventPovConnectBestBlock, topic.EventRepEquivocation); err != nil {
 		dps.logger.Errorf("failed to subscribe event %s", err)
 	} else {
 		dps.subscriber = subscriber
@@ -417,8 +433,10 @@ func (dps *DPoS) stat() {
 				dps.tps[i] = dps.tps[i-1]
 			}
 			dps.tps[0] = 0
+			dps.updateMetrics()
 		case <-dps.block2Ledger:
 			dps.tps[0]++
+			confirmedMeter.Mark(1)
 		}
 	}
 }
@@ -443,6 +461,8 @@ func (dps *DPoS) RPC(kind uint, in, out interface{}) {
 		go dps.feedBlocks()
 	case common.RpcDPoSDebug:
 		dps.debug()
+	case common.RpcDPoSConfirmDepth:
+		dps.onGetConfirmDepth(in, out)
 	}
 }
 
@@ -700,11 +720,18 @@ func (dps *DPoS) dispatchMsg(bs *consensus.BlockSource) {
 
 	if bs.Type == consensus.MsgConfirmAck {
 		ack := bs.Para.(*protos.ConfirmAckBlock)
+		if dps.isReplayedAck(ack) {
+			return
+		}
 		dps.saveOnlineRep(ack.Account)
 
 		if dps.getAckType(ack.Sequence) != ackTypeFindRep {
 			for _, h := range ack.Hash {
 				dps.logger.Infof("dps recv confirmAck block[%s]", h)
+				if tracing.Enabled() {
+					tracing.StartBlock(h, "DPoS.dispatchMsg", tracing.String("type", bs.Type.String()),
+						tracing.String("account", ack.Account.String())).End()
+				}
 				dps.heartAndVoteInc(h, ack.Account, onlineKindVote)
 
 				if has, _ := dps.ledger.HasStateBlockConfirmed(h); has {
@@ -714,6 +741,7 @@ func (dps *DPoS) dispatchMsg(bs *consensus.BlockSource) {
 				vi := &voteInfo{
 					hash:    h,
 					account: ack.Account,
+					ack:     ack,
 				}
 
 				val, err := dps.subAck.Get(vi.hash)
@@ -727,6 +755,10 @@ func (dps *DPoS) dispatchMsg(bs *consensus.BlockSource) {
 			dps.heartAndVoteInc(ack.Hash[0], ack.Account, onlineKindHeart)
 		}
 	} else {
+		if tracing.Enabled() {
+			span := tracing.StartBlock(bs.Block.GetHash(), "DPoS.dispatchMsg", tracing.String("type", bs.Type.String()))
+			defer span.End()
+		}
 		index := dps.getProcessorIndex(bs.Block.Address)
 
 		if bs.Type == consensus.MsgSync {
@@ -944,12 +976,16 @@ func (dps *DPoS) voteGenerateWithSeq(block *types.StateBlock, account types.Addr
 		hashBytes = append(hashBytes, h[:]...)
 	}
 	signHash, _ := types.HashBytes(hashBytes)
+	sig, err := acc.SignHash(signHash)
+	if err != nil {
+		return nil, fmt.Errorf("sign vote by %s: %s", account, err)
+	}
 
 	va := &protos.ConfirmAckBlock{
 		Sequence:  dps.getSeq(kind),
 		Hash:      hashes,
 		Account:   account,
-		Signature: acc.Sign(signHash),
+		Signature: sig,
 	}
 	return va, nil
 }
@@ -969,14 +1005,18 @@ out:
 
 			if total == hashNumPerAck {
 				hash, _ := types.HashBytes(hashBytes)
-				va := &protos.ConfirmAckBlock{
-					Sequence:  dps.getSeq(kind),
-					Hash:      hashes,
-					Account:   account,
-					Signature: acc.Sign(hash),
-				}
+				if sig, err := acc.SignHash(hash); err != nil {
+					dps.logger.Errorf("sign vote by %s: %s", account, err)
+				} else {
+					va := &protos.ConfirmAckBlock{
+						Sequence:  dps.getSeq(kind),
+						Hash:      hashes,
+						Account:   account,
+						Signature: sig,
+					}
 
-				dps.eb.Publish(topic.EventBroadcast, &p2p.EventBroadcastMsg{Type: p2p.ConfirmAck, Message: va})
+					dps.eb.Publish(topic.EventBroadcast, &p2p.EventBroadcastMsg{Type: p2p.ConfirmAck, Message: va})
+				}
 
 				total = 0
 				hashes = make([]types.Hash, 0)
@@ -992,11 +1032,16 @@ out:
 	}
 
 	hash, _ := types.HashBytes(hashBytes)
+	sig, err := acc.SignHash(hash)
+	if err != nil {
+		dps.logger.Errorf("sign vote by %s: %s", account, err)
+		return
+	}
 	va := &protos.ConfirmAckBlock{
 		Sequence:  dps.getSeq(kind),
 		Hash:      hashes,
 		Account:   account,
-		Signature: acc.Sign(hash),
+		Signature: sig,
 	}
 
 	dps.eb.Publish(topic.EventBroadcast, &p2p.EventBroadcastMsg{Type: p2p.ConfirmAck, Message: va})
@@ -1077,6 +1122,7 @@ func (dps *DPoS) cleanOnlineReps() {
 	})
 
 	_ = dps.ledger.SetOnlineRepresentations(repAddresses)
+	dps.updateVoteThreshold()
 }
 
 func (dps *DPoS) onRollback(hash types.Hash) {
@@ -1352,32 +1398,8 @@ func (dps *DPoS) updateLastProcessSyncTime() {
 
 func (dps *DPoS) info(in interface{}, out interface{}) {
 	outArgs := out.(map[string]interface{})
-	rootsNum := 0
-
-	pStats := make([]*processorStat, 0)
-	for _, p := range dps.processors {
-		ps := &processorStat{
-			Index:      p.index,
-			BlockQueue: len(p.blocks),
-			SyncQueue:  len(p.syncBlock),
-			AckQueue:   len(p.acks),
-		}
-
-		for _, dealt := range p.confirmedChain {
-			if !dealt {
-				ps.ChainQueue++
-			}
-		}
-
-		ps.ChainQueue += ConfirmChainParallelNum - int(p.confirmParallelNum)
-		pStats = append(pStats, ps)
-	}
-	outArgs["processors"] = pStats
-
-	dps.acTrx.roots.Range(func(key, value interface{}) bool {
-		rootsNum++
-		return true
-	})
+	outArgs["processors"] = dps.processorStats()
+	rootsNum := dps.rootsNum()
 
 	outArgs["err"] = nil
 	outArgs["tps"] = dps.tps
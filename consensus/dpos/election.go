@@ -8,11 +8,13 @@ import (
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 )
 
 type BlockReceivedVotes struct {
 	block   *types.StateBlock
 	balance types.Balance
+	reps    int
 }
 
 type electionStatus struct {
@@ -59,6 +61,7 @@ func (el *Election) voteAction(vi *voteInfo) {
 		return
 	}
 
+	el.checkEquivocation(vi)
 	result := el.vote.voteStatus(vi)
 	if result == confirm {
 		el.dps.logger.Infof("recv same ack %s", vi.account)
@@ -86,6 +89,7 @@ func (el *Election) voteFrontier(vi *voteInfo) bool {
 		return false
 	}
 
+	el.checkEquivocation(vi)
 	result := el.vote.voteStatus(vi)
 	if result == confirm {
 		el.dps.logger.Infof("recv same ack %s", vi.account)
@@ -98,16 +102,19 @@ func (el *Election) voteFrontier(vi *voteInfo) bool {
 	}
 
 	var balance = types.ZeroBalance
+	var winner *BlockReceivedVotes
 	for _, value := range t {
 		if balance.Compare(value.balance) == types.BalanceCompSmaller {
 			balance = value.balance
+			winner = value
 		}
 	}
 
-	if balance.Compare(el.dps.voteThreshold) == types.BalanceCompBigger {
+	if winner != nil && el.dps.reachQuorum(winner) {
 		if !el.ifValidAndSetInvalid() {
 			return true
 		}
+		el.dps.confirmDepths.set(winner.block.GetHash(), el.dps.confirmDepth(winner))
 
 		loser := make([]*types.StateBlock, 0)
 		el.blocks.Range(func(key, value interface{}) bool {
@@ -136,18 +143,24 @@ func (el *Election) haveQuorum() {
 
 	var balance = types.ZeroBalance
 	blk := new(types.StateBlock)
+	winner := &BlockReceivedVotes{block: blk, balance: balance}
 	for _, value := range t {
 		if balance.Compare(value.balance) == types.BalanceCompSmaller {
 			balance = value.balance
 			blk = value.block
+			winner = value
 		}
 	}
 
 	confirmedHash := blk.GetHash()
-	if balance.Compare(el.dps.voteThreshold) == types.BalanceCompBigger {
+	span := tracing.StartBlock(confirmedHash, "Election.haveQuorum", tracing.String("votes", balance.String()))
+	defer span.End()
+	if dps.reachQuorum(winner) {
 		if !el.ifValidAndSetInvalid() {
 			return
 		}
+		span.SetAttributes(tracing.String("confirmed", "true"))
+		dps.confirmDepths.set(confirmedHash, dps.confirmDepth(winner))
 
 		dps.acTrx.roots.Delete(el.vote.id)
 		el.dps.logger.Infof("hash:%s block has confirmed,total vote is [%s]", confirmedHash, balance)
@@ -173,7 +186,8 @@ func (el *Election) haveQuorum() {
 		dps.eb.Publish(topic.EventConfirmedBlock, blk)
 		el.cleanBlockInfo()
 	} else {
-		dps.logger.Infof("wait for enough rep vote for block [%s],current vote is [%s]", confirmedHash, balance)
+		dps.logger.Infof("wait for enough rep vote for block [%s],current vote is [%s] from %d reps", confirmedHash,
+			balance, winner.reps)
 	}
 }
 
@@ -197,6 +211,10 @@ func (el *Election) tally(isSync bool) map[types.Hash]*BlockReceivedVotes {
 
 		var weight types.Balance
 		repAddress := key.(types.Address)
+		if el.dps.isExcludedRep(repAddress) {
+			el.dps.logger.Warnf("rep[%s] ack block[%s] excluded for equivocation", repAddress, hash)
+			return true
+		}
 		if !isSync {
 			weight = el.dps.ledger.Weight(repAddress)
 			el.dps.logger.Infof("rep[%s] ack block[%s] weight[%s]", repAddress, hash, weight)
@@ -210,6 +228,7 @@ func (el *Election) tally(isSync bool) map[types.Hash]*BlockReceivedVotes {
 		}
 
 		totals[hash].balance = totals[hash].balance.Add(weight)
+		totals[hash].reps++
 		return true
 	})
 
@@ -0,0 +1,166 @@
+package dpos
+
+import (
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+const (
+	seenAckMaxSize = 102400
+	seenAckMaxTime = 10 * time.Minute
+)
+
+type seenAck struct {
+	signature types.Signature
+	sequence  uint32
+}
+
+// isReplayedAck reports whether the ack has been received with the same sequence. Reps sign the same
+// hashes to the same signature, so a rep voting again or sending heartbeats always uses a new sequence,
+// while an ack replayed by a peer reuses a stale one
+func (dps *DPoS) isReplayedAck(ack *protos.ConfirmAckBlock) bool {
+	key := seenAck{signature: ack.Signature, sequence: ack.Sequence}
+	if dps.seenAcks.Has(key) {
+		dps.logger.Debugf("drop replayed ack of rep[%s] with sequence %d", ack.Account, ack.Sequence)
+		return true
+	}
+	_ = dps.seenAcks.Set(key, struct{}{})
+	return false
+}
+
+// checkEquivocation builds the evidence when a rep changes its vote to another block of the election,
+// honest reps only vote for their local block of a fork
+func (el *Election) checkEquivocation(vi *voteInfo) {
+	if vi.ack == nil {
+		return
+	}
+
+	v, ok := el.vote.repVotes.Load(vi.account)
+	if !ok {
+		return
+	}
+	prev := v.(*voteInfo)
+	if prev.hash == vi.hash || prev.ack == nil {
+		return
+	}
+
+	b1, ok1 := el.blocks.Load(prev.hash)
+	b2, ok2 := el.blocks.Load(vi.hash)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	e := &types.Equivocation{
+		Account: vi.account,
+		Root:    types.VoteRoot(b1.(*types.StateBlock)),
+		First: &types.SignedVote{
+			Sequence:  prev.ack.Sequence,
+			Hashes:    prev.ack.Hash,
+			Signature: prev.ack.Signature,
+			Block:     b1.(*types.StateBlock),
+		},
+		Second: &types.SignedVote{
+			Sequence:  vi.ack.Sequence,
+			Hashes:    vi.ack.Hash,
+			Signature: vi.ack.Signature,
+			Block:     b2.(*types.StateBlock),
+		},
+		Timestamp: time.Now().Unix(),
+	}
+	el.dps.onEquivocation(e, "")
+}
+
+// onEquivocation verifies and records the evidence, excludes the rep from the vote tally and broadcasts
+// the evidence if it is new, from is the peer which sent the evidence or empty if it is detected locally
+func (dps *DPoS) onEquivocation(e *types.Equivocation, from string) {
+	if err := e.Verify(); err != nil {
+		dps.logger.Errorf("invalid equivocation of rep[%s] from [%s]: %s", e.Account, from, err)
+		if from != "" {
+			dps.eb.Publish(topic.EventPeerMisbehaviour, &topic.EventPeerMisbehaviourMsg{
+				PeerID: from,
+				Kind:   topic.MisbehaviourBadVote,
+				Reason: "invalid equivocation: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	// the exclusion starts from the time the evidence is received, the timestamp set by the peer is not trusted
+	now := time.Now()
+	e.Timestamp = now.Unix()
+	stored, err := dps.ledger.GetEquivocation(e.Account, e.Root)
+	switch {
+	case err == ledger.ErrEquivocationNotFound:
+		err = dps.ledger.AddEquivocation(e)
+	case err != nil:
+		dps.logger.Error(err)
+		return
+	case dps.exclusionEnd(stored).After(now) || sameVotes(stored, e):
+		// the rep is still excluded by the stored evidence, or the expired evidence is replayed
+		return
+	default:
+		// the stored evidence is expired, the new evidence on the root excludes the rep again
+		err = dps.ledger.UpdateEquivocation(e)
+	}
+	if err != nil {
+		dps.logger.Errorf("add equivocation of rep[%s]: %s", e.Account, err)
+		return
+	}
+
+	dps.logger.Warnf("rep[%s] voted for both [%s] and [%s] on root [%s]", e.Account, e.First.Block.GetHash(),
+		e.Second.Block.GetHash(), e.Root)
+	dps.excludeRep(e)
+	dps.eb.Publish(topic.EventBroadcast, &p2p.EventBroadcastMsg{Type: p2p.RepEquivocation, Message: e})
+}
+
+// exclusionEnd returns the time until which the evidence excludes the rep
+func (dps *DPoS) exclusionEnd(e *types.Equivocation) time.Time {
+	return time.Unix(e.Timestamp, 0).Add(dps.cfg.EquivocationExclusionTime())
+}
+
+// sameVotes reports whether both evidences are built from the same signed votes
+func sameVotes(e1, e2 *types.Equivocation) bool {
+	s1, s2 := e1.First.Signature, e1.Second.Signature
+	return (s1 == e2.First.Signature && s2 == e2.Second.Signature) ||
+		(s1 == e2.Second.Signature && s2 == e2.First.Signature)
+}
+
+func (dps *DPoS) excludeRep(e *types.Equivocation) {
+	until := dps.exclusionEnd(e)
+	if !until.After(time.Now()) {
+		return
+	}
+	if v, ok := dps.excludedReps.Load(e.Account); ok && v.(time.Time).After(until) {
+		return
+	}
+	dps.excludedReps.Store(e.Account, until)
+}
+
+// isExcludedRep reports whether the votes of the rep are excluded from the tally because of equivocation
+func (dps *DPoS) isExcludedRep(address types.Address) bool {
+	v, ok := dps.excludedReps.Load(address)
+	if !ok {
+		return false
+	}
+	if time.Now().After(v.(time.Time)) {
+		dps.excludedReps.Delete(address)
+		return false
+	}
+	return true
+}
+
+// loadEquivocations restores the exclusion of reps from the evidence in the ledger
+func (dps *DPoS) loadEquivocations() {
+	err := dps.ledger.GetEquivocations(func(e *types.Equivocation) error {
+		dps.excludeRep(e)
+		return nil
+	})
+	if err != nil {
+		dps.logger.Errorf("load equivocations: %s", err)
+	}
+}
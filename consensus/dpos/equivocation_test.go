@@ -0,0 +1,183 @@
+package dpos
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestIsReplayedAck(t *testing.T) {
+	dps := getTestDpos()
+	acc := mock.Account()
+	blk := mock.StateBlockWithoutWork()
+
+	ack, err := dps.voteGenerateWithSeq(blk, acc.Address(), acc, ackTypeCommon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dps.isReplayedAck(ack) {
+		t.Fatal("first ack is not replayed")
+	}
+	if !dps.isReplayedAck(ack) {
+		t.Fatal("ack with the same sequence is replayed")
+	}
+
+	// vote again for the same block
+	ack2, _ := dps.voteGenerateWithSeq(blk, acc.Address(), acc, ackTypeCommon)
+	if ack2.Signature != ack.Signature || dps.isReplayedAck(ack2) {
+		t.Fatal("ack with a new sequence is not replayed")
+	}
+}
+
+func TestElection_CheckEquivocation(t *testing.T) {
+	dps := getTestDpos()
+	rep := mock.Account()
+
+	blk1 := mock.StateBlockWithoutWork()
+	blk1.Type = types.Send
+	blk1.Previous = mock.Hash()
+	blk2 := blk1.Clone()
+	blk2.Balance = blk2.Balance.Add(types.NewBalance(1))
+	hash1 := blk1.GetHash()
+	hash2 := blk2.GetHash()
+
+	el := newElection(dps, blk1)
+	el.blocks.Store(hash2, blk2)
+
+	vote := func(blk *types.StateBlock) *voteInfo {
+		ack, err := dps.voteGenerateWithSeq(blk, rep.Address(), rep, ackTypeCommon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vi := &voteInfo{hash: blk.GetHash(), account: rep.Address(), ack: ack}
+		el.checkEquivocation(vi)
+		el.vote.voteStatus(vi)
+		return vi
+	}
+
+	vote(blk1)
+	vote(blk1)
+	if has, _ := dps.ledger.HasEquivocation(rep.Address(), blk1.Previous); has || dps.isExcludedRep(rep.Address()) {
+		t.Fatal("same vote is not equivocation")
+	}
+
+	// local votes carry no signature
+	local := mock.Account()
+	el.vote.voteStatus(&voteInfo{hash: hash1, account: local.Address()})
+	el.checkEquivocation(&voteInfo{hash: hash2, account: local.Address()})
+	if dps.isExcludedRep(local.Address()) {
+		t.Fatal("local vote is not equivocation")
+	}
+
+	vote(blk2)
+	e, err := dps.ledger.GetEquivocation(rep.Address(), blk1.Previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if e.First.Block.GetHash() != hash1 || e.Second.Block.GetHash() != hash2 {
+		t.Fatal("invalid evidence")
+	}
+	if !dps.isExcludedRep(rep.Address()) {
+		t.Fatal("rep should be excluded")
+	}
+	if v, ok := el.tally(false)[hash2]; ok && !v.balance.IsZero() {
+		t.Fatal("vote of excluded rep should not be tallied", v.balance)
+	}
+
+	// restored from the ledger
+	dps.excludedReps = new(sync.Map)
+	dps.loadEquivocations()
+	if !dps.isExcludedRep(rep.Address()) {
+		t.Fatal("exclusion should be restored")
+	}
+
+	// exclusion expired
+	dps.excludedReps.Store(rep.Address(), time.Now().Add(-time.Second))
+	if dps.isExcludedRep(rep.Address()) {
+		t.Fatal("exclusion should expire")
+	}
+}
+
+func TestDPoS_OnEquivocation(t *testing.T) {
+	dps := getTestDpos()
+	rep := mock.Account()
+
+	blk1 := mock.StateBlockWithoutWork()
+	blk2 := blk1.Clone()
+	blk2.Balance = blk2.Balance.Add(types.NewBalance(1))
+	ack1, _ := dps.voteGenerateWithSeq(blk1, rep.Address(), rep, ackTypeCommon)
+	ack2, _ := dps.voteGenerateWithSeq(blk2, rep.Address(), rep, ackTypeCommon)
+
+	e := &types.Equivocation{
+		Account:   rep.Address(),
+		Root:      types.VoteRoot(blk1),
+		First:     &types.SignedVote{Sequence: ack1.Sequence, Hashes: ack1.Hash, Signature: ack1.Signature, Block: blk1},
+		Second:    &types.SignedVote{Sequence: ack2.Sequence, Hashes: ack2.Hash, Signature: ack2.Signature, Block: blk1},
+		Timestamp: time.Now().Add(time.Hour).Unix(),
+	}
+
+	// both votes for blk1
+	dps.onEquivocation(e, "peer")
+	if has, _ := dps.ledger.HasEquivocation(rep.Address(), e.Root); has || dps.isExcludedRep(rep.Address()) {
+		t.Fatal("invalid evidence should be dropped")
+	}
+
+	e.Second.Block = blk2
+	dps.onEquivocation(e, "peer")
+	stored, err := dps.ledger.GetEquivocation(rep.Address(), e.Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Timestamp > time.Now().Unix() {
+		t.Fatal("timestamp of evidence should not be in the future")
+	}
+	if !dps.isExcludedRep(rep.Address()) {
+		t.Fatal("rep should be excluded")
+	}
+
+	// the exclusion starts from the receive time even if the peer sets a stale timestamp
+	rep2 := mock.Account()
+	ack3, _ := dps.voteGenerateWithSeq(blk1, rep2.Address(), rep2, ackTypeCommon)
+	ack4, _ := dps.voteGenerateWithSeq(blk2, rep2.Address(), rep2, ackTypeCommon)
+	e2 := &types.Equivocation{
+		Account: rep2.Address(),
+		Root:    e.Root,
+		First:   &types.SignedVote{Sequence: ack3.Sequence, Hashes: ack3.Hash, Signature: ack3.Signature, Block: blk1},
+		Second:  &types.SignedVote{Sequence: ack4.Sequence, Hashes: ack4.Hash, Signature: ack4.Signature, Block: blk2},
+	}
+	dps.onEquivocation(e2, "peer")
+	if !dps.isExcludedRep(rep2.Address()) {
+		t.Fatal("rep should be excluded by evidence with zero timestamp")
+	}
+
+	// the expired evidence does not block fresh evidence, but is not excluded again by replay
+	stored.Timestamp = 0
+	if err := dps.ledger.UpdateEquivocation(stored); err != nil {
+		t.Fatal(err)
+	}
+	dps.excludedReps.Delete(rep.Address())
+	replay := *e
+	dps.onEquivocation(&replay, "peer")
+	if dps.isExcludedRep(rep.Address()) {
+		t.Fatal("replayed evidence should be dropped")
+	}
+
+	blk3 := blk1.Clone()
+	blk3.Balance = blk3.Balance.Add(types.NewBalance(2))
+	ack5, _ := dps.voteGenerateWithSeq(blk3, rep.Address(), rep, ackTypeCommon)
+	fresh := *e
+	fresh.Second = &types.SignedVote{Sequence: ack5.Sequence, Hashes: ack5.Hash, Signature: ack5.Signature, Block: blk3}
+	dps.onEquivocation(&fresh, "peer")
+	if !dps.isExcludedRep(rep.Address()) {
+		t.Fatal("rep should be excluded by fresh evidence")
+	}
+	if stored, _ := dps.ledger.GetEquivocation(rep.Address(), e.Root); stored.Second.Block.GetHash() != blk3.GetHash() {
+		t.Fatal("expired evidence should be replaced")
+	}
+}
@@ -0,0 +1,62 @@
+package dpos
+
+import (
+	"fmt"
+
+	"github.com/rcrowley/go-metrics"
+
+	"github.com/qlcchain/go-qlc/monitor"
+)
+
+var (
+	confirmedMeter = metrics.GetOrRegisterMeter("dpos.confirmed", monitor.SystemRegistry)
+	tpsGauge       = metrics.GetOrRegisterGauge("dpos.tps", monitor.SystemRegistry)
+	rootsGauge     = metrics.GetOrRegisterGauge("dpos.roots", monitor.SystemRegistry)
+	recvQueueGauge = metrics.GetOrRegisterGauge("dpos.queue.recv", monitor.SystemRegistry)
+)
+
+func (dps *DPoS) processorStats() []*processorStat {
+	pStats := make([]*processorStat, 0)
+	for _, p := range dps.processors {
+		ps := &processorStat{
+			Index:      p.index,
+			BlockQueue: len(p.blocks),
+			SyncQueue:  len(p.syncBlock),
+			AckQueue:   len(p.acks),
+		}
+
+		for _, dealt := range p.confirmedChain {
+			if !dealt {
+				ps.ChainQueue++
+			}
+		}
+
+		ps.ChainQueue += ConfirmChainParallelNum - int(p.confirmParallelNum)
+		pStats = append(pStats, ps)
+	}
+	return pStats
+}
+
+func (dps *DPoS) rootsNum() int {
+	rootsNum := 0
+	dps.acTrx.roots.Range(func(key, value interface{}) bool {
+		rootsNum++
+		return true
+	})
+	return rootsNum
+}
+
+// updateMetrics samples queue depths of processors and the confirmed blocks per second of the last stat interval
+func (dps *DPoS) updateMetrics() {
+	for _, ps := range dps.processorStats() {
+		prefix := fmt.Sprintf("dpos.processor.%d.", ps.Index)
+		metrics.GetOrRegisterGauge(prefix+"blockQueue", monitor.SystemRegistry).Update(int64(ps.BlockQueue))
+		metrics.GetOrRegisterGauge(prefix+"syncQueue", monitor.SystemRegistry).Update(int64(ps.SyncQueue))
+		metrics.GetOrRegisterGauge(prefix+"ackQueue", monitor.SystemRegistry).Update(int64(ps.AckQueue))
+		metrics.GetOrRegisterGauge(prefix+"chainQueue", monitor.SystemRegistry).Update(int64(ps.ChainQueue))
+	}
+
+	tpsGauge.Update(int64(dps.tps[1]))
+	rootsGauge.Update(int64(dps.rootsNum()))
+	recvQueueGauge.Update(int64(len(dps.recvBlocks)))
+}
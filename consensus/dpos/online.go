@@ -214,6 +214,18 @@ func (dps *DPoS) isOnline(addr types.Address) bool {
 	return false
 }
 
+// generateOnlineBlock signs the online block by the account, the private key may be kept by the wallet or a remote signer
+func (dps *DPoS) generateOnlineBlock(acc *types.Account, povHeight uint64) (*types.StateBlock, error) {
+	blk, err := dps.ledger.GenerateOnlineBlock(acc.Address(), nil, povHeight)
+	if err != nil {
+		return nil, err
+	}
+	if err := dps.ledger.SignStateBlock(blk, acc); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
 func (dps *DPoS) sendOnline(povHeight uint64) {
 	dps.localRepAccount.Range(func(key, value interface{}) bool {
 		acc := value.(*types.Account)
@@ -223,7 +235,7 @@ func (dps *DPoS) sendOnline(povHeight uint64) {
 			return true
 		}
 
-		blk, err := dps.ledger.GenerateOnlineBlock(acc.Address(), acc.PrivateKey(), povHeight)
+		blk, err := dps.generateOnlineBlock(acc, povHeight)
 		if err != nil {
 			dps.logger.Error("generate online block err", err)
 			return true
@@ -248,7 +260,7 @@ func (dps *DPoS) sendOnlineWithAccount(acc *types.Account, povHeight uint64) {
 		return
 	}
 
-	blk, err := dps.ledger.GenerateOnlineBlock(acc.Address(), acc.PrivateKey(), povHeight)
+	blk, err := dps.generateOnlineBlock(acc, povHeight)
 	if err != nil {
 		dps.logger.Error("generate online block err", err)
 		return
@@ -2,6 +2,7 @@ package dpos
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -510,10 +511,13 @@ func (p *Processor) processResult(result process.ProcessResult, bs *consensus.Bl
 		}
 	case process.BadSignature:
 		dps.logger.Errorf("Bad signature for block: %s", hash)
+		p.penalizeSender(result, bs)
 	case process.BadWork:
 		dps.logger.Errorf("Bad work for block: %s", hash)
+		p.penalizeSender(result, bs)
 	case process.BalanceMismatch:
 		dps.logger.Errorf("Balance mismatch for block: %s", hash)
+		p.penalizeSender(result, bs)
 	case process.Old:
 		dps.logger.Debugf("Old for block: %s", hash)
 	case process.UnReceivable:
@@ -523,6 +527,7 @@ func (p *Processor) processResult(result process.ProcessResult, bs *consensus.Bl
 		// dps.processGapSmartContract(blk)
 	case process.InvalidData:
 		dps.logger.Errorf("InvalidData for block: %s", hash)
+		p.penalizeSender(result, bs)
 	case process.Other:
 		dps.logger.Errorf("UnKnow process result for block: %s", hash)
 	case process.Fork:
@@ -549,6 +554,18 @@ func (p *Processor) processResult(result process.ProcessResult, bs *consensus.Bl
 	}
 }
 
+// penalizeSender reports the peer which sent an invalid block to the p2p layer
+func (p *Processor) penalizeSender(result process.ProcessResult, bs *consensus.BlockSource) {
+	if bs.From == "" {
+		return
+	}
+	p.dps.eb.Publish(topic.EventPeerMisbehaviour, &topic.EventPeerMisbehaviourMsg{
+		PeerID: bs.From,
+		Kind:   topic.MisbehaviourInvalidBlock,
+		Reason: fmt.Sprintf("%s block %s", result, bs.Block.GetHash()),
+	})
+}
+
 func (p *Processor) confirmBlock(blk *types.StateBlock) {
 	hash := blk.GetHash()
 	vk := getVoteKey(blk)
@@ -0,0 +1,90 @@
+package dpos
+
+import (
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+// ConfirmDepth is the vote status of a block, Votes and Weight are of the reps voting for the block
+type ConfirmDepth struct {
+	Votes     int           `json:"votes"`
+	Weight    types.Balance `json:"weight"`
+	Threshold types.Balance `json:"threshold"`
+	MinReps   int           `json:"minReps"`
+}
+
+// updateVoteThreshold recomputes the weight a block needs to be confirmed from the quorum policy,
+// the online base falls back to the supply until online reps are found
+func (dps *DPoS) updateVoteThreshold() {
+	weight := config.GenesisBlock().Balance
+	if dps.quorumBase == config.QuorumBaseOnline {
+		if online := dps.onlineWeight(); !online.IsZero() {
+			weight = online
+		}
+	}
+
+	threshold, _ := weight.Mul(dps.quorumPercent).Div(100)
+	dps.voteThreshold.Store(threshold)
+}
+
+// onlineWeight sums the weight of online reps and local reps
+func (dps *DPoS) onlineWeight() types.Balance {
+	reps := make(map[types.Address]struct{})
+	dps.onlineReps.Range(func(key, value interface{}) bool {
+		reps[key.(types.Address)] = struct{}{}
+		return true
+	})
+	dps.localRepAccount.Range(func(key, value interface{}) bool {
+		reps[key.(types.Address)] = struct{}{}
+		return true
+	})
+
+	weight := types.ZeroBalance
+	for addr := range reps {
+		w := dps.ledger.Weight(addr)
+		if w.Compare(dps.minVoteWeight) != types.BalanceCompSmaller {
+			weight = weight.Add(w)
+		}
+	}
+	return weight
+}
+
+func (dps *DPoS) getVoteThreshold() types.Balance {
+	return dps.voteThreshold.Load().(types.Balance)
+}
+
+// reachQuorum reports whether the votes of a block exceed the threshold and come from enough reps
+func (dps *DPoS) reachQuorum(votes *BlockReceivedVotes) bool {
+	return votes.balance.Compare(dps.getVoteThreshold()) == types.BalanceCompBigger && votes.reps >= dps.quorumMinReps
+}
+
+func (dps *DPoS) confirmDepth(votes *BlockReceivedVotes) *ConfirmDepth {
+	return &ConfirmDepth{
+		Votes:     votes.reps,
+		Weight:    votes.balance,
+		Threshold: dps.getVoteThreshold(),
+		MinReps:   dps.quorumMinReps,
+	}
+}
+
+// onGetConfirmDepth reports the votes of a block confirmed recently or still in election
+func (dps *DPoS) onGetConfirmDepth(in interface{}, out interface{}) {
+	hash := in.(types.Hash)
+	depth := out.(*ConfirmDepth)
+
+	if v := dps.confirmDepths.get(hash); v != nil {
+		*depth = *v.(*ConfirmDepth)
+		return
+	}
+
+	*depth = ConfirmDepth{
+		Weight:    types.ZeroBalance,
+		Threshold: dps.getVoteThreshold(),
+		MinReps:   dps.quorumMinReps,
+	}
+	if el, ok := dps.hash2el.Load(hash); ok {
+		if votes, ok := el.(*Election).tally(false)[hash]; ok {
+			*depth = *dps.confirmDepth(votes)
+		}
+	}
+}
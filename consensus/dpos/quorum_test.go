@@ -0,0 +1,94 @@
+package dpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestUpdateVoteThreshold(t *testing.T) {
+	dps := getTestDpos()
+	supply := config.GenesisBlock().Balance
+
+	half, _ := supply.Div(2)
+	if !dps.getVoteThreshold().Equal(half) {
+		t.Fatal("default threshold should be half of supply", dps.getVoteThreshold())
+	}
+
+	dps.minVoteWeight = types.NewBalance(1000)
+	dps.quorumBase = config.QuorumBaseOnline
+	dps.quorumPercent = 67
+	dps.updateVoteThreshold()
+	expected, _ := supply.Mul(67).Div(100)
+	if !dps.getVoteThreshold().Equal(expected) {
+		t.Fatal("online threshold should fall back to supply", dps.getVoteThreshold())
+	}
+
+	reps := []types.Balance{types.NewBalance(3000), types.NewBalance(6000), types.NewBalance(100)}
+	for _, w := range reps {
+		account := mock.Account()
+		benefit := types.ZeroBenefit.Clone()
+		benefit.Vote = w
+		benefit.Total = w
+		_ = dps.ledger.AddRepresentation(account.Address(), benefit, dps.ledger.Cache().GetCache())
+		dps.onlineReps.Store(account.Address(), time.Now().Add(repTimeout).Unix())
+	}
+	dps.updateVoteThreshold()
+	// the rep below the min vote weight is ignored
+	if !dps.getVoteThreshold().Equal(types.NewBalance(6030)) {
+		t.Fatal("invalid online threshold", dps.getVoteThreshold())
+	}
+}
+
+func TestReachQuorum(t *testing.T) {
+	dps := getTestDpos()
+	dps.voteThreshold.Store(types.NewBalance(100))
+
+	votes := &BlockReceivedVotes{balance: types.NewBalance(100), reps: 1}
+	if dps.reachQuorum(votes) {
+		t.Fatal("votes should exceed the threshold")
+	}
+	votes.balance = types.NewBalance(101)
+	if !dps.reachQuorum(votes) {
+		t.Fatal("votes exceed the threshold")
+	}
+
+	dps.quorumMinReps = 2
+	if dps.reachQuorum(votes) {
+		t.Fatal("not enough reps")
+	}
+	votes.reps = 2
+	if !dps.reachQuorum(votes) {
+		t.Fatal("enough reps")
+	}
+}
+
+func TestOnGetConfirmDepth(t *testing.T) {
+	dps := getTestDpos()
+	dps.voteThreshold.Store(types.NewBalance(100))
+
+	blk := mock.StateBlockWithoutWork()
+	hash := blk.GetHash()
+	depth := new(ConfirmDepth)
+	dps.onGetConfirmDepth(hash, depth)
+	if depth.Votes != 0 || !depth.Weight.IsZero() || !depth.Threshold.Equal(types.NewBalance(100)) {
+		t.Fatal("unknown block", depth)
+	}
+
+	el := newElection(dps, blk)
+	rep := mock.Address()
+	el.vote.voteStatus(&voteInfo{hash: hash, account: rep})
+	dps.onGetConfirmDepth(hash, depth)
+	if depth.Votes != 1 {
+		t.Fatal("block in election", depth)
+	}
+
+	dps.confirmDepths.set(hash, &ConfirmDepth{Votes: 3, Weight: types.NewBalance(200), Threshold: types.NewBalance(100)})
+	dps.onGetConfirmDepth(hash, depth)
+	if depth.Votes != 3 || !depth.Weight.Equal(types.NewBalance(200)) {
+		t.Fatal("confirmed block", depth)
+	}
+}
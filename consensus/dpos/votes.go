@@ -18,6 +18,7 @@ const (
 type voteInfo struct {
 	account types.Address
 	hash    types.Hash
+	ack     *protos.ConfirmAckBlock // signed ack of the vote, nil for the votes of local reps
 }
 
 type Votes struct {
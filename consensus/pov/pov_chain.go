@@ -22,6 +22,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/trie"
 )
 
 const (
@@ -181,6 +182,9 @@ func (bc *PovBlockChain) Init() error {
 
 func (bc *PovBlockChain) Start() error {
 	common.Go(bc.statLoop)
+	if bc.config != nil && bc.config.PoV != nil && bc.config.PoV.StateGC != nil && bc.config.PoV.StateGC.Enabled {
+		common.Go(bc.stateGCLoop)
+	}
 	return nil
 }
 
@@ -204,6 +208,32 @@ func (bc *PovBlockChain) statLoop() {
 	}
 }
 
+func (bc *PovBlockChain) stateGCLoop() {
+	cfg := bc.config.PoV.StateGC
+	interval := time.Duration(cfg.Interval) * time.Second
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	checkTicker := time.NewTicker(interval)
+	defer checkTicker.Stop()
+	gc := statedb.NewPovStateGC(bc.getLedger(), cfg.KeepRoots, true)
+
+	for {
+		select {
+		case <-bc.quitCh:
+			return
+
+		case <-checkTicker.C:
+			r, err := gc.Run(trie.GCLock())
+			if err != nil {
+				bc.logger.Errorf("state gc failed, err %s", err)
+				continue
+			}
+			bc.logger.Infof("state gc removed %d trie nodes, reclaimed %d bytes in %s", r.Removed, r.ReclaimedBytes, r.Duration)
+		}
+	}
+}
+
 func (bc *PovBlockChain) onMinerDayStatTimer() {
 	if !bc.doingMinerStat.CAS(false, true) {
 		return
@@ -698,11 +728,13 @@ func (bc *PovBlockChain) InsertBlock(block *types.PovBlock, gsdb *statedb.PovGlo
 	var forkBlock *types.PovBlock
 	startTm := time.Now()
 
+	trie.GCLock().RLock()
 	err := bc.getLedger().DBStore().BatchWrite(true, func(batch storage.Batch) error {
 		var dbErr error
 		chainState, forkBlock, dbErr = bc.insertBlock(batch, block, gsdb)
 		return dbErr
 	})
+	trie.GCLock().RUnlock()
 
 	if err != nil {
 		bc.logger.Errorf("failed to insert block %d/%s to chain, err %s", block.GetHeight(), block.GetHash(), err)
@@ -1357,6 +1389,7 @@ func (bc *PovBlockChain) updateDiffDayStatInSync(forkBlock *types.PovBlock) {
 		blkDiffRatioFlt := float64(0)
 		blkDiffRatioUint := uint64(0)
 		blkTime := uint32(0)
+		algoTotalTargets := make(map[string]*big.Int)
 		for height := dayStartHeight; height <= dayEndHeight; height++ {
 			header, err := bc.getLedger().GetPovHeaderByHeight(height)
 			if err != nil {
@@ -1387,12 +1420,36 @@ func (bc *PovBlockChain) updateDiffDayStatInSync(forkBlock *types.PovBlock) {
 			}
 
 			totalTarget = new(big.Int).Add(totalTarget, blkNormTarget)
+
+			algoName := header.GetAlgoType().String()
+			algoStat := dayStat.AlgoStats[algoName]
+			if algoStat == nil {
+				algoStat = new(types.PovDiffAlgoStatItem)
+				dayStat.AlgoStats[algoName] = algoStat
+				algoTotalTargets[algoName] = big.NewInt(0)
+			}
+			algoStat.BlockNum++
+
+			blkAlgoTarget := header.GetAlgoTargetInt()
+			blkDiffRatioUint = uint64(types.CalcDifficultyRatioByBigInt(blkAlgoTarget, common.PovPowLimitInt))
+			if algoStat.MaxDiffRatio == 0 || blkDiffRatioUint > algoStat.MaxDiffRatio {
+				algoStat.MaxDiffRatio = blkDiffRatioUint
+			}
+			if algoStat.MinDiffRatio == 0 || blkDiffRatioUint < algoStat.MinDiffRatio {
+				algoStat.MinDiffRatio = blkDiffRatioUint
+			}
+			algoTotalTargets[algoName] = new(big.Int).Add(algoTotalTargets[algoName], blkAlgoTarget)
 		}
 
 		avgTarget := new(big.Int).Div(totalTarget, big.NewInt(int64(blkCount)))
 		diffRatio := types.CalcDifficultyRatioByBigInt(avgTarget, common.PovPowLimitInt)
 		dayStat.AvgDiffRatio = uint64(diffRatio)
 
+		for algoName, algoStat := range dayStat.AlgoStats {
+			algoAvgTarget := new(big.Int).Div(algoTotalTargets[algoName], big.NewInt(int64(algoStat.BlockNum)))
+			algoStat.AvgDiffRatio = uint64(types.CalcDifficultyRatioByBigInt(algoAvgTarget, common.PovPowLimitInt))
+		}
+
 		err = bc.getLedger().AddPovDiffStat(dayStat)
 		if err != nil {
 			bc.logger.Errorf("failed to add pov difficulty stat, day %d, err %s", dayStat.DayIndex, err)
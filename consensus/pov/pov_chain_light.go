@@ -0,0 +1,108 @@
+package pov
+
+import (
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// InitLight loads the chain of a light client, only headers, heights and total difficulties are stored
+func (bc *PovBlockChain) InitLight() error {
+	genesisBlock, _ := bc.getLedger().GetPovBlockByHeight(0)
+	if genesisBlock == nil {
+		bc.logger.Warn("pov genesis block not exist in ledger, resetting chain")
+		if err := bc.ResetChainState(); err != nil {
+			return err
+		}
+	} else if !bc.IsGenesisBlock(genesisBlock) {
+		return fmt.Errorf("pov genesis block %s not same in ledger, please check it", genesisBlock.GetHash())
+	} else {
+		bc.genesisBlock = genesisBlock
+	}
+
+	latestHeader, err := bc.getLedger().GetLatestPovHeader()
+	if err != nil {
+		return fmt.Errorf("failed to get latest header, err %s", err)
+	}
+	bc.storeLatestHeader(latestHeader)
+
+	bc.logger.Infof("loaded latest header %d/%s", latestHeader.GetHeight(), latestHeader.GetHash())
+	return nil
+}
+
+func (bc *PovBlockChain) storeLatestHeader(header *types.PovHeader) {
+	bc.latestBlock.Store(&types.PovBlock{Header: *header})
+	bc.latestHeader.Store(header)
+
+	_ = bc.heightHeaderCache.Set(header.GetHeight(), header)
+}
+
+// InsertHeader inserts a verified header into the chain of a light client, the best chain is chosen by total difficulty
+func (bc *PovBlockChain) InsertHeader(header *types.PovHeader) (ChainState, error) {
+	hash := header.GetHash()
+	height := header.GetHeight()
+	if bc.HasHeader(hash, height) {
+		return ChainStateNone, nil
+	}
+	if height == 0 {
+		return ChainStateNone, ErrPovInvalidHeight
+	}
+
+	prevTD := bc.GetBlockTDByHashAndHeight(header.GetPrevious(), height-1)
+	if prevTD == nil {
+		return ChainStateNone, ErrPovUnknownAncestor
+	}
+	td := bc.CalcTotalDifficulty(prevTD, header)
+
+	if err := bc.getLedger().AddPovHeader(header); err != nil {
+		return ChainStateNone, err
+	}
+	if err := bc.getLedger().AddPovHeight(hash, height); err != nil {
+		return ChainStateNone, err
+	}
+	if err := bc.getLedger().AddPovTD(hash, height, td); err != nil {
+		return ChainStateNone, err
+	}
+	_ = bc.hashHeaderCache.Set(hash, header)
+	_ = bc.hashTdCache.Set(hash, td)
+
+	latestHeader := bc.LatestHeader()
+	latestTD := bc.GetBlockTDByHashAndHeight(latestHeader.GetHash(), latestHeader.GetHeight())
+	if latestTD != nil && td.Chain.Cmp(&latestTD.Chain) <= 0 {
+		return ChainStateSide, nil
+	}
+
+	err := bc.getLedger().DBStore().BatchWrite(true, func(batch storage.Batch) error {
+		// walk back to the fork point and make the new branch best
+		for cur := header; ; {
+			bestHash, _ := bc.getLedger().GetPovBestHash(cur.GetHeight())
+			if bestHash == cur.GetHash() {
+				break
+			}
+			if err := bc.getLedger().AddPovBestHash(cur.GetHeight(), cur.GetHash(), batch); err != nil {
+				return err
+			}
+			bc.heightHeaderCache.Remove(cur.GetHeight())
+
+			if cur = bc.GetHeaderByHash(cur.GetPrevious()); cur == nil {
+				return ErrPovInvalidFork
+			}
+		}
+
+		for h := height + 1; h <= latestHeader.GetHeight(); h++ {
+			if err := bc.getLedger().DeletePovBestHash(h, batch); err != nil {
+				return err
+			}
+			bc.heightHeaderCache.Remove(h)
+		}
+
+		return bc.getLedger().SetPovLatestHeight(height, batch)
+	})
+	if err != nil {
+		return ChainStateNone, err
+	}
+
+	bc.storeLatestHeader(header)
+	return ChainStateMain, nil
+}
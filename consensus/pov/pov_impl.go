@@ -42,6 +42,7 @@ type PoVEngine struct {
 	cs       ConsensusPov
 	verifier *PovVerifier
 	syncer   *PovSyncer
+	light    *PovLightClient
 
 	quitCh         chan struct{}
 	febRpcMsgCh    chan *topic.EventRPCSyncCallMsg
@@ -68,6 +69,13 @@ func NewPovEngine(cfgFile string, fakePow bool) (*PoVEngine, error) {
 	pov.blkRecvCache = gcache.New(blkCacheSize).Simple().Expiration(blkCacheExpireTime).Build()
 
 	pov.chain = NewPovBlockChain(cfg, pov.eb, pov.ledger)
+	if pov.isLightMode() {
+		pov.cs = NewPovConsensus(PovConsensusModePow, pov.chain)
+		pov.verifier = NewPovVerifier(l, pov.chain, pov.cs)
+		pov.light = NewPovLightClient(pov.eb, pov.chain, pov.verifier)
+		return pov, nil
+	}
+
 	pov.txpool = NewPovTxPool(pov.eb, pov.ledger, pov.chain)
 	if fakePow {
 		pov.cs = NewPovConsensus(PovConsensusModeFake, pov.chain)
@@ -82,7 +90,21 @@ func NewPovEngine(cfgFile string, fakePow bool) (*PoVEngine, error) {
 	return pov, nil
 }
 
+func (pov *PoVEngine) isLightMode() bool {
+	return pov.cfg != nil && pov.cfg.PoV != nil && pov.cfg.PoV.LightMode
+}
+
 func (pov *PoVEngine) Init() error {
+	if pov.light != nil {
+		if err := pov.chain.InitLight(); err != nil {
+			return err
+		}
+		if err := pov.cs.Init(); err != nil {
+			return err
+		}
+		return pov.light.Init()
+	}
+
 	err := pov.bp.Init()
 	if err != nil {
 		return err
@@ -115,6 +137,13 @@ func (pov *PoVEngine) Init() error {
 func (pov *PoVEngine) Start() error {
 	pov.logger.Info("start pov engine service")
 
+	if pov.light != nil {
+		if err := pov.cs.Start(); err != nil {
+			return err
+		}
+		return pov.light.Start()
+	}
+
 	err := pov.txpool.Start()
 	if err != nil {
 		return err
@@ -148,6 +177,12 @@ func (pov *PoVEngine) Start() error {
 func (pov *PoVEngine) Stop() error {
 	pov.logger.Info("stop pov engine service")
 
+	if pov.light != nil {
+		pov.light.Stop()
+		_ = pov.cs.Stop()
+		return nil
+	}
+
 	close(pov.quitCh)
 
 	pov.unsetEvent()
@@ -197,6 +232,27 @@ func (pov *PoVEngine) GetVerifier() *PovVerifier {
 	return pov.verifier
 }
 
+func (pov *PoVEngine) GetLightClient() *PovLightClient {
+	return pov.light
+}
+
+func (pov *PoVEngine) RPC(kind uint, in, out interface{}) {
+	switch kind {
+	case common.RpcPovLightState:
+		pov.onGetLightState(in, out)
+	}
+}
+
+func (pov *PoVEngine) onGetLightState(in, out interface{}) {
+	req := in.(*PovLightStateReq)
+	rsp := out.(*PovLightStateRsp)
+	if pov.light == nil {
+		rsp.Err = ErrLightDisabled
+		return
+	}
+	rsp.Value, rsp.Err = pov.light.GetState(req.BlockHash, req.Key, req.SubKey)
+}
+
 func (pov *PoVEngine) GetAccounts() []*types.Account {
 	return pov.accounts
 }
@@ -216,6 +272,13 @@ func (pov *PoVEngine) AddBlock(block *types.PovBlock, from types.PovBlockFrom, p
 	stat := pov.verifier.VerifyNet(block)
 	if stat.Result != process.Progress {
 		pov.logger.Infof("block %s verify net err %s", blockHash, stat.ErrMsg)
+		if peerID != "" {
+			pov.eb.Publish(topic.EventPeerMisbehaviour, &topic.EventPeerMisbehaviourMsg{
+				PeerID: peerID,
+				Kind:   topic.MisbehaviourInvalidPovBlock,
+				Reason: fmt.Sprintf("block %s verify net err %s", blockHash, stat.ErrMsg),
+			})
+		}
 		return fmt.Errorf("block %s verify net err %s", blockHash, stat.ErrMsg)
 	}
 
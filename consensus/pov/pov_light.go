@@ -0,0 +1,361 @@
+package pov
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+var (
+	LightSyncTime     = 10 * time.Second
+	LightProofTimeout = 30 * time.Second
+)
+
+var (
+	ErrLightDisabled      = errors.New("pov light mode is not enabled")
+	ErrLightNoPeer        = errors.New("no full peer available")
+	ErrLightUnknownHeader = errors.New("header not found in light chain")
+	ErrLightProofTimeout  = errors.New("state proof request timeout")
+)
+
+// PovLightStateReq is the input of common.RpcPovLightState, see PovLightClient.GetState
+type PovLightStateReq struct {
+	BlockHash types.Hash
+	Key       []byte
+	SubKey    []byte
+}
+
+// PovLightStateRsp is the output of common.RpcPovLightState
+type PovLightStateRsp struct {
+	Value []byte
+	Err   error
+}
+
+// PovLightClient follows the best chain by PoV headers only, and fetches state from full peers
+// with merkle proofs which are verified against the state hash of the local headers
+type PovLightClient struct {
+	eb         event.EventBus
+	subscriber *event.ActorSubscriber
+	chain      *PovBlockChain
+	verifier   *PovVerifier
+	logger     *zap.SugaredLogger
+
+	allPeers   sync.Map // map[string]*PovSyncPeer
+	syncPeerID string
+
+	proofSeqID   *atomic.Uint64
+	proofWaiters sync.Map // map[uint64]chan *protos.PovStateProofRsp
+
+	messageCh chan *PovSyncMessage
+	quitCh    chan struct{}
+}
+
+func NewPovLightClient(eb event.EventBus, chain *PovBlockChain, verifier *PovVerifier) *PovLightClient {
+	return &PovLightClient{
+		eb:         eb,
+		chain:      chain,
+		verifier:   verifier,
+		logger:     log.NewLogger("pov_light"),
+		proofSeqID: atomic.NewUint64(0),
+		messageCh:  make(chan *PovSyncMessage, 2000),
+		quitCh:     make(chan struct{}),
+	}
+}
+
+func (lc *PovLightClient) Init() error {
+	eb := lc.eb
+	if eb != nil {
+		lc.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+			switch msg := c.Message().(type) {
+			case *topic.EventAddP2PStreamMsg:
+				lc.onAddP2PStream(msg.PeerID)
+			case *topic.EventDeleteP2PStreamMsg:
+				lc.allPeers.Delete(msg.PeerID)
+			case *p2p.EventPovPeerStatusMsg:
+				lc.onPovStatus(msg.Status, msg.From)
+			case *p2p.EventPovBulkPullRspMsg:
+				lc.messageCh <- &PovSyncMessage{msgValue: msg.Resp, msgPeer: msg.From}
+			case *topic.EventPovRecvBlockMsg:
+				lc.messageCh <- &PovSyncMessage{msgValue: msg.Block, msgPeer: msg.MsgPeer}
+			case *p2p.EventPovStateProofRspMsg:
+				lc.onPovStateProofRsp(msg.Resp)
+			}
+		}), eb)
+
+		if err := lc.subscriber.Subscribe(topic.EventAddP2PStream, topic.EventDeleteP2PStream, topic.EventPovPeerStatus,
+			topic.EventPovBulkPullRsp, topic.EventPovRecvBlock, topic.EventPovStateProofRsp); err != nil {
+			lc.logger.Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lc *PovLightClient) Start() error {
+	common.Go(lc.mainLoop)
+	return nil
+}
+
+func (lc *PovLightClient) Stop() {
+	if lc.subscriber != nil {
+		if err := lc.subscriber.UnsubscribeAll(); err != nil {
+			lc.logger.Error(err)
+		}
+	}
+
+	close(lc.quitCh)
+}
+
+func (lc *PovLightClient) mainLoop() {
+	syncTicker := time.NewTicker(LightSyncTime)
+	defer syncTicker.Stop()
+
+	for {
+		select {
+		case <-lc.quitCh:
+			return
+
+		case <-syncTicker.C:
+			lc.requestHeaders()
+
+		case msg := <-lc.messageCh:
+			switch v := msg.msgValue.(type) {
+			case *protos.PovBulkPullRsp:
+				lc.processPovBulkPullRsp(v, msg.msgPeer)
+			case *types.PovBlock:
+				lc.processPovBlock(v, msg.msgPeer)
+			}
+		}
+	}
+}
+
+func (lc *PovLightClient) onAddP2PStream(peerID string) {
+	lc.allPeers.Store(peerID, &PovSyncPeer{
+		peerID:         peerID,
+		currentTD:      big.NewInt(0),
+		lastStatusTime: time.Now(),
+		status:         peerStatusInit,
+	})
+}
+
+func (lc *PovLightClient) onPovStatus(status *protos.PovStatus, msgPeer string) {
+	v, ok := lc.allPeers.Load(msgPeer)
+	if !ok {
+		return
+	}
+	peer := v.(*PovSyncPeer)
+
+	genBlk := lc.chain.GenesisBlock()
+	if genBlk == nil || status.GenesisHash != genBlk.GetHash() {
+		lc.logger.Warnf("peer %s genesis hash %s is invalid", msgPeer, status.GenesisHash)
+		return
+	}
+
+	peer.currentHash = status.CurrentHash
+	peer.currentHeight = status.CurrentHeight
+	peer.currentTD = new(big.Int).SetBytes(status.CurrentTD)
+	peer.timestamp = status.Timestamp
+	peer.lastStatusTime = time.Now()
+	peer.status = peerStatusGood
+}
+
+func (lc *PovLightClient) bestPeer() *PovSyncPeer {
+	var best *PovSyncPeer
+	lc.allPeers.Range(func(key, value interface{}) bool {
+		peer := value.(*PovSyncPeer)
+		if peer.status != peerStatusGood {
+			return true
+		}
+		if best == nil || peer.currentTD.Cmp(best.currentTD) > 0 {
+			best = peer
+		}
+		return true
+	})
+	return best
+}
+
+func (lc *PovLightClient) requestHeaders() {
+	peer := lc.bestPeer()
+	if peer == nil {
+		return
+	}
+
+	latestHeader := lc.chain.LatestHeader()
+	latestTD := lc.chain.GetBlockTDByHashAndHeight(latestHeader.GetHash(), latestHeader.GetHeight())
+	if latestTD != nil && peer.currentTD.Cmp(&latestTD.Chain.Int) <= 0 {
+		return
+	}
+
+	req := &protos.PovBulkPullReq{
+		PullType:   protos.PovPullTypeForward,
+		Reason:     protos.PovReasonSync,
+		Locators:   lc.chain.GetBlockLocator(types.ZeroHash),
+		Count:      maxSyncBlockPerReq,
+		HeaderOnly: true,
+	}
+
+	lc.syncPeerID = peer.peerID
+
+	lc.logger.Infof("request headers from peer %s, local %d, remote %d",
+		peer.peerID, latestHeader.GetHeight(), peer.currentHeight)
+	lc.eb.Publish(topic.EventSendMsgToSingle,
+		&p2p.EventSendMsgToSingleMsg{Type: p2p.PovBulkPullReq, Message: req, PeerID: peer.peerID})
+}
+
+func (lc *PovLightClient) processPovBulkPullRsp(rsp *protos.PovBulkPullRsp, msgPeer string) {
+	if rsp.Reason != protos.PovReasonSync || msgPeer != lc.syncPeerID {
+		return
+	}
+
+	headers := rsp.Headers
+	if len(headers) == 0 {
+		// peers which do not know header only requests reply full blocks
+		for _, block := range rsp.Blocks {
+			headers = append(headers, block.GetHeader())
+		}
+	}
+	lc.logger.Infof("recv %d headers from peer %s", len(headers), msgPeer)
+
+	inserted := 0
+	for _, header := range headers {
+		if lc.chain.HasHeader(header.GetHash(), header.GetHeight()) {
+			continue
+		}
+		if err := lc.insertHeader(header); err != nil {
+			lc.logger.Warnf("header %d/%s from peer %s, %s", header.GetHeight(), header.GetHash(), msgPeer, err)
+			return
+		}
+		inserted++
+	}
+
+	if inserted > 0 {
+		lc.requestHeaders()
+	}
+}
+
+func (lc *PovLightClient) processPovBlock(block *types.PovBlock, msgPeer string) {
+	header := block.GetHeader()
+	if lc.chain.HasHeader(header.GetHash(), header.GetHeight()) {
+		return
+	}
+
+	if err := lc.insertHeader(header); err != nil {
+		lc.logger.Debugf("header %d/%s from peer %s, %s", header.GetHeight(), header.GetHash(), msgPeer, err)
+	}
+}
+
+func (lc *PovLightClient) insertHeader(header *types.PovHeader) error {
+	stat := lc.verifier.VerifyHeader(header)
+	if stat.Result != process.Progress {
+		return fmt.Errorf("verify err %s %s", stat.Result, stat.ErrMsg)
+	}
+
+	_, err := lc.chain.InsertHeader(header)
+	return err
+}
+
+func (lc *PovLightClient) onPovStateProofRsp(rsp *protos.PovStateProofRsp) {
+	if v, ok := lc.proofWaiters.Load(rsp.ID); ok {
+		select {
+		case v.(chan *protos.PovStateProofRsp) <- rsp:
+		default:
+		}
+	}
+}
+
+// GetState returns the value of key in the global state at blockHash, when subKey is set key must be a
+// contract state key and the value of subKey in the contract state is returned, nil means not exist
+func (lc *PovLightClient) GetState(blockHash types.Hash, key []byte, subKey []byte) ([]byte, error) {
+	header := lc.chain.GetHeaderByHash(blockHash)
+	if header == nil {
+		return nil, ErrLightUnknownHeader
+	}
+
+	peer := lc.bestPeer()
+	if peer == nil {
+		return nil, ErrLightNoPeer
+	}
+
+	req := &protos.PovStateProofReq{
+		ID:        lc.proofSeqID.Inc(),
+		BlockHash: blockHash,
+		Key:       key,
+		SubKey:    subKey,
+	}
+	rspCh := make(chan *protos.PovStateProofRsp, 1)
+	lc.proofWaiters.Store(req.ID, rspCh)
+	defer lc.proofWaiters.Delete(req.ID)
+
+	lc.eb.Publish(topic.EventSendMsgToSingle,
+		&p2p.EventSendMsgToSingleMsg{Type: p2p.PovStateProofReq, Message: req, PeerID: peer.peerID})
+
+	var rsp *protos.PovStateProofRsp
+	select {
+	case rsp = <-rspCh:
+	case <-time.After(LightProofTimeout):
+		return nil, ErrLightProofTimeout
+	case <-lc.quitCh:
+		return nil, ErrLightProofTimeout
+	}
+
+	if rsp.Error != "" {
+		return nil, fmt.Errorf("peer %s: %s", peer.peerID, rsp.Error)
+	}
+	if rsp.BlockHash != blockHash {
+		return nil, fmt.Errorf("peer %s: block hash %s not match", peer.peerID, rsp.BlockHash)
+	}
+	return statedb.PovVerifyState(header.GetStateHash(), key, subKey, rsp.Proof, rsp.SubProof)
+}
+
+func (lc *PovLightClient) GetAccountState(address types.Address, blockHash types.Hash) (*types.PovAccountState, error) {
+	value, err := lc.GetState(blockHash, statedb.PovCreateAccountStateKey(address), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	as := types.NewPovAccountState()
+	if err := as.Deserialize(value); err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+func (lc *PovLightClient) GetRepState(address types.Address, blockHash types.Hash) (*types.PovRepState, error) {
+	value, err := lc.GetState(blockHash, statedb.PovCreateRepStateKey(address), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	rs := types.NewPovRepState()
+	if err := rs.Deserialize(value); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+func (lc *PovLightClient) GetContractValue(address types.Address, key []byte, blockHash types.Hash) ([]byte, error) {
+	return lc.GetState(blockHash, statedb.PovCreateContractStateKey(address), key)
+}
@@ -0,0 +1,104 @@
+package pov
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestPovChain_InsertHeader(t *testing.T) {
+	teardownTestCase, md := setupPovChainTestCase(t)
+	defer teardownTestCase(t)
+
+	md.config.PoV.LightMode = true
+	chain := NewPovBlockChain(md.config, md.eb, md.ledger)
+	if err := chain.InitLight(); err != nil {
+		t.Fatal(err)
+	}
+	genesisBlk := chain.GenesisBlock()
+	if chain.LatestHeader().GetHash() != genesisBlk.GetHash() {
+		t.Fatal("genesis hash invalid")
+	}
+
+	blk1, _ := mock.GeneratePovBlock(genesisBlk, 0)
+	blk2, _ := mock.GeneratePovBlock(blk1, 0)
+	for _, blk := range []*types.PovBlock{blk1, blk2} {
+		if cs, err := chain.InsertHeader(blk.GetHeader()); err != nil || cs != ChainStateMain {
+			t.Fatal(cs, err)
+		}
+	}
+	if cs, err := chain.InsertHeader(blk2.GetHeader()); err != nil || cs != ChainStateNone {
+		t.Fatal(cs, err)
+	}
+
+	side2, _ := mock.GeneratePovBlock(blk1, 0)
+	side2.Header.BasHdr.Timestamp++
+	side2.Header.BasHdr.Hash = side2.ComputeHash()
+	if cs, err := chain.InsertHeader(side2.GetHeader()); err != nil || cs != ChainStateSide {
+		t.Fatal(cs, err)
+	}
+	if chain.LatestHeader().GetHash() != blk2.GetHash() {
+		t.Fatal("latest header should not change")
+	}
+
+	side3, _ := mock.GeneratePovBlock(side2, 0)
+	if cs, err := chain.InsertHeader(side3.GetHeader()); err != nil || cs != ChainStateMain {
+		t.Fatal(cs, err)
+	}
+	if chain.LatestHeader().GetHash() != side3.GetHash() {
+		t.Fatal("latest header should be side3")
+	}
+	if hdr := chain.GetHeaderByHeight(2); hdr == nil || hdr.GetHash() != side2.GetHash() {
+		t.Fatal("best header at height 2 should be side2")
+	}
+
+	orphan, _ := mock.GeneratePovBlock(blk2, 0)
+	orphan.Header.BasHdr.Previous = mock.Hash()
+	if _, err := chain.InsertHeader(orphan.GetHeader()); err != ErrPovUnknownAncestor {
+		t.Fatal(err)
+	}
+
+	reload := NewPovBlockChain(md.config, md.eb, md.ledger)
+	if err := reload.InitLight(); err != nil {
+		t.Fatal(err)
+	}
+	if reload.LatestHeader().GetHash() != side3.GetHash() {
+		t.Fatal("latest header not loaded")
+	}
+}
+
+func TestPovVerifier_VerifyHeader(t *testing.T) {
+	teardownTestCase, md := setupPovChainTestCase(t)
+	defer teardownTestCase(t)
+
+	chain := NewPovBlockChain(md.config, md.eb, md.ledger)
+	if err := chain.InitLight(); err != nil {
+		t.Fatal(err)
+	}
+	cs := NewPovConsensus(PovConsensusModeFake, chain)
+	verifier := NewPovVerifier(md.ledger, chain, cs)
+
+	blk1, _ := mock.GeneratePovBlockByFakePow(chain.GenesisBlock(), 0)
+	if stat := verifier.VerifyHeader(blk1.GetHeader()); stat.Result != process.Progress {
+		t.Fatal(stat.Result, stat.ErrMsg)
+	}
+
+	blk2, _ := mock.GeneratePovBlockByFakePow(blk1, 0)
+	if stat := verifier.VerifyHeader(blk2.GetHeader()); stat.Result != process.GapPrevious {
+		t.Fatal(stat.Result, stat.ErrMsg)
+	}
+
+	bad := blk1.GetHeader().Copy()
+	bad.BasHdr.Nonce++
+	bad.BasHdr.Hash = bad.ComputeHash()
+	if stat := verifier.VerifyHeader(bad); stat.Result != process.BadConsensus {
+		t.Fatal(stat.Result, stat.ErrMsg)
+	}
+
+	bad.BasHdr.Hash = mock.Hash()
+	if stat := verifier.VerifyHeader(bad); stat.Result != process.BadHash {
+		t.Fatal(stat.Result, stat.ErrMsg)
+	}
+}
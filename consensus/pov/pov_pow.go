@@ -77,6 +77,10 @@ func (c *ConsensusPow) verifyProducer(header *types.PovHeader) error {
 	if cfg.PoV.ChainParams.MinerPledge.Sign() <= 0 {
 		return nil
 	}
+	// light client has no state trie to check the miner pledge
+	if cfg.PoV.LightMode {
+		return nil
+	}
 	pledgeAmount := cfg.PoV.ChainParams.MinerPledge
 
 	if header.GetHeight() < common.PovMinerVerifyHeightStart {
@@ -1,6 +1,7 @@
 package pov
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/statedb"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/ledger"
@@ -112,11 +114,13 @@ func (ss *PovSyncer) Init() error {
 				ss.onPovBulkPullReq(msg.Req, msg.From)
 			case *p2p.EventPovBulkPullRspMsg:
 				ss.onPovBulkPullRsp(msg.Resp, msg.From)
+			case *p2p.EventPovStateProofReqMsg:
+				ss.onPovStateProofReq(msg.Req, msg.From)
 			}
 		}), eb)
 
 		if err := ss.subscriber.Subscribe(topic.EventAddP2PStream, topic.EventDeleteP2PStream, topic.EventPovPeerStatus,
-			topic.EventPovBulkPullReq, topic.EventPovBulkPullRsp); err != nil {
+			topic.EventPovBulkPullReq, topic.EventPovBulkPullRsp, topic.EventPovStateProofReq); err != nil {
 			ss.logger.Error(err)
 			return err
 		}
@@ -170,12 +174,18 @@ func (ss *PovSyncer) onPovBulkPullRsp(rsp *protos.PovBulkPullRsp, msgPeer string
 	ss.messageCh <- &PovSyncMessage{msgValue: rsp, msgPeer: msgPeer}
 }
 
+func (ss *PovSyncer) onPovStateProofReq(req *protos.PovStateProofReq, msgPeer string) {
+	ss.messageCh <- &PovSyncMessage{msgValue: req, msgPeer: msgPeer}
+}
+
 func (ss *PovSyncer) processMessage(msg *PovSyncMessage) {
 	switch v := msg.msgValue.(type) {
 	case *protos.PovBulkPullReq:
 		ss.processPovBulkPullReq(msg)
 	case *protos.PovBulkPullRsp:
 		ss.processPovBulkPullRsp(msg)
+	case *protos.PovStateProofReq:
+		ss.processPovStateProofReq(msg)
 	default:
 		ss.logger.Infof("unknown message value type %T!\n", v)
 	}
@@ -239,7 +249,7 @@ func (ss *PovSyncer) processPovBulkPullReqByForward(msg *PovSyncMessage) {
 			ss.logger.Debugf("failed to locate best block %s", req.Locators[0])
 			return
 		}
-		rsp.Blocks = append(rsp.Blocks, block)
+		ss.addBulkPullRspBlock(rsp, block, req.HeaderOnly)
 		startHeight = block.GetHeight() + 1
 		blockCount--
 	} else if !req.StartHash.IsZero() {
@@ -248,7 +258,7 @@ func (ss *PovSyncer) processPovBulkPullReqByForward(msg *PovSyncMessage) {
 			ss.logger.Debugf("failed to get block by hash %s", req.StartHash)
 			return
 		}
-		rsp.Blocks = append(rsp.Blocks, block)
+		ss.addBulkPullRspBlock(rsp, block, req.HeaderOnly)
 		startHeight = block.GetHeight() + 1
 		blockCount--
 	}
@@ -263,15 +273,14 @@ func (ss *PovSyncer) processPovBulkPullReqByForward(msg *PovSyncMessage) {
 			ss.logger.Debugf("failed to get block by height %d", height)
 			break
 		}
-		rsp.Blocks = append(rsp.Blocks, block)
 
-		curBlkMsgSize += block.Msgsize()
+		curBlkMsgSize += ss.addBulkPullRspBlock(rsp, block, req.HeaderOnly)
 		if curBlkMsgSize >= maxBlockSize {
 			break
 		}
 	}
 
-	rsp.Count = uint32(len(rsp.Blocks))
+	rsp.Count = uint32(len(rsp.Blocks) + len(rsp.Headers))
 
 	ss.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
 		Type:    p2p.PovBulkPullRsp,
@@ -308,7 +317,7 @@ func (ss *PovSyncer) processPovBulkPullReqByBackward(msg *PovSyncMessage) {
 			ss.logger.Debugf("failed to locate best block %s", req.Locators[0])
 			return
 		}
-		rsp.Blocks = append(rsp.Blocks, block)
+		ss.addBulkPullRspBlock(rsp, block, req.HeaderOnly)
 
 		if block.GetHeight() > 1 {
 			startHeight = block.GetHeight() - 1
@@ -323,7 +332,7 @@ func (ss *PovSyncer) processPovBulkPullReqByBackward(msg *PovSyncMessage) {
 			ss.logger.Debugf("failed to get block by hash %s", req.StartHash)
 			return
 		}
-		rsp.Blocks = append(rsp.Blocks, block)
+		ss.addBulkPullRspBlock(rsp, block, req.HeaderOnly)
 
 		if block.GetHeight() > 1 {
 			startHeight = block.GetHeight() - 1
@@ -347,15 +356,14 @@ func (ss *PovSyncer) processPovBulkPullReqByBackward(msg *PovSyncMessage) {
 			ss.logger.Debugf("failed to get block by height %d, err %s", height, err)
 			break
 		}
-		rsp.Blocks = append(rsp.Blocks, block)
 
-		curBlkMsgSize += block.Msgsize()
+		curBlkMsgSize += ss.addBulkPullRspBlock(rsp, block, req.HeaderOnly)
 		if curBlkMsgSize >= maxBlockSize {
 			break
 		}
 	}
 
-	rsp.Count = uint32(len(rsp.Blocks))
+	rsp.Count = uint32(len(rsp.Blocks) + len(rsp.Headers))
 
 	ss.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
 		Type:    p2p.PovBulkPullRsp,
@@ -364,6 +372,17 @@ func (ss *PovSyncer) processPovBulkPullReqByBackward(msg *PovSyncMessage) {
 	})
 }
 
+// addBulkPullRspBlock appends the block or only its header for light clients, and returns the message size
+func (ss *PovSyncer) addBulkPullRspBlock(rsp *protos.PovBulkPullRsp, block *types.PovBlock, headerOnly bool) int {
+	if headerOnly {
+		header := block.GetHeader()
+		rsp.Headers = append(rsp.Headers, header)
+		return header.Msgsize()
+	}
+	rsp.Blocks = append(rsp.Blocks, block)
+	return block.Msgsize()
+}
+
 func (ss *PovSyncer) processPovBulkPullReqByBatch(msg *PovSyncMessage) {
 	req := msg.msgValue.(*protos.PovBulkPullReq)
 
@@ -454,6 +473,30 @@ func (ss *PovSyncer) processPovBulkPullRsp(msg *PovSyncMessage) {
 	}
 }
 
+func (ss *PovSyncer) processPovStateProofReq(msg *PovSyncMessage) {
+	req := msg.msgValue.(*protos.PovStateProofReq)
+
+	ss.logger.Debugf("recv PovStateProofReq from peer %s, id %d block %s", msg.msgPeer, req.ID, req.BlockHash)
+
+	rsp := &protos.PovStateProofRsp{ID: req.ID, BlockHash: req.BlockHash}
+
+	header, err := ss.ledger.GetPovHeaderByHash(req.BlockHash)
+	if err != nil {
+		rsp.Error = fmt.Sprintf("failed to get header %s, err %s", req.BlockHash, err)
+	} else {
+		rsp.Proof, rsp.SubProof, err = statedb.PovProveState(ss.ledger.DBStore(), header.GetStateHash(), req.Key, req.SubKey)
+		if err != nil {
+			rsp.Error = err.Error()
+		}
+	}
+
+	ss.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.PovStateProofRsp,
+		Message: rsp,
+		PeerID:  msg.msgPeer,
+	})
+}
+
 func (ss *PovSyncer) processEvent(evt *PovSyncEvent) {
 	switch evt.eventType {
 	case topic.EventAddP2PStream:
@@ -290,6 +290,17 @@ func TestPovSync_BulkPullReq1(t *testing.T) {
 		t.Fatalf("failed to get Message 2 Count & Hash")
 	}
 
+	req2.HeaderOnly = true
+	povSync.onPovBulkPullReq(req2, bestPeer.peerID)
+	time.Sleep(10 * time.Millisecond)
+
+	if rsp == nil {
+		t.Fatalf("failed to get Message 3 msg")
+	}
+	if rsp.Count == 0 || len(rsp.Blocks) != 0 || rsp.Headers[0].GetHash() != blk1.GetHash() {
+		t.Fatalf("failed to get Message 3 Count & Header")
+	}
+
 	_ = subscriber.UnsubscribeAll()
 	povSync.Stop()
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/rcrowley/go-metrics"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -18,6 +19,8 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/monitor"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 )
 
 const (
@@ -28,6 +31,8 @@ const (
 	maxSelectWaitTimeInPool = 120 * time.Second
 )
 
+var txPoolSizeGauge = metrics.GetOrRegisterGauge("pov.txpool.size", monitor.SystemRegistry)
+
 type PovTxEvent struct {
 	txHash  types.Hash
 	txBlock *types.StateBlock
@@ -137,6 +142,8 @@ func (tp *PovTxPool) Stop() {
 }
 
 func (tp *PovTxPool) onAddStateBlock(block *types.StateBlock) {
+	span := tracing.StartBlock(block.GetHash(), "PovTxPool.onAddStateBlock", tracing.String("event", string(topic.EventAddRelation)))
+	defer span.End()
 	if !tp.isPovSyncDone() {
 		return
 	}
@@ -427,6 +434,7 @@ func (tp *PovTxPool) addTx(txHash types.Hash, txBlock *types.StateBlock) {
 		accTxList.PushBack(txEntry)
 	}
 	tp.allTxs[txHash] = txEntry
+	txPoolSizeGauge.Update(int64(len(tp.allTxs)))
 
 	tp.lastUpdated = time.Now()
 }
@@ -438,6 +446,7 @@ func (tp *PovTxPool) delTx(txHash types.Hash) {
 	}
 
 	delete(tp.allTxs, txHash)
+	txPoolSizeGauge.Update(int64(len(tp.allTxs)))
 
 	if txEntry == nil {
 		return
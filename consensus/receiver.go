@@ -63,6 +63,7 @@ func (r *Receiver) ReceivePublish(blk *types.StateBlock, msgFrom string) {
 		Block:     blk,
 		BlockFrom: types.UnSynchronized,
 		Type:      MsgPublishReq,
+		From:      msgFrom,
 	}
 	r.c.ca.ProcessMsg(bs)
 }
@@ -75,6 +76,7 @@ func (r *Receiver) ReceiveConfirmReq(blk []*types.StateBlock, msgFrom string) {
 			Block:     b,
 			BlockFrom: types.UnSynchronized,
 			Type:      MsgConfirmReq,
+			From:      msgFrom,
 		}
 		r.c.ca.ProcessMsg(bs)
 	}
@@ -86,12 +88,18 @@ func (r *Receiver) ReceiveConfirmAck(ack *protos.ConfirmAckBlock, msgFrom string
 	valid := IsAckSignValidate(ack)
 	if !valid {
 		r.c.logger.Error("ack sign err")
+		r.eb.Publish(topic.EventPeerMisbehaviour, &topic.EventPeerMisbehaviourMsg{
+			PeerID: msgFrom,
+			Kind:   topic.MisbehaviourBadVote,
+			Reason: "invalid signature of confirm ack",
+		})
 		return
 	}
 
 	bs := &BlockSource{
 		Type: MsgConfirmAck,
 		Para: ack,
+		From: msgFrom,
 	}
 	r.c.ca.ProcessMsg(bs)
 }
@@ -15,11 +15,28 @@ const (
 	MsgGenerateBlock
 )
 
+var msgTypes = [...]string{
+	MsgPublishReq:    "publishReq",
+	MsgConfirmReq:    "confirmReq",
+	MsgConfirmAck:    "confirmAck",
+	MsgSync:          "sync",
+	MsgGenerateBlock: "generateBlock",
+}
+
+func (m MsgType) String() string {
+	if int(m) >= len(msgTypes) {
+		return "unknown"
+	}
+	return msgTypes[m]
+}
+
 type BlockSource struct {
 	Block     *types.StateBlock
 	BlockFrom types.SynchronizedKind
 	Type      MsgType
 	Para      interface{}
+	// ID of the peer which sent the block, empty if the block is not received from the network
+	From string
 }
 
 func IsAckSignValidate(va *protos.ConfirmAckBlock) bool {
@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package lyra2 implements the Lyra2 password hashing scheme with the sponge based on Blake2b,
+// as used by the Lyra2 family of proof of work algorithms.
+//
+// This code is a port of the Lyra2 reference implementation by Marcos A. Simplicio Jr. et al.
+package lyra2
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	blockLenInt64           = 12 // block length of the sponge: 768 bits
+	blockLenBlake2SafeInt64 = 8  // block length used to absorb the input: 512 bits
+	blockLenBlake2SafeBytes = blockLenBlake2SafeInt64 * 8
+)
+
+var blake2bIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b,
+	0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f,
+	0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+type sponge [16]uint64
+
+func rotr64(w uint64, c uint) uint64 {
+	return (w >> c) | (w << (64 - c))
+}
+
+func g(v *sponge, a, b, c, d int) {
+	v[a] = v[a] + v[b]
+	v[d] = rotr64(v[d]^v[a], 32)
+	v[c] = v[c] + v[d]
+	v[b] = rotr64(v[b]^v[c], 24)
+	v[a] = v[a] + v[b]
+	v[d] = rotr64(v[d]^v[a], 16)
+	v[c] = v[c] + v[d]
+	v[b] = rotr64(v[b]^v[c], 63)
+}
+
+// round is one round of the Blake2b compression function, without the message
+func (s *sponge) round() {
+	g(s, 0, 4, 8, 12)
+	g(s, 1, 5, 9, 13)
+	g(s, 2, 6, 10, 14)
+	g(s, 3, 7, 11, 15)
+	g(s, 0, 5, 10, 15)
+	g(s, 1, 6, 11, 12)
+	g(s, 2, 7, 8, 13)
+	g(s, 3, 4, 9, 14)
+}
+
+func (s *sponge) permute() {
+	for i := 0; i < 12; i++ {
+		s.round()
+	}
+}
+
+// absorb xors the first n words of in into the state and applies the full permutation
+func (s *sponge) absorb(in []uint64, n int) {
+	for i := 0; i < n; i++ {
+		s[i] ^= in[i]
+	}
+	s.permute()
+}
+
+func (s *sponge) squeeze(out []byte) {
+	buf := make([]byte, blockLenInt64*8)
+	for len(out) > 0 {
+		for i := 0; i < blockLenInt64; i++ {
+			binary.LittleEndian.PutUint64(buf[i*8:], s[i])
+		}
+		n := copy(out, buf)
+		out = out[n:]
+		s.permute()
+	}
+}
+
+// reducedSqueezeRow0 fills M[0] from the last column to the first
+func (s *sponge) reducedSqueezeRow0(rowOut []uint64, nCols int) {
+	for col := nCols - 1; col >= 0; col-- {
+		copy(rowOut[col*blockLenInt64:(col+1)*blockLenInt64], s[:blockLenInt64])
+		s.round()
+	}
+}
+
+// reducedDuplexRow1 fills M[1] from M[0], columns of M[1] are written in reverse order
+func (s *sponge) reducedDuplexRow1(rowIn, rowOut []uint64, nCols int) {
+	for col := 0; col < nCols; col++ {
+		in := rowIn[col*blockLenInt64:]
+		out := rowOut[(nCols-1-col)*blockLenInt64:]
+		for i := 0; i < blockLenInt64; i++ {
+			s[i] ^= in[i]
+		}
+		s.round()
+		for i := 0; i < blockLenInt64; i++ {
+			out[i] = in[i] ^ s[i]
+		}
+	}
+}
+
+// reducedDuplexRowSetup fills M[row] from M[prev] and M[row*], and updates M[row*]
+func (s *sponge) reducedDuplexRowSetup(rowIn, rowInOut, rowOut []uint64, nCols int) {
+	for col := 0; col < nCols; col++ {
+		in := rowIn[col*blockLenInt64:]
+		inOut := rowInOut[col*blockLenInt64:]
+		out := rowOut[(nCols-1-col)*blockLenInt64:]
+		for i := 0; i < blockLenInt64; i++ {
+			s[i] ^= in[i] + inOut[i]
+		}
+		s.round()
+		for i := 0; i < blockLenInt64; i++ {
+			out[i] = in[i] ^ s[i]
+		}
+		s.rotWXor(inOut)
+	}
+}
+
+// reducedDuplexRow updates M[row] and M[row*] from M[prev] and M[row*]
+func (s *sponge) reducedDuplexRow(rowIn, rowInOut, rowOut []uint64, nCols int) {
+	for col := 0; col < nCols; col++ {
+		in := rowIn[col*blockLenInt64:]
+		inOut := rowInOut[col*blockLenInt64:]
+		out := rowOut[col*blockLenInt64:]
+		for i := 0; i < blockLenInt64; i++ {
+			s[i] ^= in[i] + inOut[i]
+		}
+		s.round()
+		for i := 0; i < blockLenInt64; i++ {
+			out[i] ^= s[i]
+		}
+		s.rotWXor(inOut)
+	}
+}
+
+// rotWXor xors the bitrate of the state rotated by one word into block
+func (s *sponge) rotWXor(block []uint64) {
+	block[0] ^= s[blockLenInt64-1]
+	for i := 1; i < blockLenInt64; i++ {
+		block[i] ^= s[i-1]
+	}
+}
+
+// Sum derives a key of len(k) bytes from pwd and salt into k, the memory matrix has nRows rows of nCols columns,
+// nRows must be a power of 2, pwd and salt must be a multiple of 8 bytes.
+// The basil is kLen || pwdlen || saltlen || timeCost || nRows || nCols.
+func Sum(k, pwd, salt []byte, timeCost uint64, nRows, nCols int) error {
+	if nRows < 2 || nRows&(nRows-1) != 0 {
+		return errors.New("lyra2: nRows should be a power of 2")
+	}
+	if nCols < 1 || len(pwd)%8 != 0 || len(salt)%8 != 0 {
+		return errors.New("lyra2: invalid parameters")
+	}
+
+	rowLen := blockLenInt64 * nCols
+	nBlocksInput := (len(salt)+len(pwd)+6*8)/blockLenBlake2SafeBytes + 1
+	size := nRows * rowLen
+	if n := nBlocksInput * blockLenBlake2SafeInt64; n > size {
+		size = n
+	}
+	matrix := make([]uint64, size)
+	m := make([][]uint64, nRows)
+	for i := range m {
+		m[i] = matrix[i*rowLen : (i+1)*rowLen]
+	}
+
+	// pad(pwd || salt || basil) with 10*1 padding, the matrix holds it temporarily
+	p := 0
+	for i := 0; i < len(pwd); i += 8 {
+		matrix[p] = binary.LittleEndian.Uint64(pwd[i:])
+		p++
+	}
+	for i := 0; i < len(salt); i += 8 {
+		matrix[p] = binary.LittleEndian.Uint64(salt[i:])
+		p++
+	}
+	for _, v := range []uint64{uint64(len(k)), uint64(len(pwd)), uint64(len(salt)), timeCost, uint64(nRows), uint64(nCols)} {
+		matrix[p] = v
+		p++
+	}
+	matrix[p] = 0x80
+	matrix[nBlocksInput*blockLenBlake2SafeInt64-1] ^= 0x0100000000000000
+
+	// setup phase
+	var s sponge
+	copy(s[8:], blake2bIV[:])
+	for i := 0; i < nBlocksInput; i++ {
+		s.absorb(matrix[i*blockLenBlake2SafeInt64:], blockLenBlake2SafeInt64)
+	}
+
+	s.reducedSqueezeRow0(m[0], nCols)
+	s.reducedDuplexRow1(m[0], m[1], nCols)
+
+	mask := uint64(nRows - 1)
+	var row, prev, rowa uint64 = 2, 1, 0
+	var step, window, gap uint64 = 1, 2, 1
+	for ; row < uint64(nRows); row++ {
+		s.reducedDuplexRowSetup(m[prev], m[rowa], m[row], nCols)
+		rowa = (rowa + step) & (window - 1)
+		prev = row
+		if rowa == 0 {
+			// gap alternates between 1 and -1
+			step = window + gap
+			window *= 2
+			gap = -gap
+		}
+	}
+
+	// wandering phase
+	row = 0
+	for tau := uint64(1); tau <= timeCost; tau++ {
+		step = uint64(nRows/2 - 1)
+		if tau%2 == 0 {
+			step = mask // -1
+		}
+		for {
+			rowa = s[0] & mask
+			s.reducedDuplexRow(m[prev], m[rowa], m[row], nCols)
+			prev = row
+			row = (row + step) & mask
+			if row == 0 {
+				break
+			}
+		}
+	}
+
+	// wrap-up phase
+	s.absorb(m[rowa], blockLenInt64)
+	s.squeeze(k)
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package lyra2
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// the lyra2 stage of the published Lyra2REv2 vector, "test" padded to 80 bytes hashes to
+// 5f21d7763b1ae8fc87db7dc993ddc50468765729411ba6b24906de15851a4abf
+func TestSum_Lyra2REv2(t *testing.T) {
+	in, _ := hex.DecodeString("57719c0f0f5e539a3aee7fc8b8c2278f02db35626e4936bcbfb9440c70d2c77f")
+	k := make([]byte, 32)
+	if err := Sum(k, in, in, 1, 4, 4); err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(k); got != "207056912fc672f10902ea9c56ed37543fad2d213b3e9ecf848b7f068b391cfe" {
+		t.Fatal(got)
+	}
+}
+
+// the vectors are cross-checked with an independent port of the Lyra2 reference implementation
+func TestSum(t *testing.T) {
+	pwd := make([]byte, 32)
+	for i := range pwd {
+		pwd[i] = byte(i)
+	}
+
+	tests := []struct {
+		timeCost uint64
+		nRows    int
+		nCols    int
+		want     string
+	}{
+		// parameters of Lyra2REv2
+		{1, 4, 4, "6e30062cecbe4c53612da9305a36d7e89ca9983efcf86498596d1751e718aa73"},
+		// parameters of Lyra2Z
+		{8, 8, 8, "7bf350a4ca416352e2a4ff6d77d2b07aec78e1f4a4044f3752bff2b77f00d456"},
+	}
+	for _, tt := range tests {
+		k := make([]byte, 32)
+		if err := Sum(k, pwd, pwd, tt.timeCost, tt.nRows, tt.nCols); err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(k); got != tt.want {
+			t.Fatalf("lyra2(%d, %d, %d) = %s, want %s", tt.timeCost, tt.nRows, tt.nCols, got, tt.want)
+		}
+	}
+}
+
+func TestSum_Params(t *testing.T) {
+	k := make([]byte, 32)
+	pwd := make([]byte, 32)
+	if err := Sum(k, pwd, pwd, 1, 6, 4); err == nil {
+		t.Fatal("nRows should be a power of 2")
+	}
+	if err := Sum(k, pwd[:31], pwd, 1, 4, 4); err == nil {
+		t.Fatal("password should be a multiple of 8 bytes")
+	}
+	if err := Sum(k, pwd, pwd, 1, 4, 0); err == nil {
+		t.Fatal("nCols should be positive")
+	}
+}
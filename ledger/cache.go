@@ -15,6 +15,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 )
 
 type MemoryCache struct {
@@ -430,7 +431,17 @@ func (c *Cache) dumpToLevelDb(key []byte, v interface{}, b storage.Batch) error
 
 func (c *Cache) dumpToRelation(key []byte, v interface{}, l *Ledger) error {
 	if !isDeleteKey(v) {
-		if val, ok := v.(types.Convert); ok {
+		if blk, ok := v.(*types.StateBlock); ok {
+			span := tracing.StartBlock(blk.GetHash(), "Relation.Add")
+			objs, err := l.blockSchema(blk)
+			if err != nil {
+				span.SetError(err)
+				span.End()
+				return fmt.Errorf("table convert: %s", err)
+			}
+			l.relation.Add(objs)
+			span.End()
+		} else if val, ok := v.(types.Convert); ok {
 			objs, err := val.ConvertToSchema()
 			if err != nil {
 				return fmt.Errorf("table convert: %s", err)
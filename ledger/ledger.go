@@ -42,6 +42,7 @@ type LedgerStore interface {
 	GenerateReceiveBlock(sendBlock *types.StateBlock, prk ed25519.PrivateKey) (*types.StateBlock, error)
 	GenerateChangeBlock(account types.Address, representative types.Address, prk ed25519.PrivateKey) (*types.StateBlock, error)
 	GenerateOnlineBlock(account types.Address, prk ed25519.PrivateKey, povHeight uint64) (*types.StateBlock, error)
+	SignStateBlock(block *types.StateBlock, signer types.Signer) error
 	GetVerifiedData() map[types.Hash]int
 	Action(at storage.ActionType, t int) (interface{}, error)
 	GetRelation(dest interface{}, query string) error
@@ -120,7 +121,11 @@ func NewLedger(cfgFile string) *Ledger {
 			logger:         log.NewLogger("ledger"),
 			tokenCache:     sync.Map{},
 		}
-		store, err := db.NewBadgerStore(dir)
+		engine := ""
+		if cfg.DB != nil {
+			engine = cfg.DB.Engine
+		}
+		store, err := db.NewStore(engine, dir)
 		if err != nil {
 			l.logger.Fatal(err.Error())
 		}
@@ -288,17 +293,8 @@ func (l *Ledger) initRelation() error {
 	}
 
 	if count1 != count2 {
-		if err := l.relation.EmptyStore(); err != nil {
-			return fmt.Errorf("relation emptystore, %s ", err)
-		}
-		return l.GetStateBlocksConfirmed(func(block *types.StateBlock) error {
-			c, err := block.ConvertToSchema()
-			if err != nil {
-				return fmt.Errorf("relation convert, %s ", err)
-			}
-			l.relation.Add(c)
-			return nil
-		})
+		_, err := l.RebuildRelation()
+		return err
 	}
 	return nil
 }
@@ -377,6 +373,21 @@ func (l *Ledger) generateWork(hash types.Hash) types.Work {
 	//_ = s.setWork(hash, work)
 }
 
+// SignStateBlock signs block by signer and generates its work, the private key of signer may be kept by
+// the wallet or out of the node, e.g. by a remote signer
+func (l *Ledger) SignStateBlock(block *types.StateBlock, signer types.Signer) error {
+	if signer.Address() != block.Address {
+		return fmt.Errorf("block address (%s) is mismatch signer (%s)", block.Address.String(), signer.Address().String())
+	}
+	sig, err := signer.SignBytes(block.GetHash().Bytes())
+	if err != nil {
+		return err
+	}
+	block.Signature = sig
+	block.Work = l.generateWork(block.Root())
+	return nil
+}
+
 func (l *Ledger) GenerateSendBlock(block *types.StateBlock, amount types.Balance, prk ed25519.PrivateKey) (*types.StateBlock, error) {
 	tm, err := l.GetTokenMeta(block.GetAddress(), block.GetToken())
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 )
 
 type BlockStore interface {
@@ -105,6 +106,8 @@ func (l *Ledger) GetRandomStateBlock() (*types.StateBlock, error) {
 // Block Confirmed
 
 func (l *Ledger) AddStateBlock(block *types.StateBlock) error {
+	span := tracing.StartBlock(block.GetHash(), "Ledger.AddStateBlock")
+	defer span.End()
 	err := l.cache.BatchUpdate(func(c *Cache) error {
 		if err := l.UpdateStateBlock(block, c); err != nil {
 			l.logger.Error(err)
@@ -114,6 +117,7 @@ func (l *Ledger) AddStateBlock(block *types.StateBlock) error {
 	})
 
 	if err != nil {
+		span.SetError(err)
 		return err
 	}
 
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package ledger
+
+import (
+	"errors"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+var ErrEquivocationNotFound = errors.New("equivocation not found")
+
+type EquivocationStore interface {
+	AddEquivocation(e *types.Equivocation) error
+	UpdateEquivocation(e *types.Equivocation) error
+	GetEquivocation(account types.Address, root types.Hash) (*types.Equivocation, error)
+	HasEquivocation(account types.Address, root types.Hash) (bool, error)
+	GetEquivocations(fn func(e *types.Equivocation) error) error
+	GetEquivocationsByAccount(account types.Address, fn func(e *types.Equivocation) error) error
+}
+
+// AddEquivocation records the evidence of a representative, only the first evidence on a root is kept
+func (l *Ledger) AddEquivocation(e *types.Equivocation) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixEquivocation, e.Account, e.Root)
+	if err != nil {
+		return err
+	}
+	if b, err := l.store.Has(k); err != nil {
+		return err
+	} else if b {
+		return nil
+	}
+	v, err := e.Serialize()
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, v)
+}
+
+// UpdateEquivocation replaces the evidence on the root, e.g. by the new evidence after the stored one is expired
+func (l *Ledger) UpdateEquivocation(e *types.Equivocation) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixEquivocation, e.Account, e.Root)
+	if err != nil {
+		return err
+	}
+	v, err := e.Serialize()
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, v)
+}
+
+func (l *Ledger) GetEquivocation(account types.Address, root types.Hash) (*types.Equivocation, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixEquivocation, account, root)
+	if err != nil {
+		return nil, err
+	}
+	val, err := l.store.Get(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrEquivocationNotFound
+		}
+		return nil, err
+	}
+	e := new(types.Equivocation)
+	if err := e.Deserialize(val); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (l *Ledger) HasEquivocation(account types.Address, root types.Hash) (bool, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixEquivocation, account, root)
+	if err != nil {
+		return false, err
+	}
+	return l.store.Has(k)
+}
+
+func (l *Ledger) GetEquivocations(fn func(e *types.Equivocation) error) error {
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixEquivocation)
+	return l.iterateEquivocations(prefix, fn)
+}
+
+func (l *Ledger) GetEquivocationsByAccount(account types.Address, fn func(e *types.Equivocation) error) error {
+	prefix, err := storage.GetKeyOfParts(storage.KeyPrefixEquivocation, account)
+	if err != nil {
+		return err
+	}
+	return l.iterateEquivocations(prefix, fn)
+}
+
+func (l *Ledger) iterateEquivocations(prefix []byte, fn func(e *types.Equivocation) error) error {
+	return l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		e := new(types.Equivocation)
+		if err := e.Deserialize(val); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
@@ -0,0 +1,86 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func generateEquivocation(account types.Address) *types.Equivocation {
+	blk1 := mock.StateBlockWithoutWork()
+	blk2 := blk1.Clone()
+	blk2.Balance = blk2.Balance.Add(types.NewBalance(1))
+	return &types.Equivocation{
+		Account:   account,
+		Root:      types.VoteRoot(blk1),
+		First:     &types.SignedVote{Sequence: 1, Hashes: []types.Hash{blk1.GetHash()}, Block: blk1},
+		Second:    &types.SignedVote{Sequence: 2, Hashes: []types.Hash{blk2.GetHash()}, Block: blk2},
+		Timestamp: 1,
+	}
+}
+
+func TestLedger_AddEquivocation(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	account := mock.Address()
+	e1 := generateEquivocation(account)
+	e2 := generateEquivocation(account)
+	e3 := generateEquivocation(mock.Address())
+	for _, e := range []*types.Equivocation{e1, e2, e3} {
+		if err := l.AddEquivocation(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// only the first evidence on a root is kept
+	e := generateEquivocation(account)
+	e.Root = e1.Root
+	e.Timestamp = 2
+	if err := l.AddEquivocation(e); err != nil {
+		t.Fatal(err)
+	}
+	r, err := l.GetEquivocation(account, e1.Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Timestamp != e1.Timestamp || r.Second.Block.GetHash() != e1.Second.Block.GetHash() {
+		t.Fatal("evidence should not be overwritten")
+	}
+	if err := l.UpdateEquivocation(e); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := l.GetEquivocation(account, e1.Root); err != nil || r.Timestamp != 2 {
+		t.Fatal("evidence should be replaced", err)
+	}
+
+	if has, err := l.HasEquivocation(account, e2.Root); err != nil || !has {
+		t.Fatal(err)
+	}
+	if has, err := l.HasEquivocation(account, e3.Root); err != nil || has {
+		t.Fatal(err)
+	}
+	if _, err := l.GetEquivocation(account, mock.Hash()); err != ErrEquivocationNotFound {
+		t.Fatal(err)
+	}
+
+	count := 0
+	if err := l.GetEquivocations(func(e *types.Equivocation) error {
+		count++
+		return nil
+	}); err != nil || count != 3 {
+		t.Fatal(err, count)
+	}
+
+	count = 0
+	if err := l.GetEquivocationsByAccount(account, func(e *types.Equivocation) error {
+		if e.Account != account {
+			t.Fatal("invalid account", e.Account)
+		}
+		count++
+		return nil
+	}); err != nil || count != 2 {
+		t.Fatal(err, count)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package ledger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+var ErrHistoryPruned = errors.New("history before pruned height is not available")
+
+type HistoryStore interface {
+	GetAccountMetaAtPovHeight(address types.Address, height uint64) (*types.AccountMeta, error)
+	GetTokenMetaAtPovHeight(address types.Address, token types.Hash, height uint64) (*types.TokenMeta, error)
+	GetPendingsAtPovHeight(address types.Address, height uint64, fn func(key *types.PendingKey, value *types.PendingInfo) error) error
+}
+
+// GetAccountMetaAtPovHeight rebuilds the account meta as it was at pov height, from the confirmed
+// account chains, blocks with PoVHeight greater than height are not included
+func (l *Ledger) GetAccountMetaAtPovHeight(address types.Address, height uint64) (*types.AccountMeta, error) {
+	accountMeta, err := l.GetAccountMetaConfirmed(address)
+	if err != nil {
+		return nil, err
+	}
+
+	am := &types.AccountMeta{Address: address}
+	for _, t := range accountMeta.Tokens {
+		blk, skipped, err := l.tokenBlockAtPovHeight(t, height)
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil {
+			continue
+		}
+		am.Tokens = append(am.Tokens, historyTokenMeta(t, blk, len(skipped)))
+		if t.Type == config.ChainToken() {
+			am.CoinBalance = blk.GetBalance()
+			am.CoinVote = blk.GetVote()
+			am.CoinNetwork = blk.GetNetwork()
+			am.CoinStorage = blk.GetStorage()
+			am.CoinOracle = blk.GetOracle()
+		}
+	}
+	if len(am.Tokens) == 0 {
+		return nil, ErrAccountNotFound
+	}
+	return am, nil
+}
+
+// GetTokenMetaAtPovHeight rebuilds the token meta of address as it was at pov height
+func (l *Ledger) GetTokenMetaAtPovHeight(address types.Address, token types.Hash, height uint64) (*types.TokenMeta, error) {
+	tm, err := l.GetTokenMetaConfirmed(address, token)
+	if err != nil {
+		return nil, err
+	}
+	blk, skipped, err := l.tokenBlockAtPovHeight(tm, height)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return nil, ErrTokenNotFound
+	}
+	return historyTokenMeta(tm, blk, len(skipped)), nil
+}
+
+// GetPendingsAtPovHeight iterates the pendings of address as they were at pov height, that is the
+// current pendings sent before height, and the sends before height which were received after it
+func (l *Ledger) GetPendingsAtPovHeight(address types.Address, height uint64, fn func(key *types.PendingKey, value *types.PendingInfo) error) error {
+	pendings := make(map[types.PendingKey]*types.PendingInfo)
+	if err := l.GetPendingsByAddress(address, func(key *types.PendingKey, value *types.PendingInfo) error {
+		send, err := l.historyBlock(key.Hash)
+		if err != nil {
+			return err
+		}
+		if send.PoVHeight <= height {
+			pendings[*key] = value
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	am, err := l.GetAccountMetaConfirmed(address)
+	if err != nil && err != ErrAccountNotFound {
+		return err
+	}
+	if am != nil {
+		for _, tm := range am.Tokens {
+			_, skipped, err := l.tokenBlockAtPovHeight(tm, height)
+			if err != nil {
+				return err
+			}
+			for _, blk := range skipped {
+				if !blk.IsReceiveBlock() {
+					continue
+				}
+				send, err := l.historyBlock(blk.GetLink())
+				if err != nil {
+					return err
+				}
+				if send.PoVHeight > height {
+					continue
+				}
+				amount, err := l.CalculateAmount(blk)
+				if err != nil {
+					return fmt.Errorf("calculate amount of %s: %s", blk.GetHash(), err)
+				}
+				pendings[types.PendingKey{Address: address, Hash: send.GetHash()}] = &types.PendingInfo{
+					Source: send.GetAddress(),
+					Amount: amount,
+					Type:   blk.GetToken(),
+				}
+			}
+		}
+	}
+
+	for key, value := range pendings {
+		k := key
+		if err := fn(&k, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tokenBlockAtPovHeight walks the token chain back from the header, and returns the latest block
+// with PoVHeight not greater than height (nil if the chain was opened after it) and the blocks skipped
+func (l *Ledger) tokenBlockAtPovHeight(tm *types.TokenMeta, height uint64) (*types.StateBlock, []*types.StateBlock, error) {
+	skipped := make([]*types.StateBlock, 0)
+	hash := tm.Header
+	for !hash.IsZero() {
+		blk, err := l.historyBlock(hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if blk.PoVHeight <= height {
+			return blk, skipped, nil
+		}
+		skipped = append(skipped, blk)
+		hash = blk.GetPrevious()
+	}
+	return nil, skipped, nil
+}
+
+func (l *Ledger) historyBlock(hash types.Hash) (*types.StateBlock, error) {
+	blk, err := l.GetStateBlockConfirmed(hash)
+	if err != nil {
+		if err == ErrBlockNotFound {
+			if h, _ := l.GetPrunedHeight(); h > 0 {
+				return nil, ErrHistoryPruned
+			}
+		}
+		return nil, fmt.Errorf("get block %s: %s", hash, err)
+	}
+	return blk, nil
+}
+
+func historyTokenMeta(tm *types.TokenMeta, blk *types.StateBlock, skipped int) *types.TokenMeta {
+	return &types.TokenMeta{
+		Type:           tm.Type,
+		Header:         blk.GetHash(),
+		Representative: blk.GetRepresentative(),
+		OpenBlock:      tm.OpenBlock,
+		Balance:        blk.GetBalance(),
+		BelongTo:       tm.BelongTo,
+		Modified:       blk.GetTimestamp(),
+		BlockCount:     tm.BlockCount - int64(skipped),
+	}
+}
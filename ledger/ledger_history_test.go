@@ -0,0 +1,99 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func addHistoryBlock(t *testing.T, l *Ledger, typ types.BlockType, address types.Address, prev types.Hash,
+	link types.Hash, balance uint64, height uint64) *types.StateBlock {
+	blk := mock.StateBlockWithoutWork()
+	blk.Type = typ
+	blk.Address = address
+	blk.Token = config.ChainToken()
+	blk.Previous = prev
+	blk.Link = link
+	blk.Balance = types.NewBalance(int64(balance))
+	blk.PoVHeight = height
+	if err := l.AddStateBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	return blk
+}
+
+func TestLedger_GetAccountMetaAtPovHeight(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	account := mock.Address()
+	open := addHistoryBlock(t, l, types.Open, account, types.ZeroHash, mock.Hash(), 100, 1)
+	send := addHistoryBlock(t, l, types.Send, account, open.GetHash(), mock.Hash(), 60, 5)
+	source := addHistoryBlock(t, l, types.Send, mock.Address(), types.ZeroHash, account.ToHash(), 0, 3)
+	receive := addHistoryBlock(t, l, types.Receive, account, send.GetHash(), source.GetHash(), 70, 8)
+
+	am := &types.AccountMeta{
+		Address:     account,
+		CoinBalance: receive.Balance,
+		Tokens: []*types.TokenMeta{{
+			Type:           config.ChainToken(),
+			Header:         receive.GetHash(),
+			Representative: receive.Representative,
+			OpenBlock:      open.GetHash(),
+			Balance:        receive.Balance,
+			BelongTo:       account,
+			BlockCount:     3,
+		}},
+	}
+	if err := l.AddAccountMeta(am, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	pending1 := addHistoryBlock(t, l, types.Send, mock.Address(), types.ZeroHash, account.ToHash(), 0, 4)
+	pending2 := addHistoryBlock(t, l, types.Send, mock.Address(), types.ZeroHash, account.ToHash(), 0, 7)
+	for _, blk := range []*types.StateBlock{pending1, pending2} {
+		pk := &types.PendingKey{Address: account, Hash: blk.GetHash()}
+		pi := &types.PendingInfo{Source: blk.Address, Amount: types.NewBalance(1), Type: blk.Token}
+		if err := l.AddPending(pk, pi, l.cache.GetCache()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := l.GetAccountMetaAtPovHeight(account, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := r.Token(config.ChainToken())
+	if r.CoinBalance.Compare(send.Balance) != types.BalanceCompEqual || tm == nil ||
+		tm.Header != send.GetHash() || tm.BlockCount != 2 || tm.OpenBlock != open.GetHash() {
+		t.Fatal(r, tm)
+	}
+	if tm, err := l.GetTokenMetaAtPovHeight(account, config.ChainToken(), 8); err != nil || tm.Header != receive.GetHash() {
+		t.Fatal(tm, err)
+	}
+	if _, err := l.GetAccountMetaAtPovHeight(account, 0); err != ErrAccountNotFound {
+		t.Fatal(err)
+	}
+	if _, err := l.GetTokenMetaAtPovHeight(account, config.ChainToken(), 0); err != ErrTokenNotFound {
+		t.Fatal(err)
+	}
+
+	pendings := make(map[types.Hash]*types.PendingInfo)
+	if err := l.GetPendingsAtPovHeight(account, 6, func(key *types.PendingKey, value *types.PendingInfo) error {
+		pendings[key.Hash] = value
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(pendings) != 2 || pendings[pending1.GetHash()] == nil {
+		t.Fatal(pendings)
+	}
+	if pi := pendings[source.GetHash()]; pi == nil || pi.Source != source.Address ||
+		pi.Amount.Compare(types.NewBalance(10)) != types.BalanceCompEqual {
+		t.Fatal(pi)
+	}
+}
@@ -15,6 +15,10 @@ type PeerInfoStore interface {
 	CountPeersInfo() (uint64, error)
 	UpdatePeerInfo(value *types.PeerInfo) error
 	AddOrUpdatePeerInfo(value *types.PeerInfo) error
+	AddOrUpdatePeerBan(ban *types.PeerBan) error
+	GetPeerBan(peerID string) (*types.PeerBan, error)
+	GetPeerBans(fn func(ban *types.PeerBan) error) error
+	DeletePeerBan(peerID string) error
 }
 
 func (l *Ledger) AddPeerInfo(info *types.PeerInfo) error {
@@ -119,3 +123,53 @@ func (l *Ledger) AddOrUpdatePeerInfo(value *types.PeerInfo) error {
 	}
 	return l.store.Put(k, v)
 }
+
+func (l *Ledger) AddOrUpdatePeerBan(ban *types.PeerBan) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPeerBan, []byte(ban.PeerID))
+	if err != nil {
+		return err
+	}
+	v, err := ban.Serialize()
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, v)
+}
+
+func (l *Ledger) GetPeerBan(peerID string) (*types.PeerBan, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPeerBan, []byte(peerID))
+	if err != nil {
+		return nil, err
+	}
+	val, err := l.store.Get(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrPeerNotFound
+		}
+		return nil, err
+	}
+	ban := new(types.PeerBan)
+	if err := ban.Deserialize(val); err != nil {
+		return nil, err
+	}
+	return ban, nil
+}
+
+func (l *Ledger) GetPeerBans(fn func(ban *types.PeerBan) error) error {
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixPeerBan)
+	return l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		ban := new(types.PeerBan)
+		if err := ban.Deserialize(val); err != nil {
+			return err
+		}
+		return fn(ban)
+	})
+}
+
+func (l *Ledger) DeletePeerBan(peerID string) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPeerBan, []byte(peerID))
+	if err != nil {
+		return err
+	}
+	return l.store.Delete(k)
+}
@@ -234,3 +234,49 @@ func TestLedger_CountPeersInfo(t *testing.T) {
 		t.Fatal("PeerInfo Count err")
 	}
 }
+
+func TestLedger_PeerBan(t *testing.T) {
+	teardownTestCase, l := setupPeersInfoTestCase(t)
+	defer teardownTestCase(t)
+
+	ban := &types.PeerBan{
+		PeerID:  random.RandomHexString(46),
+		Address: "/ip4/192.168.80.1/tcp/9001",
+		Score:   -100,
+		Reason:  "invalid block",
+		Until:   1600000000,
+	}
+	if err := l.AddOrUpdatePeerBan(ban); err != nil {
+		t.Fatal(err)
+	}
+	ban.Until = 1700000000
+	if err := l.AddOrUpdatePeerBan(ban); err != nil {
+		t.Fatal(err)
+	}
+	b, err := l.GetPeerBan(ban.PeerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *b != *ban {
+		t.Fatal("PeerBan mismatch", b)
+	}
+	if _, err := l.GetPeerBan(random.RandomHexString(46)); err != ErrPeerNotFound {
+		t.Fatal(err)
+	}
+
+	count := 0
+	err = l.GetPeerBans(func(b *types.PeerBan) error {
+		count++
+		return nil
+	})
+	if err != nil || count != 1 {
+		t.Fatal(err, count)
+	}
+
+	if err := l.DeletePeerBan(ban.PeerID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.GetPeerBan(ban.PeerID); err != ErrPeerNotFound {
+		t.Fatal(err)
+	}
+}
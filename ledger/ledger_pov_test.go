@@ -3,6 +3,7 @@ package ledger
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/google/uuid"
@@ -709,6 +710,7 @@ func TestLedger_PovDiffStats(t *testing.T) {
 	dayStat0 := types.NewPovDiffDayStat()
 	dayStat0.DayIndex = 0
 	dayStat0.AvgDiffRatio = 10
+	dayStat0.AlgoStats[types.ALGO_LYRA2Z.String()] = &types.PovDiffAlgoStatItem{BlockNum: 3, AvgDiffRatio: 20}
 	err := l.AddPovDiffStat(dayStat0)
 	if err != nil {
 		t.Fatal(err)
@@ -734,10 +736,13 @@ func TestLedger_PovDiffStats(t *testing.T) {
 	allDss = append(allDss, dayStat1)
 
 	for _, ds := range allDss {
-		_, err := l.GetPovDiffStat(ds.DayIndex)
+		retDs, err := l.GetPovDiffStat(ds.DayIndex)
 		if err != nil {
 			t.Fatal(err)
 		}
+		if !reflect.DeepEqual(retDs.AlgoStats, ds.AlgoStats) {
+			t.Fatalf("algo stats not equal, %v != %v", retDs.AlgoStats, ds.AlgoStats)
+		}
 	}
 
 	var retDss []*types.PovDiffDayStat
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package ledger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+const pruneBatchSize = 10000
+
+var ErrPruneKeepHeight = errors.New("prune keep height must be greater than zero")
+
+type PruneStore interface {
+	PruneBlocks(keep uint64) (*PruneResult, error)
+	GetPrunedHeight() (uint64, error)
+}
+
+// PruneResult describes one round of block pruning
+type PruneResult struct {
+	PovHeight    uint64 `json:"povHeight"`
+	PrunedHeight uint64 `json:"prunedHeight"`
+	Scanned      uint64 `json:"scanned"`
+	Pruned       uint64 `json:"pruned"`
+}
+
+// PruneBlocks deletes confirmed blocks older than the latest `keep` PoV heights.
+// AccountMeta, frontiers, pending, child/link indexes and PoV state are kept,
+// and so are blocks still referenced by frontiers, pending entries, contract storage or vote history.
+func (l *Ledger) PruneBlocks(keep uint64) (*PruneResult, error) {
+	if keep == 0 {
+		return nil, ErrPruneKeepHeight
+	}
+	latest, err := l.GetPovLatestHeight()
+	if err != nil {
+		return nil, fmt.Errorf("get pov latest height: %s", err)
+	}
+	result := &PruneResult{PovHeight: latest}
+	if result.PrunedHeight, err = l.GetPrunedHeight(); err != nil {
+		return nil, err
+	}
+	if latest <= keep {
+		return result, nil
+	}
+	height := latest - keep
+
+	if err := l.Flush(); err != nil {
+		return nil, fmt.Errorf("flush cache: %s", err)
+	}
+	protected, err := l.pruneProtectedBlocks()
+	if err != nil {
+		return nil, err
+	}
+
+	// blocks are deleted batch by batch while iterating, the store allows writes in the iterator callback
+	hashes := make([]types.Hash, 0, pruneBatchSize)
+	prune := func() error {
+		if err := l.pruneBlocks(hashes); err != nil {
+			return err
+		}
+		result.Pruned += uint64(len(hashes))
+		hashes = hashes[:0]
+		return nil
+	}
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixBlock)
+	if err := l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		result.Scanned++
+		blk := new(types.StateBlock)
+		if err := blk.Deserialize(val); err != nil {
+			return fmt.Errorf("block deserialize: %s", err)
+		}
+		if blk.PoVHeight >= height || blk.IsContractBlock() || config.IsGenesisBlock(blk) {
+			return nil
+		}
+		hash := blk.GetHash()
+		if _, ok := protected[hash]; ok {
+			return nil
+		}
+		hashes = append(hashes, hash)
+		if len(hashes) >= pruneBatchSize {
+			return prune()
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(hashes) > 0 {
+		if err := prune(); err != nil {
+			return nil, err
+		}
+	}
+
+	if height > result.PrunedHeight {
+		if err := l.setPrunedHeight(height); err != nil {
+			return nil, err
+		}
+		result.PrunedHeight = height
+	}
+	return result, nil
+}
+
+// GetPrunedHeight returns the pov height below which blocks may have been pruned, 0 means full history
+func (l *Ledger) GetPrunedHeight() (uint64, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPrunedHeight)
+	if err != nil {
+		return 0, err
+	}
+	v, err := l.store.Get(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(v), nil
+}
+
+func (l *Ledger) setPrunedHeight(height uint64) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPrunedHeight)
+	if err != nil {
+		return err
+	}
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, height)
+	return l.store.Put(k, v)
+}
+
+func (l *Ledger) pruneProtectedBlocks() (map[types.Hash]struct{}, error) {
+	protected := make(map[types.Hash]struct{})
+
+	frontiers, err := l.GetFrontiers()
+	if err != nil {
+		return nil, fmt.Errorf("get frontiers: %s", err)
+	}
+	for _, f := range frontiers {
+		protected[f.HeaderBlock] = struct{}{}
+		protected[f.OpenBlock] = struct{}{}
+	}
+
+	if err := l.GetPendings(func(key *types.PendingKey, info *types.PendingInfo) error {
+		protected[key.Hash] = struct{}{}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("get pendings: %s", err)
+	}
+
+	// vote history and vm logs are keyed by block hash
+	for _, p := range []storage.KeyPrefix{storage.KeyPrefixVoteHistory, storage.KeyPrefixVmLogs} {
+		prefix, _ := storage.GetKeyOfParts(p)
+		if err := l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+			if len(key) < 1+types.HashSize {
+				return nil
+			}
+			hash, err := types.BytesToHash(key[1 : 1+types.HashSize])
+			if err != nil {
+				return err
+			}
+			protected[hash] = struct{}{}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return protected, nil
+}
+
+func (l *Ledger) pruneBlocks(hashes []types.Hash) error {
+	batch := l.store.Batch(false)
+	for _, hash := range hashes {
+		k, err := storage.GetKeyOfParts(storage.KeyPrefixBlock, hash)
+		if err != nil {
+			batch.Discard()
+			return err
+		}
+		if err := batch.Delete(k); err != nil {
+			batch.Discard()
+			return err
+		}
+		pk, err := storage.GetKeyOfParts(storage.KeyPrefixPrivatePayload, hash)
+		if err != nil {
+			batch.Discard()
+			return err
+		}
+		if err := batch.Delete(pk); err != nil {
+			batch.Discard()
+			return err
+		}
+	}
+	if err := l.store.PutBatch(batch); err != nil {
+		return fmt.Errorf("prune blocks: %s", err)
+	}
+
+	return l.relation.BatchUpdate(func(txn *sqlx.Tx) error {
+		for _, hash := range hashes {
+			bh := &types.BlockHash{Hash: hash.String()}
+			if _, err := txn.Exec(bh.DeleteKey()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
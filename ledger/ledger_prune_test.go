@@ -0,0 +1,75 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func addPruneBlock(t *testing.T, l *Ledger, height uint64) *types.StateBlock {
+	blk := mock.StateBlockWithoutWork()
+	blk.PoVHeight = height
+	if err := l.AddStateBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	return blk
+}
+
+func TestLedger_PruneBlocks(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	if _, err := l.PruneBlocks(0); err != ErrPruneKeepHeight {
+		t.Fatal(err)
+	}
+
+	old := addPruneBlock(t, l, 1)
+	header := addPruneBlock(t, l, 1)
+	if err := l.AddFrontier(&types.Frontier{HeaderBlock: header.GetHash(), OpenBlock: header.GetHash()}, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	pending := addPruneBlock(t, l, 1)
+	pk := &types.PendingKey{Address: mock.Address(), Hash: pending.GetHash()}
+	pi := &types.PendingInfo{Source: pending.Address, Amount: types.NewBalance(1), Type: pending.Token}
+	if err := l.AddPending(pk, pi, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	voted := addPruneBlock(t, l, 1)
+	if err := l.AddVoteHistory(voted.GetHash(), mock.Address()); err != nil {
+		t.Fatal(err)
+	}
+	contract := mock.StateBlockWithoutWork()
+	contract.Type = types.ContractSend
+	contract.PoVHeight = 1
+	if err := l.AddStateBlock(contract); err != nil {
+		t.Fatal(err)
+	}
+	recent := addPruneBlock(t, l, 18)
+
+	if err := l.SetPovLatestHeight(20); err != nil {
+		t.Fatal(err)
+	}
+	r, err := l.PruneBlocks(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.PovHeight != 20 || r.PrunedHeight != 10 || r.Pruned != 1 {
+		t.Fatal(r)
+	}
+	if b, _ := l.HasStateBlockConfirmed(old.GetHash()); b {
+		t.Fatal("old block should be pruned")
+	}
+	for _, blk := range []*types.StateBlock{header, pending, voted, contract, recent} {
+		if b, _ := l.HasStateBlockConfirmed(blk.GetHash()); !b {
+			t.Fatal("block should be kept", blk.GetHash())
+		}
+	}
+	if h, err := l.GetPrunedHeight(); err != nil || h != 10 {
+		t.Fatal(h, err)
+	}
+
+	if r, err := l.PruneBlocks(30); err != nil || r.Pruned != 0 || r.PrunedHeight != 10 {
+		t.Fatal(r, err)
+	}
+}
@@ -1,16 +1,24 @@
 package ledger
 
 import (
+	"fmt"
+
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger/relation"
 )
 
+const relationRebuildBatch = 1000
+
 type Relation interface {
 	Blocks(limit int, offset int) ([]types.Hash, error)
 	BlocksByAccount(address types.Address, limit int, offset int) ([]types.Hash, error)
 	BlocksCount() (uint64, error)
 	BlocksCountByType() (map[string]uint64, error)
 
+	QueryBlocks(query *relation.BlockQuery) ([]types.Hash, string, error)
+
 	EmptyRelation() error
+	RebuildRelation() (uint64, error)
 }
 
 func (l *Ledger) Blocks(limit int, offset int) ([]types.Hash, error) {
@@ -29,10 +37,71 @@ func (l *Ledger) BlocksCountByType() (map[string]uint64, error) {
 	return l.relation.BlocksCountByType()
 }
 
+// QueryBlocks returns hashes of blocks matching query, newest first, and the cursor of the next page
+func (l *Ledger) QueryBlocks(query *relation.BlockQuery) ([]types.Hash, string, error) {
+	return l.relation.QueryBlocks(query)
+}
+
 func (l *Ledger) EmptyRelation() error {
 	return l.relation.EmptyStore()
 }
 
+// RebuildRelation empties the relation store and indexes all confirmed blocks again, returns the count of blocks
+func (l *Ledger) RebuildRelation() (uint64, error) {
+	if err := l.relation.EmptyStore(); err != nil {
+		return 0, fmt.Errorf("relation emptystore, %s ", err)
+	}
+	return l.indexBlocks()
+}
+
+// indexBlocks adds all confirmed blocks to the relation store in batches, returns the count of blocks
+func (l *Ledger) indexBlocks() (uint64, error) {
+	var count uint64
+	objs := make([]types.Schema, 0, relationRebuildBatch)
+	if err := l.GetStateBlocksConfirmed(func(block *types.StateBlock) error {
+		c, err := l.blockSchema(block)
+		if err != nil {
+			return fmt.Errorf("relation convert, %s ", err)
+		}
+		objs = append(objs, c...)
+		count++
+		if len(objs) >= relationRebuildBatch {
+			if err := l.relation.BatchAdd(objs); err != nil {
+				return err
+			}
+			objs = objs[:0]
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if len(objs) > 0 {
+		if err := l.relation.BatchAdd(objs); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// blockSchema converts block to relation schema with the amount calculated from the previous block,
+// the amount is left zero if the previous block is not available, e.g. pruned
+func (l *Ledger) blockSchema(block *types.StateBlock) ([]types.Schema, error) {
+	objs, err := block.ConvertToSchema()
+	if err != nil {
+		return nil, err
+	}
+	amount, err := l.CalculateAmount(block)
+	if err != nil {
+		return objs, nil
+	}
+	for _, obj := range objs {
+		if bh, ok := obj.(*types.BlockHash); ok {
+			bh.Amount = amount.String()
+		}
+	}
+	return objs, nil
+}
+
 func (l *Ledger) SelectRelation(dest interface{}, query string) error {
 	return l.relation.Select(dest, query)
 }
@@ -98,3 +98,33 @@ func TestLedger_Relation(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestLedger_RebuildRelation(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	blocks, err := l.CountStateBlocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < relationRebuildBatch+10; i++ {
+		if err := l.AddStateBlock(mock.StateBlockWithoutWork()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(1 * time.Second)
+	if err := l.EmptyRelation(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := l.RebuildRelation()
+	if err != nil || c != blocks+relationRebuildBatch+10 {
+		t.Fatal(c, err)
+	}
+	if r, err := l.BlocksCount(); err != nil || r != c {
+		t.Fatal(r, err)
+	}
+}
@@ -0,0 +1,458 @@
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path/filepath"
+	"time"
+
+	typelation "github.com/qlcchain/go-qlc/common/relation"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+const (
+	SnapshotVersion = 2
+
+	snapshotMagic     = "QLCSNAP"
+	snapshotRecordEnd = byte(0)
+	snapshotRecordKV  = byte(1)
+	snapshotBatchSize = 10000
+)
+
+var (
+	ErrSnapshotFormat   = errors.New("invalid snapshot format")
+	ErrSnapshotVersion  = errors.New("unsupported snapshot version")
+	ErrSnapshotChecksum = errors.New("snapshot checksum mismatch")
+	ErrSnapshotGenesis  = errors.New("snapshot genesis mismatch")
+	ErrLedgerNotEmpty   = errors.New("ledger is not empty")
+)
+
+// node local data and unconfirmed caches, they are not part of a snapshot
+var snapshotExcludedPrefixes = []storage.KeyPrefix{
+	storage.KeyPrefixUncheckedBlockPrevious,
+	storage.KeyPrefixUncheckedBlockLink,
+	storage.KeyPrefixBlockCache,
+	storage.KeyPrefixRepresentationCache,
+	storage.KeyPrefixUncheckedTokenInfo,
+	storage.KeyPrefixBlockCacheAccount,
+	storage.KeyPrefixUnconfirmedSync,
+	storage.KeyPrefixUncheckedSync,
+	storage.KeyPrefixSyncCacheBlock,
+	storage.KeyPrefixUncheckedPovHeight,
+	storage.KeyPrefixPeerInfo,
+	storage.KeyPrefixGapPublish,
+	storage.KeyPrefixGapDoDSettleState,
+	storage.KeyPrefixGapPovHeight,
+}
+
+type SnapshotStore interface {
+	ExportSnapshot(w io.Writer) (*SnapshotInfo, error)
+}
+
+// SnapshotInfo describes a ledger snapshot, Records/Checksum are filled once the archive is complete
+type SnapshotInfo struct {
+	Version       int        `json:"version"`
+	LedgerVersion int        `json:"ledgerVersion"`
+	Genesis       types.Hash `json:"genesis"`
+	PovHeight     uint64     `json:"povHeight"`
+	PovHash       types.Hash `json:"povHash"`
+	StateHash     types.Hash `json:"stateHash"`
+	Timestamp     int64      `json:"timestamp"`
+	Records       uint64     `json:"records"`
+	Checksum      types.Hash `json:"checksum"`
+}
+
+// ExportSnapshot writes all confirmed ledger data to w, the store is read in one transaction
+// so the archive matches the recorded PoV height. Relation rows are not exported, they are
+// written asynchronously and would not match the transaction, the import indexes them again.
+func (l *Ledger) ExportSnapshot(w io.Writer) (*SnapshotInfo, error) {
+	if err := l.Flush(); err != nil {
+		return nil, fmt.Errorf("flush cache: %s", err)
+	}
+
+	batch := l.store.Batch(true)
+	defer batch.Discard()
+
+	info := &SnapshotInfo{
+		Version:       SnapshotVersion,
+		LedgerVersion: version,
+		Genesis:       config.GenesisBlockHash(),
+		Timestamp:     time.Now().Unix(),
+	}
+	if header, err := l.snapshotPovHeader(batch); err == nil {
+		info.PovHeight = header.GetHeight()
+		info.PovHash = header.GetHash()
+		info.StateHash = header.GetStateHash()
+	}
+
+	sw, err := newSnapshotWriter(w, info)
+	if err != nil {
+		return nil, err
+	}
+
+	for p := 0; p <= 0xff; p++ {
+		if isSnapshotExcluded(byte(p)) {
+			continue
+		}
+		if err := batch.Iterator([]byte{byte(p)}, nil, func(k, v []byte) error {
+			info.Records++
+			return sw.writeRecord(snapshotRecordKV, k, v)
+		}); err != nil {
+			return nil, fmt.Errorf("export prefix %d: %s", p, err)
+		}
+	}
+
+	if err := sw.close(info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ImportSnapshot loads a snapshot into an empty ledger and indexes the relation of the imported data,
+// all imported data is dropped again if the archive turns out to be corrupted
+func (l *Ledger) ImportSnapshot(r io.Reader) (*SnapshotInfo, error) {
+	if c, err := l.CountStateBlocks(); err != nil || c > 0 {
+		return nil, ErrLedgerNotEmpty
+	}
+
+	batch := l.store.Batch(false)
+	count := 0
+	objs := make([]types.Schema, 0, relationRebuildBatch)
+	info, err := readSnapshot(r, func(k, v []byte) error {
+		if err := batch.Put(k, v); err != nil {
+			return err
+		}
+		count++
+		if count >= snapshotBatchSize {
+			if err := l.store.PutBatch(batch); err != nil {
+				return err
+			}
+			batch = l.store.Batch(false)
+			count = 0
+		}
+
+		// values of registered relation types, blocks are indexed once all of them are imported
+		c, err := typelation.ConvertToInterface(v)
+		if err != nil || c == nil {
+			return nil
+		}
+		schemas, err := c.ConvertToSchema()
+		if err != nil {
+			return fmt.Errorf("relation convert: %s", err)
+		}
+		objs = append(objs, schemas...)
+		if len(objs) >= relationRebuildBatch {
+			if err := l.relation.BatchAdd(objs); err != nil {
+				return err
+			}
+			objs = objs[:0]
+		}
+		return nil
+	})
+	if err == nil {
+		err = l.store.PutBatch(batch)
+	} else {
+		batch.Discard()
+	}
+	if err == nil && len(objs) > 0 {
+		err = l.relation.BatchAdd(objs)
+	}
+	if err == nil {
+		_, err = l.indexBlocks()
+	}
+
+	if err != nil {
+		if e := l.store.Drop(nil); e != nil {
+			l.logger.Error(e)
+		}
+		if e := l.relation.EmptyStore(); e != nil {
+			l.logger.Error(e)
+		}
+		return nil, err
+	}
+	return info, nil
+}
+
+// DefaultSnapshotFile returns a time stamped snapshot file under the snapshot folder of dataDir
+func DefaultSnapshotFile(dataDir string) string {
+	return filepath.Join(dataDir, "snapshot", fmt.Sprintf("snapshot-%s.qsnap", time.Now().Format("2006-01-02T15-04-05")))
+}
+
+// VerifySnapshot checks the format and checksum of a snapshot without importing it
+func VerifySnapshot(r io.Reader) (*SnapshotInfo, error) {
+	return readSnapshot(r, func(k, v []byte) error {
+		return nil
+	})
+}
+
+// VerifyFrontiers recomputes frontiers from the account metas and compares them with the stored ones
+func (l *Ledger) VerifyFrontiers() error {
+	expected := make(map[types.Hash]types.Hash)
+	err := l.GetAccountMetas(func(am *types.AccountMeta) error {
+		for _, tm := range am.Tokens {
+			blk, err := l.GetStateBlockConfirmed(tm.Header)
+			if err != nil {
+				return fmt.Errorf("header %s of %s: %s", tm.Header, am.Address, err)
+			}
+			if blk.GetAddress() != am.Address || blk.GetToken() != tm.Type || !blk.GetBalance().Equal(tm.Balance) {
+				return fmt.Errorf("header %s mismatch with account %s", tm.Header, am.Address)
+			}
+			if b, err := l.HasStateBlockConfirmed(tm.OpenBlock); err != nil || !b {
+				return fmt.Errorf("open block %s of %s not found", tm.OpenBlock, am.Address)
+			}
+			expected[tm.Header] = tm.OpenBlock
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	frontiers, err := l.GetFrontiers()
+	if err != nil {
+		return err
+	}
+	for _, f := range frontiers {
+		open, ok := expected[f.HeaderBlock]
+		if !ok {
+			return fmt.Errorf("frontier %s has no account", f.HeaderBlock)
+		}
+		if open != f.OpenBlock {
+			return fmt.Errorf("frontier %s open block mismatch", f.HeaderBlock)
+		}
+		delete(expected, f.HeaderBlock)
+	}
+	for header := range expected {
+		return fmt.Errorf("frontier %s not found", header)
+	}
+	return nil
+}
+
+func (l *Ledger) snapshotPovHeader(batch storage.Batch) (*types.PovHeader, error) {
+	key, err := storage.GetKeyOfParts(storage.KeyPrefixPovLatestHeight)
+	if err != nil {
+		return nil, err
+	}
+	val, err := l.getFromStore(key, batch)
+	if err != nil {
+		return nil, err
+	}
+	height := binary.BigEndian.Uint64(val)
+	hash, err := l.GetPovBestHash(height, batch)
+	if err != nil {
+		return nil, err
+	}
+	key, err = storage.GetKeyOfParts(storage.KeyPrefixPovHeader, height, hash)
+	if err != nil {
+		return nil, err
+	}
+	val, err = l.getFromStore(key, batch)
+	if err != nil {
+		return nil, err
+	}
+	header := new(types.PovHeader)
+	if err := header.Deserialize(val); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func isSnapshotExcluded(p byte) bool {
+	for _, e := range snapshotExcludedPrefixes {
+		if byte(e) == p {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot layout (gzip compressed):
+// magic | version uint16 | header length uint32 | header json |
+// records (type byte, uvarint key length, key, uvarint value length, value) |
+// end byte | records uint64 | sha256 of all preceding bytes
+type snapshotWriter struct {
+	gz  *gzip.Writer
+	buf *bufio.Writer
+	h   hash.Hash
+	w   io.Writer
+}
+
+func newSnapshotWriter(w io.Writer, info *SnapshotInfo) (*snapshotWriter, error) {
+	gz := gzip.NewWriter(w)
+	buf := bufio.NewWriterSize(gz, 1<<20)
+	h := sha256.New()
+	sw := &snapshotWriter{gz: gz, buf: buf, h: h, w: io.MultiWriter(buf, h)}
+
+	header, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sw.w.Write([]byte(snapshotMagic)); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(sw.w, binary.BigEndian, uint16(info.Version)); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(sw.w, binary.BigEndian, uint32(len(header))); err != nil {
+		return nil, err
+	}
+	if _, err := sw.w.Write(header); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+func (sw *snapshotWriter) writeRecord(typ byte, k, v []byte) error {
+	buf := make([]byte, 1, 1+2*binary.MaxVarintLen64+len(k)+len(v))
+	buf[0] = typ
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], uint64(len(k)))
+	buf = append(buf, tmp[:n]...)
+	buf = append(buf, k...)
+	n = binary.PutUvarint(tmp[:], uint64(len(v)))
+	buf = append(buf, tmp[:n]...)
+	buf = append(buf, v...)
+	_, err := sw.w.Write(buf)
+	return err
+}
+
+func (sw *snapshotWriter) close(info *SnapshotInfo) error {
+	footer := make([]byte, 9)
+	footer[0] = snapshotRecordEnd
+	binary.BigEndian.PutUint64(footer[1:], info.Records)
+	if _, err := sw.w.Write(footer); err != nil {
+		return err
+	}
+	checksum, err := types.BytesToHash(sw.h.Sum(nil))
+	if err != nil {
+		return err
+	}
+	info.Checksum = checksum
+	if _, err := sw.buf.Write(checksum[:]); err != nil {
+		return err
+	}
+	if err := sw.buf.Flush(); err != nil {
+		return err
+	}
+	return sw.gz.Close()
+}
+
+type snapshotReader struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func (sr *snapshotReader) Read(p []byte) (int, error) {
+	n, err := io.ReadFull(sr.r, p)
+	sr.h.Write(p[:n])
+	return n, err
+}
+
+func (sr *snapshotReader) ReadByte() (byte, error) {
+	b, err := sr.r.ReadByte()
+	if err == nil {
+		sr.h.Write([]byte{b})
+	}
+	return b, err
+}
+
+func (sr *snapshotReader) readBytes() ([]byte, error) {
+	l, err := binary.ReadUvarint(sr)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, l)
+	if _, err := sr.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func readSnapshot(r io.Reader, fn func(k, v []byte) error) (*SnapshotInfo, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	defer gz.Close()
+	sr := &snapshotReader{r: bufio.NewReaderSize(gz, 1<<20), h: sha256.New()}
+
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := sr.Read(magic); err != nil || string(magic) != snapshotMagic {
+		return nil, ErrSnapshotFormat
+	}
+	var v uint16
+	if err := binary.Read(sr, binary.BigEndian, &v); err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	if v != SnapshotVersion {
+		return nil, ErrSnapshotVersion
+	}
+	var hl uint32
+	if err := binary.Read(sr, binary.BigEndian, &hl); err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	header := make([]byte, hl)
+	if _, err := sr.Read(header); err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	info := new(SnapshotInfo)
+	if err := json.Unmarshal(header, info); err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	if info.LedgerVersion != version {
+		return nil, fmt.Errorf("%s, ledger version %d", ErrSnapshotVersion, info.LedgerVersion)
+	}
+	if info.Genesis != config.GenesisBlockHash() {
+		return nil, ErrSnapshotGenesis
+	}
+
+	var records uint64
+	for {
+		typ, err := sr.ReadByte()
+		if err != nil {
+			return nil, ErrSnapshotFormat
+		}
+		if typ == snapshotRecordEnd {
+			break
+		}
+		if typ != snapshotRecordKV {
+			return nil, ErrSnapshotFormat
+		}
+		k, err := sr.readBytes()
+		if err != nil {
+			return nil, ErrSnapshotFormat
+		}
+		v, err := sr.readBytes()
+		if err != nil {
+			return nil, ErrSnapshotFormat
+		}
+		records++
+		if err := fn(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := binary.Read(sr, binary.BigEndian, &info.Records); err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	checksum := sr.h.Sum(nil)
+	expected := make([]byte, len(checksum))
+	if _, err := io.ReadFull(sr.r, expected); err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	if !bytes.Equal(checksum, expected) || records != info.Records {
+		return nil, ErrSnapshotChecksum
+	}
+	info.Checksum, _ = types.BytesToHash(checksum)
+	return info, nil
+}
@@ -0,0 +1,130 @@
+package ledger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func addSnapshotAccount(t *testing.T, l *Ledger) *types.AccountMeta {
+	blk := addStateBlock(t, l)
+	tm := &types.TokenMeta{
+		Type:      blk.Token,
+		Header:    blk.GetHash(),
+		OpenBlock: blk.GetHash(),
+		Balance:   blk.Balance,
+		BelongTo:  blk.Address,
+	}
+	am := mock.AccountMeta(blk.Address)
+	am.Tokens = []*types.TokenMeta{tm}
+	if err := l.AddAccountMeta(am, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddFrontier(&types.Frontier{HeaderBlock: tm.Header, OpenBlock: tm.OpenBlock}, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	return am
+}
+
+func TestLedger_Snapshot(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	am := addSnapshotAccount(t, l)
+	if err := l.VerifyFrontiers(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(500 * time.Millisecond)
+
+	buf := new(bytes.Buffer)
+	info, err := l.ExportSnapshot(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Records == 0 || info.Checksum.IsZero() {
+		t.Fatal(info)
+	}
+
+	r, err := VerifySnapshot(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Checksum != info.Checksum || r.Records != info.Records {
+		t.Fatal(r, info)
+	}
+
+	teardownTestCase2, l2 := setupTestCase(t)
+	defer teardownTestCase2(t)
+	if _, err := l2.ImportSnapshot(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l2.GetAccountMetaConfirmed(am.Address); err != nil {
+		t.Fatal(err)
+	}
+	if err := l2.VerifyFrontiers(); err != nil {
+		t.Fatal(err)
+	}
+	c1, _ := l.CountStateBlocks()
+	c2, _ := l2.CountStateBlocks()
+	if c1 != c2 {
+		t.Fatal(c1, c2)
+	}
+	// relation is indexed from the imported blocks
+	if c, err := l2.relation.BlocksCount(); err != nil || c != c2 {
+		t.Fatal(c, c2, err)
+	}
+	if _, err := l2.ImportSnapshot(bytes.NewReader(buf.Bytes())); err != ErrLedgerNotEmpty {
+		t.Fatal(err)
+	}
+}
+
+func TestLedger_SnapshotCorrupted(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	addSnapshotAccount(t, l)
+	raw := new(bytes.Buffer)
+	sw, err := newSnapshotWriter(raw, &SnapshotInfo{Version: SnapshotVersion, LedgerVersion: version})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sw.writeRecord(snapshotRecordKV, []byte{1, 2}, []byte{3}); err != nil {
+		t.Fatal(err)
+	}
+	info := new(SnapshotInfo)
+	info.Records = 2
+	if err := sw.close(info); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifySnapshot(bytes.NewReader(raw.Bytes())); err != ErrSnapshotGenesis {
+		t.Fatal(err)
+	}
+	if _, err := VerifySnapshot(bytes.NewReader([]byte("invalid"))); err != ErrSnapshotFormat {
+		t.Fatal(err)
+	}
+}
+
+func TestLedger_VerifyFrontiers(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	addSnapshotAccount(t, l)
+	if err := l.VerifyFrontiers(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddFrontier(&types.Frontier{HeaderBlock: mock.Hash(), OpenBlock: mock.Hash()}, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.VerifyFrontiers(); err == nil {
+		t.Fatal("extra frontier should fail")
+	}
+}
@@ -20,6 +20,7 @@ type Store interface {
 	PendingStore
 	FrontierStore
 	RepresentationStore
+	EquivocationStore
 	UncheckedBlockStore
 	PeerInfoStore
 	SyncStore
@@ -32,6 +33,9 @@ type Store interface {
 	PrivacyStore
 	VmlogsStore
 	VmStore
+	PruneStore
+	SnapshotStore
+	HistoryStore
 }
 
 type ContractStore interface {
@@ -336,6 +336,21 @@ func TestLedger_GenerateSendBlock(t *testing.T) {
 	}
 	t.Log(onlineBlk)
 
+	// SignStateBlock by the account whose private key is kept by a signer
+	onlineBlk2, err := l.GenerateOnlineBlock(ac1.Address(), nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SignStateBlock(onlineBlk2, types.NewAccountWithSigner(ac2)); err == nil {
+		t.Fatal("signer mismatch should fail")
+	}
+	if err := l.SignStateBlock(onlineBlk2, types.NewAccountWithSigner(ac1)); err != nil {
+		t.Fatal(err)
+	}
+	if sig, hash := onlineBlk2.GetSignature(), onlineBlk2.GetHash(); !ac1.Address().Verify(hash[:], sig[:]) || !onlineBlk2.IsValid() {
+		t.Fatal("invalid signature or work")
+	}
+
 	// CalculateAmount
 	b1, err := l.CalculateAmount(sendBlk1)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 	"github.com/qlcchain/go-qlc/trie"
 	"github.com/qlcchain/go-qlc/vm/contract"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
@@ -256,7 +257,11 @@ func (c *onlineBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (P
 
 func (lv *LedgerVerifier) BlockProcess(block *types.StateBlock) error {
 	lv.logger.Infof("block  process: %s(%s) ", block.GetHash().String(), block.GetType().String())
+	span := tracing.StartBlock(block.GetHash(), "LedgerVerifier.BlockProcess")
+	defer span.End()
 	lv.lock(block)
+	// vm storage tries may reuse existing trie nodes, which must not be collected meanwhile
+	trie.GCLock().RLock()
 	err := lv.l.Cache().BatchUpdate(func(c *ledger.Cache) error {
 		err := lv.processStateBlock(block, c)
 		if err != nil {
@@ -265,8 +270,10 @@ func (lv *LedgerVerifier) BlockProcess(block *types.StateBlock) error {
 		}
 		return nil
 	})
+	trie.GCLock().RUnlock()
 	lv.unlock(block)
 	if err != nil {
+		span.SetError(err)
 		return err
 	}
 	lv.logger.Debug("publish addRelation,", block.GetHash())
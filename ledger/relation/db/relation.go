@@ -1,7 +1,7 @@
 package db
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
@@ -21,5 +21,5 @@ func NewDB(cfg *config.Config) (*sqlx.DB, error) {
 	case "postgres":
 		return openPostgres(cfg)
 	}
-	return nil, errors.New("unsupported driver")
+	return nil, fmt.Errorf("unsupported driver %s", dbStr)
 }
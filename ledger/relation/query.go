@@ -0,0 +1,156 @@
+package relation
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+const (
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// BlockQuery filters blocks in the relation index, fields of zero value are not filtered,
+// StartTime and EndTime are inclusive, Cursor is returned by the previous page
+type BlockQuery struct {
+	Address   types.Address
+	Token     types.Hash
+	Types     []types.BlockType
+	StartTime int64
+	EndTime   int64
+	MinAmount *types.Balance
+	MaxAmount *types.Balance
+	Method    []byte
+	Link      types.Hash
+	Cursor    string
+	Limit     int
+}
+
+type blockCursor struct {
+	Hash      string `db:"hash"`
+	Timestamp int64  `db:"timestamp"`
+}
+
+func (c *blockCursor) String() string {
+	return fmt.Sprintf("%d_%s", c.Timestamp, c.Hash)
+}
+
+func parseBlockCursor(s string) (*blockCursor, error) {
+	parts := strings.Split(s, "_")
+	if len(parts) != 2 {
+		return nil, ErrInvalidCursor
+	}
+	t, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	h, err := types.NewHash(parts[1])
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &blockCursor{Hash: h.String(), Timestamp: t}, nil
+}
+
+// where returns the conditions of query, values are always passed as arguments
+func (q *BlockQuery) where() (string, []interface{}, error) {
+	conds := make([]string, 0)
+	args := make([]interface{}, 0)
+	add := func(cond string, values ...interface{}) {
+		conds = append(conds, cond)
+		args = append(args, values...)
+	}
+
+	if !q.Address.IsZero() {
+		add("address = ?", q.Address.String())
+	}
+	if !q.Token.IsZero() {
+		add("token = ?", q.Token.String())
+	}
+	if len(q.Types) > 0 {
+		marks := make([]string, 0, len(q.Types))
+		values := make([]interface{}, 0, len(q.Types))
+		for _, t := range q.Types {
+			if t == types.Invalid {
+				return "", nil, errors.New("invalid block type")
+			}
+			marks = append(marks, "?")
+			values = append(values, t.String())
+		}
+		add(fmt.Sprintf("type in (%s)", strings.Join(marks, ", ")), values...)
+	}
+	if q.StartTime > 0 {
+		add("timestamp >= ?", q.StartTime)
+	}
+	if q.EndTime > 0 {
+		add("timestamp <= ?", q.EndTime)
+	}
+	if q.MinAmount != nil {
+		add("amount >= ?", q.MinAmount.String())
+	}
+	if q.MaxAmount != nil {
+		add("amount <= ?", q.MaxAmount.String())
+	}
+	if len(q.Method) > 0 {
+		add("method = ?", hex.EncodeToString(q.Method))
+	}
+	if !q.Link.IsZero() {
+		add("link = ?", q.Link.String())
+	}
+	if q.Cursor != "" {
+		c, err := parseBlockCursor(q.Cursor)
+		if err != nil {
+			return "", nil, err
+		}
+		add("(timestamp < ? or (timestamp = ? and hash < ?))", c.Timestamp, c.Timestamp, c.Hash)
+	}
+
+	if len(conds) == 0 {
+		return "", args, nil
+	}
+	return "where " + strings.Join(conds, " and "), args, nil
+}
+
+// QueryBlocks returns hashes of blocks matching query, newest first, and the cursor of the next page,
+// the cursor is empty if there are no more blocks
+func (r *Relation) QueryBlocks(q *BlockQuery) ([]types.Hash, string, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		return nil, "", fmt.Errorf("limit should not be greater than %d", MaxQueryLimit)
+	}
+	where, args, err := q.where()
+	if err != nil {
+		return nil, "", err
+	}
+
+	sql := fmt.Sprintf("select hash, timestamp from %s %s order by timestamp desc, hash desc limit %d",
+		r.tables[getIdentityID(new(types.BlockHash))].tableName, where, limit)
+	r.logger.Debug(sql, args)
+	var cs []blockCursor
+	if err := r.db.Select(&cs, r.db.Rebind(sql), args...); err != nil {
+		return nil, "", fmt.Errorf("query error, sql: %s, err: %s", sql, err.Error())
+	}
+
+	hs := make([]types.Hash, 0, len(cs))
+	for _, c := range cs {
+		var h types.Hash
+		if err := h.Of(c.Hash); err != nil {
+			return nil, "", err
+		}
+		hs = append(hs, h)
+	}
+	cursor := ""
+	if len(cs) == limit {
+		cursor = cs[len(cs)-1].String()
+	}
+	return hs, cursor, nil
+}
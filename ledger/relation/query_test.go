@@ -0,0 +1,91 @@
+package relation
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestRelation_QueryBlocks(t *testing.T) {
+	teardownTestCase, r := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	addr := mock.Address()
+	objs := make([]types.Schema, 0)
+	for i := 0; i < 10; i++ {
+		blk := mock.StateBlockWithoutWork()
+		blk.Timestamp = int64(1000 + i)
+		schemas, _ := blk.ConvertToSchema()
+		bh := schemas[0].(*types.BlockHash)
+		bh.Amount = types.NewBalance(int64(i * 100)).String()
+		if i%2 == 0 {
+			bh.Address = addr.String()
+			bh.Type = types.Send.String()
+		} else {
+			bh.Type = types.Receive.String()
+		}
+		objs = append(objs, bh)
+	}
+	if err := r.BatchAdd(objs); err != nil {
+		t.Fatal(err)
+	}
+
+	hs, cursor, err := r.QueryBlocks(&BlockQuery{Address: addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hs) != 5 || cursor != "" {
+		t.Fatal(len(hs), cursor)
+	}
+	if hs[0].String() != objs[8].(*types.BlockHash).Hash {
+		t.Fatal("blocks should be newest first")
+	}
+
+	hs, _, err = r.QueryBlocks(&BlockQuery{Types: []types.BlockType{types.Receive}, StartTime: 1003, EndTime: 1007})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hs) != 3 {
+		t.Fatal(len(hs))
+	}
+
+	min := types.NewBalance(200)
+	max := types.NewBalance(500)
+	hs, _, err = r.QueryBlocks(&BlockQuery{MinAmount: &min, MaxAmount: &max})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hs) != 4 {
+		t.Fatal(len(hs))
+	}
+
+	all := make([]types.Hash, 0)
+	q := &BlockQuery{Limit: 3}
+	for {
+		hs, cursor, err := r.QueryBlocks(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		all = append(all, hs...)
+		if cursor == "" {
+			break
+		}
+		q.Cursor = cursor
+	}
+	if len(all) != 10 {
+		t.Fatal(len(all))
+	}
+	for i, h := range all {
+		if h.String() != objs[9-i].(*types.BlockHash).Hash {
+			t.Fatal("invalid order of pages", i)
+		}
+	}
+
+	if _, _, err := r.QueryBlocks(&BlockQuery{Cursor: "1' or '1'='1"}); err != ErrInvalidCursor {
+		t.Fatal(err)
+	}
+	if _, _, err := r.QueryBlocks(&BlockQuery{Limit: MaxQueryLimit + 1}); err == nil {
+		t.Fatal("limit should be checked")
+	}
+}
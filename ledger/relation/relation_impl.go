@@ -9,7 +9,7 @@ import (
 )
 
 func (r *Relation) count(s types.Schema) (uint64, error) {
-	sql := fmt.Sprintf("select count (*) as total from %s", r.tables[getIdentityID(s)].tableName)
+	sql := fmt.Sprintf("select count(*) as total from %s", r.tables[getIdentityID(s)].tableName)
 	r.logger.Debug(sql)
 	var i uint64
 	err := r.db.Get(&i, sql)
@@ -91,6 +91,7 @@ func (r *Relation) BatchUpdate(fn func(txn *sqlx.Tx) error) error {
 		return fmt.Errorf("tx begin: %s", err)
 	}
 	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
 		return fmt.Errorf("tx fn: %s", err)
 	}
 	if err := tx.Commit(); err != nil {
@@ -86,6 +86,7 @@ func (r *Relation) Register(t types.Schema) error {
 	var s schema
 	rt := reflect.TypeOf(t).Elem()
 	var columns []string
+	var indexes []string
 	columnsMap := make(map[string]string)
 	key := ""
 	for i := 0; i < rt.NumField(); i++ {
@@ -94,19 +95,25 @@ func (r *Relation) Register(t types.Schema) error {
 			if _, b := tg.Lookup("key"); b {
 				key = column
 			}
+			if _, b := tg.Lookup("index"); b {
+				indexes = append(indexes, column)
+			}
 			columns = append(columns, column)
 			columnsMap[column] = convertSchemaType(r.drive, tg.Get("typ"))
 		}
 	}
 	s.tableName = rt.Name()
-	s.create = create(s.tableName, columnsMap, key)
+	s.create = create(r.drive, s.tableName, columnsMap, key, indexes)
+	s.indexes = createIndexes(r.drive, s.tableName, indexes)
 	s.insert = insert(s.tableName, columns)
 	r.tables[identityID] = s
 	r.logger.Debug(s.create)
 
-	if _, err := r.db.Exec(s.create); err != nil {
-		r.logger.Errorf("exec error, sql: %s, err: %s", s.create, err.Error())
-		return err
+	for _, sql := range append([]string{s.create}, s.indexes...) {
+		if _, err := r.db.Exec(sql); err != nil {
+			r.logger.Errorf("exec error, sql: %s, err: %s", sql, err.Error())
+			return err
+		}
 	}
 	return nil
 }
@@ -138,6 +145,13 @@ func (r *Relation) Delete(obj types.Schema) {
 	r.deleteChan <- obj
 }
 
+// BatchAdd inserts objs in one transaction, it does not wait for the objs queued by Add
+func (r *Relation) BatchAdd(objs []types.Schema) error {
+	return r.BatchUpdate(func(txn *sqlx.Tx) error {
+		return r.batchAdd(txn, objs)
+	})
+}
+
 func (r *Relation) batchAdd(txn *sqlx.Tx, objs []types.Schema) error {
 	//objsMap := make(map[string][]*BlockHash)
 	//for _, obj := range objs {
@@ -319,7 +333,26 @@ func getIdentityID(obj types.Schema) string {
 	return reflect.TypeOf(obj).String()
 }
 
-const version = 1
+// migration upgrades the relation schema to version, it runs before tables are registered,
+// so a table which is dropped is created again by Register with the latest schema
+type migration struct {
+	version int
+	name    string
+	migrate func(txn *sqlx.Tx) error
+}
+
+func dropTable(tableName string) func(txn *sqlx.Tx) error {
+	return func(txn *sqlx.Tx) error {
+		_, err := txn.Exec(fmt.Sprintf("drop table if exists %s", tableName))
+		return err
+	}
+}
+
+// blocks are indexed again from the ledger when the table is empty, see ledger initRelation
+var migrations = []migration{
+	{version: 1, name: "column types and indexes of drivers for BlockHash", migrate: dropTable("BlockHash")},
+	{version: 2, name: "token, link, amount and method columns for BlockHash", migrate: dropTable("BlockHash")},
+}
 
 func (r *Relation) init() error {
 	var v int
@@ -334,10 +367,32 @@ func (r *Relation) init() error {
 		if _, err := r.db.Exec("drop table if exists blockhash"); err != nil {
 			return fmt.Errorf("drop err: %s", err.Error())
 		}
-		r.logger.Info("update blockhash schema")
-		return nil
-	} else {
-		r.logger.Info("blockhash schema updated")
-		return nil
+		v = 0
+	}
+
+	for _, m := range migrations {
+		if m.version <= v {
+			continue
+		}
+		r.logger.Infof("migrate relation schema to version %d, %s", m.version, m.name)
+		if err := r.BatchUpdate(func(txn *sqlx.Tx) error {
+			if err := m.migrate(txn); err != nil {
+				return err
+			}
+			_, err := txn.Exec(txn.Rebind("update version set v = ?"), m.version)
+			return err
+		}); err != nil {
+			return fmt.Errorf("migrate to version %d err: %s", m.version, err)
+		}
+	}
+	return nil
+}
+
+// SchemaVersion returns the version of the relation schema
+func (r *Relation) SchemaVersion() (int, error) {
+	var v int
+	if err := r.db.Get(&v, "select v from version"); err != nil {
+		return 0, err
 	}
+	return v, nil
 }
@@ -2,44 +2,78 @@ package relation
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
 type schema struct {
 	tableName string
 	create    string
+	indexes   []string
 	insert    string
 	delete    string
 }
 
-func convertSchemaType(db string, typ string) string {
-	switch db {
-	case "sqlite", "sqlite3":
+// convertSchemaType converts the column type of `typ` tag, which is written in sqlite types, to the type of driver
+func convertSchemaType(driver string, typ string) string {
+	switch driver {
+	case "postgres":
+		switch typ {
+		case "integer":
+			return "bigint"
+		case "blob":
+			return "bytea"
+		}
 		return typ
 	case "mysql":
-		// TODO mysql type may different
+		switch typ {
+		case "integer":
+			return "bigint"
+		}
 		return typ
 	default:
-		return "varchar(100)"
+		return typ
 	}
 }
 
-func create(tableName string, columns map[string]string, key string) string {
-	cs := ""
-	keyTyp := ""
-	for k, v := range columns {
+func indexName(tableName string, column string) string {
+	return fmt.Sprintf("idx_%s_%s", strings.ToLower(tableName), column)
+}
+
+func create(driver string, tableName string, columns map[string]string, key string, indexes []string) string {
+	names := make([]string, 0, len(columns))
+	for k := range columns {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	cs := make([]string, 0, len(columns)+len(indexes))
+	if key != "" {
+		cs = append(cs, fmt.Sprintf("%s %s PRIMARY KEY NOT NULL", key, columns[key]))
+	}
+	for _, k := range names {
 		if k != key {
-			cs = cs + fmt.Sprintf(" %s %s ,", k, v)
-		} else {
-			keyTyp = v
+			cs = append(cs, fmt.Sprintf("%s %s", k, columns[k]))
+		}
+	}
+	// mysql has no `CREATE INDEX IF NOT EXISTS`, so indexes are created with the table
+	if driver == "mysql" {
+		for _, column := range indexes {
+			cs = append(cs, fmt.Sprintf("INDEX %s (%s)", indexName(tableName, column), column))
 		}
 	}
-	cs = strings.TrimRight(cs, ",")
-	if key == "" {
-		return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (%s)`, tableName, cs)
-	} else {
-		return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s ( %s %s PRIMARY KEY NOT NULL, %s)`, tableName, key, keyTyp, cs)
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (%s)`, tableName, strings.Join(cs, ", "))
+}
+
+func createIndexes(driver string, tableName string, indexes []string) []string {
+	if driver == "mysql" {
+		return nil
+	}
+	sqls := make([]string, 0, len(indexes))
+	for _, column := range indexes {
+		sqls = append(sqls, fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (%s)`, indexName(tableName, column), tableName, column))
 	}
+	return sqls
 }
 
 func insert(tableName string, columns []string) string {
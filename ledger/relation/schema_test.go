@@ -0,0 +1,52 @@
+package relation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConvertSchemaType(t *testing.T) {
+	tests := []struct {
+		driver string
+		typ    string
+		want   string
+	}{
+		{"sqlite3", "integer", "integer"},
+		{"postgres", "integer", "bigint"},
+		{"postgres", "blob", "bytea"},
+		{"postgres", "char(64)", "char(64)"},
+		{"mysql", "integer", "bigint"},
+		{"mysql", "varchar(15)", "varchar(15)"},
+	}
+	for _, tt := range tests {
+		if got := convertSchemaType(tt.driver, tt.typ); got != tt.want {
+			t.Fatalf("%s %s: got %s, want %s", tt.driver, tt.typ, got, tt.want)
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	columns := map[string]string{"hash": "char(64)", "address": "char(64)", "timestamp": "bigint"}
+	indexes := []string{"address", "timestamp"}
+
+	sql := create("postgres", "BlockHash", columns, "hash", indexes)
+	if sql != "CREATE TABLE IF NOT EXISTS BlockHash (hash char(64) PRIMARY KEY NOT NULL, address char(64), timestamp bigint)" {
+		t.Fatal(sql)
+	}
+	want := []string{
+		"CREATE INDEX IF NOT EXISTS idx_blockhash_address ON BlockHash (address)",
+		"CREATE INDEX IF NOT EXISTS idx_blockhash_timestamp ON BlockHash (timestamp)",
+	}
+	if got := createIndexes("postgres", "BlockHash", indexes); !reflect.DeepEqual(got, want) {
+		t.Fatal(got)
+	}
+
+	sql = create("mysql", "BlockHash", columns, "", indexes)
+	if sql != "CREATE TABLE IF NOT EXISTS BlockHash (address char(64), hash char(64), timestamp bigint, "+
+		"INDEX idx_blockhash_address (address), INDEX idx_blockhash_timestamp (timestamp))" {
+		t.Fatal(sql)
+	}
+	if got := createIndexes("mysql", "BlockHash", indexes); len(got) != 0 {
+		t.Fatal(got)
+	}
+}
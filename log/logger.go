@@ -29,6 +29,7 @@ const (
 var (
 	logger *zap.Logger
 	Root   *zap.SugaredLogger
+	level  = zap.NewAtomicLevelAt(zap.ErrorLevel)
 )
 
 func init() {
@@ -64,10 +65,11 @@ func Setup(cfg *config.Config) (err error) {
 	if err := l.Set(cfg.LogLevel); err != nil {
 		fmt.Println(err)
 	}
+	level.SetLevel(l)
 	consoleDebugging := zapcore.Lock(os.Stdout)
 	consoleEncoder := zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig())
 	core := zapcore.NewTee(
-		zapcore.NewCore(consoleEncoder, consoleDebugging, l),
+		zapcore.NewCore(consoleEncoder, consoleDebugging, level),
 		zapcore.NewCore(zapcore.NewJSONEncoder(zapcore.EncoderConfig{
 			TimeKey:        "ts",
 			LevelKey:       "level",
@@ -80,7 +82,7 @@ func Setup(cfg *config.Config) (err error) {
 			EncodeTime:     zapcore.ISO8601TimeEncoder,
 			EncodeDuration: zapcore.SecondsDurationEncoder,
 			EncodeCaller:   zapcore.ShortCallerEncoder,
-		}), w, l),
+		}), w, level),
 	)
 
 	logger = zap.New(core, zap.AddCaller(), zap.AddStacktrace(zap.ErrorLevel))
@@ -88,6 +90,16 @@ func Setup(cfg *config.Config) (err error) {
 	return nil
 }
 
+// SetLevel changes the level of the loggers set up by Setup at runtime, e.g. "debug", "info"
+func SetLevel(text string) error {
+	var l zapcore.Level
+	if err := l.Set(text); err != nil {
+		return err
+	}
+	level.SetLevel(l)
+	return nil
+}
+
 func Teardown() error {
 	if logger != nil {
 		return logger.Sync()
@@ -58,6 +58,31 @@ func TestInit(t *testing.T) {
 	logger.Warn("xxxxxxxxxxxxxxxxxxxxxx")
 }
 
+func TestSetLevel(t *testing.T) {
+	cfg, err := config.DefaultConfig(config.DefaultDataDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Setup(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := NewLogger("test3")
+	if logger.Desugar().Core().Enabled(zap.DebugLevel) {
+		t.Fatal("debug should be disabled")
+	}
+	if err := SetLevel("debug"); err != nil {
+		t.Fatal(err)
+	}
+	if !logger.Desugar().Core().Enabled(zap.DebugLevel) {
+		t.Fatal("debug should be enabled")
+	}
+	if err := SetLevel("xxx"); err == nil {
+		t.Fatal("invalid level should be rejected")
+	}
+	_ = SetLevel(cfg.LogLevel)
+}
+
 //
 //func TestDynamicLevel(t *testing.T) {
 //	ctx, cancel := context.WithCancel(context.Background())
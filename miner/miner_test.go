@@ -175,6 +175,26 @@ func TestMiner_Work(t *testing.T) {
 	}
 	mineBlk := outArgs1["mineBlock"].(*types.PovMineBlock)
 
+	for _, algo := range []types.PovAlgoType{types.ALGO_NIST5, types.ALGO_LYRA2Z, types.ALGO_X16R} {
+		inArgsAlgo := make(map[interface{}]interface{})
+		inArgsAlgo["minerAddr"] = minerAcc.Address()
+		inArgsAlgo["algoName"] = algo.String()
+		outArgsAlgo := make(map[interface{}]interface{})
+		md.m.povWorker.OnEventRpcSyncCall(&topic.EventRPCSyncCallMsg{Name: "Miner.GetWork", In: inArgsAlgo, Out: outArgsAlgo})
+		if algo == types.ALGO_X16R {
+			if outArgsAlgo["err"] == nil {
+				t.Fatal("x16r should not be supported")
+			}
+			continue
+		}
+		if outArgsAlgo["mineBlock"] == nil {
+			t.Fatalf("failed to GetWork of %s", algo)
+		}
+		if hdr := outArgsAlgo["mineBlock"].(*types.PovMineBlock).Header; hdr.GetAlgoType() != algo {
+			t.Fatalf("exp: %s, act: %s", algo, hdr.GetAlgoType())
+		}
+	}
+
 	inArgs2 := make(map[interface{}]interface{})
 	mineRes := types.NewPovMineResult()
 	mineRes.WorkHash = mineBlk.WorkHash
@@ -248,6 +268,34 @@ func TestMiner_Mining(t *testing.T) {
 	_ = md.m.Stop()
 }
 
+func TestMiner_ApplyConfig(t *testing.T) {
+	tearDone, md := setupTestCasePov(t)
+	defer tearDone(t)
+
+	_ = md.m.Init()
+	w := md.m.povWorker
+
+	old, _ := md.cfg.Clone()
+	cur, _ := md.cfg.Clone()
+	minerAcc := mock.Account()
+	cur.PoV.Coinbase = minerAcc.Address().String()
+	cur.PoV.AlgoName = types.ALGO_X11.String()
+	cur.PoV.MinerEnabled = true
+	w.applyConfig(old, cur)
+	if w.GetMinerAddress() != minerAcc.Address() || w.GetAlgoType() != types.ALGO_X11 || !w.cpuMining {
+		t.Fatal("miner config not applied", w.GetMinerAddress(), w.GetAlgoType(), w.cpuMining)
+	}
+
+	old, cur = cur, md.cfg
+	cur.PoV.AlgoName = types.ALGO_X11.String()
+	cur.PoV.MinerEnabled = false
+	w.applyConfig(old, cur)
+	if w.cpuMining || w.GetMinerAddress() != types.ZeroAddress || w.GetAlgoType() != types.ALGO_X11 {
+		t.Fatal("miner config not applied", w.GetMinerAddress(), w.GetAlgoType(), w.cpuMining)
+	}
+	close(w.quitCh)
+}
+
 func mockMinerGeneratePovBlocksToLedger(l ledger.Store, blkNum int) ([]*types.PovBlock, error) {
 	var prevBlk *types.PovBlock
 	var allBlks []*types.PovBlock
@@ -41,6 +41,15 @@ type PovWorker struct {
 	feb            *event.FeedEventBus
 	febRpcMsgCh    chan *topic.EventRPCSyncCallMsg
 	febRpcMsgSubID event.FeedSubscription
+
+	cfgManager  *config.CfgManager
+	cfgChangeCh chan *povWorkerCfgChange
+	cfgSubID    int
+}
+
+type povWorkerCfgChange struct {
+	old *config.Config
+	cur *config.Config
 }
 
 type PovMinerAlgoBlock struct {
@@ -56,7 +65,9 @@ func NewPovWorker(cc *context.ChainContext, miner *Miner) *PovWorker {
 		feb:    cc.FeedEventBus(),
 
 		febRpcMsgCh: make(chan *topic.EventRPCSyncCallMsg, 100),
+		cfgChangeCh: make(chan *povWorkerCfgChange, 1),
 	}
+	worker.cfgManager, _ = cc.ConfigManager()
 	worker.mineBlockPool = make(map[types.Hash]*types.PovMineBlock)
 	worker.minerAlgoBlocks = make(map[types.Address]map[types.PovAlgoType]*PovMinerAlgoBlock)
 
@@ -85,22 +96,63 @@ func (w *PovWorker) Init() error {
 
 	cfg := w.GetConfig()
 	if cfg.PoV.Coinbase != "" {
-		var err error
-		w.minerAddr, err = types.HexToAddress(cfg.PoV.Coinbase)
-		if err != nil {
-			w.logger.Errorf("invalid coinbase address %s", cfg.PoV.Coinbase)
+		w.setCoinbase(cfg.PoV.Coinbase)
+	}
+	if cfg.PoV.AlgoName != "" {
+		w.setAlgoName(cfg.PoV.AlgoName)
+	}
+
+	return nil
+}
+
+func (w *PovWorker) setCoinbase(coinbase string) {
+	var err error
+	w.minerAccount = nil
+	w.minerAddr, err = types.HexToAddress(coinbase)
+	if err != nil {
+		w.logger.Errorf("invalid coinbase address %s", coinbase)
+		w.minerAddr = types.ZeroAddress
+	}
+}
+
+func (w *PovWorker) setAlgoName(algoName string) {
+	w.algoType = types.NewPoVHashAlgoFromStr(algoName)
+	if !common.PovIsAlgoSupported(w.algoType) {
+		w.logger.Errorf("invalid algo name %s", algoName)
+		w.algoType = types.ALGO_SHA256D
+	}
+}
+
+// applyConfig applies the reloaded miner config, only the changed settings are applied
+// so the miner address and algo set by StartMining are kept
+func (w *PovWorker) applyConfig(old, cur *config.Config) {
+	if old.PoV.Coinbase != cur.PoV.Coinbase {
+		if cur.PoV.Coinbase != "" {
+			w.setCoinbase(cur.PoV.Coinbase)
+		} else {
+			w.minerAccount = nil
 			w.minerAddr = types.ZeroAddress
 		}
 	}
-	if cfg.PoV.AlgoName != "" {
-		w.algoType = types.NewPoVHashAlgoFromStr(cfg.PoV.AlgoName)
-		if w.algoType == types.ALGO_UNKNOWN {
-			w.logger.Errorf("invalid algo name %s", cfg.PoV.AlgoName)
-			w.algoType = types.ALGO_SHA256D
+	if old.PoV.AlgoName != cur.PoV.AlgoName {
+		if cur.PoV.AlgoName != "" {
+			w.setAlgoName(cur.PoV.AlgoName)
+		} else {
+			w.algoType = types.ALGO_UNKNOWN
 		}
 	}
+	if old.PoV.MinerEnabled == cur.PoV.MinerEnabled {
+		return
+	}
 
-	return nil
+	if !cur.PoV.MinerEnabled {
+		w.cpuMining = false
+		return
+	}
+	if !w.cpuMining && w.algoType != types.ALGO_UNKNOWN && !w.minerAddr.IsZero() {
+		w.cpuMining = true
+		common.Go(w.cpuMiningLoop)
+	}
 }
 
 func (w *PovWorker) Start() error {
@@ -110,6 +162,15 @@ func (w *PovWorker) Start() error {
 
 	common.Go(w.loop)
 
+	if w.cfgManager != nil {
+		w.cfgSubID = w.cfgManager.Subscribe(func(old, cur *config.Config) {
+			select {
+			case w.cfgChangeCh <- &povWorkerCfgChange{old: old, cur: cur}:
+			case <-w.quitCh:
+			}
+		}, config.SectionMiner)
+	}
+
 	if cfg.PoV.MinerEnabled && w.algoType != types.ALGO_UNKNOWN && !w.minerAddr.IsZero() {
 		w.cpuMining = true
 		common.Go(w.cpuMiningLoop)
@@ -120,6 +181,9 @@ func (w *PovWorker) Start() error {
 
 func (w *PovWorker) Stop() error {
 	w.febRpcMsgSubID.Unsubscribe()
+	if w.cfgManager != nil {
+		w.cfgManager.Unsubscribe(w.cfgSubID)
+	}
 
 	if w.quitCh != nil {
 		close(w.quitCh)
@@ -167,6 +231,8 @@ func (w *PovWorker) loop() {
 			return
 		case msg := <-w.febRpcMsgCh:
 			w.OnEventRpcSyncCall(msg)
+		case c := <-w.cfgChangeCh:
+			w.applyConfig(c.old, c.cur)
 		}
 	}
 }
@@ -208,7 +274,7 @@ func (w *PovWorker) GetWork(in interface{}, out interface{}) {
 	algoName := inArgs["algoName"].(string)
 	algoType := types.NewPoVHashAlgoFromStr(algoName)
 	if !common.PovIsAlgoSupported(algoType) {
-		outArgs["err"] = errors.New("unknown algorithm name")
+		outArgs["err"] = fmt.Errorf("unsupported algorithm name %s", algoName)
 		return
 	}
 
@@ -274,6 +340,11 @@ func (w *PovWorker) StartMining(in interface{}, out interface{}) {
 		return
 	}
 
+	if algoType != types.ALGO_UNKNOWN && !common.PovIsAlgoSupported(algoType) {
+		outArgs["err"] = fmt.Errorf("unsupported algorithm name %s", algoName)
+		return
+	}
+
 	if !minerAddr.IsZero() {
 		err := w.checkMinerPledge(minerAddr)
 		if err != nil {
@@ -8,10 +8,14 @@ import (
 
 	event "github.com/qlcchain/go-qlc/common/event"
 
+	io "io"
+
 	ledger "github.com/qlcchain/go-qlc/ledger"
 
 	mock "github.com/stretchr/testify/mock"
 
+	relation "github.com/qlcchain/go-qlc/ledger/relation"
+
 	storage "github.com/qlcchain/go-qlc/common/storage"
 
 	types "github.com/qlcchain/go-qlc/common/types"
@@ -129,6 +133,20 @@ func (_m *Store) AddBlockPrivatePayload(hash types.Hash, payload []byte) error {
 	return r0
 }
 
+// AddEquivocation provides a mock function with given fields: e
+func (_m *Store) AddEquivocation(e *types.Equivocation) error {
+	ret := _m.Called(e)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.Equivocation) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddFrontier provides a mock function with given fields: frontier, c
 func (_m *Store) AddFrontier(frontier *types.Frontier, c storage.Cache) error {
 	ret := _m.Called(frontier, c)
@@ -220,6 +238,20 @@ func (_m *Store) AddOrUpdatePeerInfo(value *types.PeerInfo) error {
 	return r0
 }
 
+// AddOrUpdatePeerBan provides a mock function with given fields: ban
+func (_m *Store) AddOrUpdatePeerBan(ban *types.PeerBan) error {
+	ret := _m.Called(ban)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.PeerBan) error); ok {
+		r0 = rf(ban)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddOrUpdateVmLogs provides a mock function with given fields: value, c
 func (_m *Store) AddOrUpdateVmLogs(value *types.VmLogs, c storage.Cache) error {
 	ret := _m.Called(value, c)
@@ -1245,6 +1277,20 @@ func (_m *Store) DeleteGapPublishBlock(key types.Hash, blkHash types.Hash) error
 	return r0
 }
 
+// DeletePeerBan provides a mock function with given fields: peerID
+func (_m *Store) DeletePeerBan(peerID string) error {
+	ret := _m.Called(peerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(peerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePending provides a mock function with given fields: key, c
 func (_m *Store) DeletePending(key *types.PendingKey, c storage.Cache) error {
 	ret := _m.Called(key, c)
@@ -1562,6 +1608,29 @@ func (_m *Store) EventBus() event.EventBus {
 	return r0
 }
 
+// ExportSnapshot provides a mock function with given fields: w
+func (_m *Store) ExportSnapshot(w io.Writer) (*ledger.SnapshotInfo, error) {
+	ret := _m.Called(w)
+
+	var r0 *ledger.SnapshotInfo
+	if rf, ok := ret.Get(0).(func(io.Writer) *ledger.SnapshotInfo); ok {
+		r0 = rf(w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ledger.SnapshotInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(io.Writer) error); ok {
+		r1 = rf(w)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Flush provides a mock function with given fields:
 func (_m *Store) Flush() error {
 	ret := _m.Called()
@@ -1728,6 +1797,29 @@ func (_m *Store) GetAccountMeta(address types.Address, c ...storage.Cache) (*typ
 	return r0, r1
 }
 
+// GetAccountMetaAtPovHeight provides a mock function with given fields: address, height
+func (_m *Store) GetAccountMetaAtPovHeight(address types.Address, height uint64) (*types.AccountMeta, error) {
+	ret := _m.Called(address, height)
+
+	var r0 *types.AccountMeta
+	if rf, ok := ret.Get(0).(func(types.Address, uint64) *types.AccountMeta); ok {
+		r0 = rf(address, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.AccountMeta)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, uint64) error); ok {
+		r1 = rf(address, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAccountMetaByPovHeight provides a mock function with given fields: address, height
 func (_m *Store) GetAccountMetaByPovHeight(address types.Address, height uint64) (*types.AccountMeta, error) {
 	ret := _m.Called(address, height)
@@ -2089,6 +2181,57 @@ func (_m *Store) GetCacheStatue() map[string]string {
 	return r0
 }
 
+// GetEquivocation provides a mock function with given fields: account, root
+func (_m *Store) GetEquivocation(account types.Address, root types.Hash) (*types.Equivocation, error) {
+	ret := _m.Called(account, root)
+
+	var r0 *types.Equivocation
+	if rf, ok := ret.Get(0).(func(types.Address, types.Hash) *types.Equivocation); ok {
+		r0 = rf(account, root)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Equivocation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Hash) error); ok {
+		r1 = rf(account, root)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEquivocations provides a mock function with given fields: fn
+func (_m *Store) GetEquivocations(fn func(*types.Equivocation) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*types.Equivocation) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetEquivocationsByAccount provides a mock function with given fields: account, fn
+func (_m *Store) GetEquivocationsByAccount(account types.Address, fn func(*types.Equivocation) error) error {
+	ret := _m.Called(account, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, func(*types.Equivocation) error) error); ok {
+		r0 = rf(account, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetFrontier provides a mock function with given fields: hash, cache
 func (_m *Store) GetFrontier(hash types.Hash, cache ...storage.Cache) (*types.Frontier, error) {
 	_va := make([]interface{}, len(cache))
@@ -2367,6 +2510,43 @@ func (_m *Store) GetOnlineRepresentations() ([]types.Address, error) {
 	return r0, r1
 }
 
+// GetPeerBan provides a mock function with given fields: peerID
+func (_m *Store) GetPeerBan(peerID string) (*types.PeerBan, error) {
+	ret := _m.Called(peerID)
+
+	var r0 *types.PeerBan
+	if rf, ok := ret.Get(0).(func(string) *types.PeerBan); ok {
+		r0 = rf(peerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PeerBan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(peerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPeerBans provides a mock function with given fields: fn
+func (_m *Store) GetPeerBans(fn func(*types.PeerBan) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*types.PeerBan) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetPeerInfo provides a mock function with given fields: peerID
 func (_m *Store) GetPeerInfo(peerID string) (*types.PeerInfo, error) {
 	ret := _m.Called(peerID)
@@ -2441,6 +2621,20 @@ func (_m *Store) GetPendings(fn func(*types.PendingKey, *types.PendingInfo) erro
 	return r0
 }
 
+// GetPendingsAtPovHeight provides a mock function with given fields: address, height, fn
+func (_m *Store) GetPendingsAtPovHeight(address types.Address, height uint64, fn func(*types.PendingKey, *types.PendingInfo) error) error {
+	ret := _m.Called(address, height, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, uint64, func(*types.PendingKey, *types.PendingInfo) error) error); ok {
+		r0 = rf(address, height, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetPendingsByAddress provides a mock function with given fields: address, fn
 func (_m *Store) GetPendingsByAddress(address types.Address, fn func(*types.PendingKey, *types.PendingInfo) error) error {
 	ret := _m.Called(address, fn)
@@ -2829,6 +3023,27 @@ func (_m *Store) GetPovTxlScanCursor() (uint64, error) {
 	return r0, r1
 }
 
+// GetPrunedHeight provides a mock function with given fields:
+func (_m *Store) GetPrunedHeight() (uint64, error) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRandomStateBlock provides a mock function with given fields:
 func (_m *Store) GetRandomStateBlock() (*types.StateBlock, error) {
 	ret := _m.Called()
@@ -3113,6 +3328,29 @@ func (_m *Store) GetTokenMetaByBlockHash(hash types.Hash) (*types.TokenMeta, err
 	return r0, r1
 }
 
+// GetTokenMetaAtPovHeight provides a mock function with given fields: address, token, height
+func (_m *Store) GetTokenMetaAtPovHeight(address types.Address, token types.Hash, height uint64) (*types.TokenMeta, error) {
+	ret := _m.Called(address, token, height)
+
+	var r0 *types.TokenMeta
+	if rf, ok := ret.Get(0).(func(types.Address, types.Hash, uint64) *types.TokenMeta); ok {
+		r0 = rf(address, token, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TokenMeta)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Hash, uint64) error); ok {
+		r1 = rf(address, token, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTokenMetaByPovHeight provides a mock function with given fields: address, token, height
 func (_m *Store) GetTokenMetaByPovHeight(address types.Address, token types.Hash, height uint64) (*types.TokenMeta, error) {
 	ret := _m.Called(address, token, height)
@@ -3358,6 +3596,27 @@ func (_m *Store) HasBlockCache(key types.Hash) (bool, error) {
 	return r0, r1
 }
 
+// HasEquivocation provides a mock function with given fields: account, root
+func (_m *Store) HasEquivocation(account types.Address, root types.Hash) (bool, error) {
+	ret := _m.Called(account, root)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Address, types.Hash) bool); ok {
+		r0 = rf(account, root)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Hash) error); ok {
+		r1 = rf(account, root)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HasPovBlock provides a mock function with given fields: height, hash, batch
 func (_m *Store) HasPovBlock(height uint64, hash types.Hash, batch ...storage.Batch) bool {
 	_va := make([]interface{}, len(batch))
@@ -3789,6 +4048,80 @@ func (_m *Store) PovHeightHasGap(height uint64) (bool, error) {
 	return r0, r1
 }
 
+// PruneBlocks provides a mock function with given fields: keep
+func (_m *Store) PruneBlocks(keep uint64) (*ledger.PruneResult, error) {
+	ret := _m.Called(keep)
+
+	var r0 *ledger.PruneResult
+	if rf, ok := ret.Get(0).(func(uint64) *ledger.PruneResult); ok {
+		r0 = rf(keep)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ledger.PruneResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(keep)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryBlocks provides a mock function with given fields: query
+func (_m *Store) QueryBlocks(query *relation.BlockQuery) ([]types.Hash, string, error) {
+	ret := _m.Called(query)
+
+	var r0 []types.Hash
+	if rf, ok := ret.Get(0).(func(*relation.BlockQuery) []types.Hash); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Hash)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(*relation.BlockQuery) string); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*relation.BlockQuery) error); ok {
+		r2 = rf(query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RebuildRelation provides a mock function with given fields:
+func (_m *Store) RebuildRelation() (uint64, error) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveStorage provides a mock function with given fields: key, val, c
 func (_m *Store) RemoveStorage(key []byte, val []byte, c storage.Cache) error {
 	ret := _m.Called(key, val, c)
@@ -3950,6 +4283,20 @@ func (_m *Store) SetStorage(val map[string]interface{}) error {
 	return r0
 }
 
+// SignStateBlock provides a mock function with given fields: block, signer
+func (_m *Store) SignStateBlock(block *types.StateBlock, signer types.Signer) error {
+	ret := _m.Called(block, signer)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.StateBlock, types.Signer) error); ok {
+		r0 = rf(block, signer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SubRepresentation provides a mock function with given fields: address, diff, c
 func (_m *Store) SubRepresentation(address types.Address, diff *types.Benefit, c storage.Cache) error {
 	ret := _m.Called(address, diff, c)
@@ -4013,6 +4360,20 @@ func (_m *Store) UpdateAccountMeteCache(value *types.AccountMeta, batch ...stora
 	return r0
 }
 
+// UpdateEquivocation provides a mock function with given fields: e
+func (_m *Store) UpdateEquivocation(e *types.Equivocation) error {
+	ret := _m.Called(e)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.Equivocation) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdatePeerInfo provides a mock function with given fields: value
 func (_m *Store) UpdatePeerInfo(value *types.PeerInfo) error {
 	ret := _m.Called(value)
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package prometheus exposes go-metrics registries in the Prometheus text exposition format.
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var quantiles = []float64{0.5, 0.75, 0.95, 0.99, 0.999}
+
+type family struct {
+	name    string
+	typ     string
+	samples []string
+}
+
+func (f *family) add(suffix, labels string, v float64) {
+	f.samples = append(f.samples, fmt.Sprintf("%s%s%s %s", f.name, suffix, labels, formatFloat(v)))
+}
+
+func (f *family) summary(count int64, sum float64, ps []float64, scale float64) {
+	for i, q := range quantiles {
+		f.add("", fmt.Sprintf("{quantile=\"%s\"}", formatFloat(q)), ps[i]/scale)
+	}
+	f.add("_sum", "", sum/scale)
+	f.add("_count", "", float64(count))
+}
+
+// Handler returns a http handler which writes metrics of registries, the name of each metric is
+// prefixed by namespace and sanitized, e.g. `/system/trie.gc.runs` is exported as `qlc_system_trie_gc_runs_total`
+func Handler(namespace string, registries ...metrics.Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(Gather(namespace, registries...))
+	})
+}
+
+// Gather returns metrics of registries in the text exposition format, sorted by name
+func Gather(namespace string, registries ...metrics.Registry) []byte {
+	families := make(map[string]*family)
+	for _, reg := range registries {
+		reg.Each(func(name string, i interface{}) {
+			if f := collect(metricName(namespace, name), i); f != nil {
+				if _, ok := families[f.name]; !ok {
+					families[f.name] = f
+				}
+			}
+		})
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		f := families[name]
+		fmt.Fprintf(&buf, "# TYPE %s %s\n", f.name, f.typ)
+		for _, s := range f.samples {
+			buf.WriteString(s)
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.Bytes()
+}
+
+func collect(name string, i interface{}) *family {
+	switch metric := i.(type) {
+	case metrics.Counter:
+		f := &family{name: name + "_total", typ: "counter"}
+		f.add("", "", float64(metric.Count()))
+		return f
+	case metrics.Gauge:
+		f := &family{name: name, typ: "gauge"}
+		f.add("", "", float64(metric.Value()))
+		return f
+	case metrics.GaugeFloat64:
+		f := &family{name: name, typ: "gauge"}
+		f.add("", "", metric.Value())
+		return f
+	case metrics.Histogram:
+		ms := metric.Snapshot()
+		f := &family{name: name, typ: "summary"}
+		f.summary(ms.Count(), float64(ms.Sum()), ms.Percentiles(quantiles), 1)
+		return f
+	case metrics.Meter:
+		f := &family{name: name + "_total", typ: "counter"}
+		f.add("", "", float64(metric.Count()))
+		return f
+	case metrics.Timer:
+		// timers are updated by durations in nanoseconds
+		ms := metric.Snapshot()
+		f := &family{name: name + "_seconds", typ: "summary"}
+		f.summary(ms.Count(), float64(ms.Sum()), ms.Percentiles(quantiles), float64(time.Second))
+		return f
+	default:
+		return nil
+	}
+}
+
+// metricName replaces characters which are invalid in Prometheus metric names with underscores
+func metricName(namespace, name string) string {
+	var b strings.Builder
+	underscore := true
+	for _, c := range namespace + "_" + name {
+		if c == ':' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			if b.Len() == 0 && c >= '0' && c <= '9' {
+				b.WriteByte('_')
+			}
+			b.WriteRune(c)
+			underscore = false
+		} else if !underscore {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
+
+// Prometheus serves metrics of registries at path of the endpoint, such as `tcp://0.0.0.0:9747`,
+// until ctx is done, onError is called if the server stops serving before that
+func Prometheus(ctx context.Context, endpoint, path string, onError func(error), registries ...metrics.Registry) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen(u.Scheme, u.Host)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %s (%s)", err, endpoint)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, Handler("qlc", registries...))
+	srv := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	go func() {
+		if err := srv.Serve(lis); err != nil && err != http.ErrServerClosed && onError != nil {
+			onError(fmt.Errorf("prometheus exporter stopped: %s (%s)", err, endpoint))
+		}
+	}()
+	return nil
+}
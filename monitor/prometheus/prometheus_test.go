@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package prometheus
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+func TestMetricName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"/system/trie.gc.runs", "qlc_system_trie_gc_runs"},
+		{"/system/runtime.MemStats.Alloc", "qlc_system_runtime_MemStats_Alloc"},
+		{"dpos.processor.0.blockQueue", "qlc_dpos_processor_0_blockQueue"},
+		{"a--b..", "qlc_a_b"},
+	}
+	for _, tt := range tests {
+		if got := metricName("qlc", tt.name); got != tt.want {
+			t.Fatalf("metricName(%s) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+	if got := metricName("", "0abc"); got != "_0abc" {
+		t.Fatal(got)
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	if formatFloat(math.Inf(1)) != "+Inf" || formatFloat(math.Inf(-1)) != "-Inf" || formatFloat(math.NaN()) != "NaN" {
+		t.Fatal("invalid special values")
+	}
+	if formatFloat(1.5) != "1.5" || formatFloat(100) != "100" {
+		t.Fatal("invalid values")
+	}
+}
+
+func TestGather(t *testing.T) {
+	r := metrics.NewPrefixedChildRegistry(metrics.NewRegistry(), "/test/")
+	metrics.GetOrRegisterCounter("counter", r).Inc(3)
+	metrics.GetOrRegisterGauge("gauge", r).Update(7)
+	metrics.GetOrRegisterGaugeFloat64("float", r).Update(0.25)
+	h := metrics.GetOrRegisterHistogram("histogram", r, metrics.NewUniformSample(100))
+	h.Update(1)
+	h.Update(3)
+	metrics.GetOrRegisterMeter("meter", r).Mark(2)
+	metrics.GetOrRegisterTimer("timer", r).Update(2 * time.Second)
+
+	out := string(Gather("qlc", r))
+	t.Log(out)
+	for _, line := range []string{
+		"# TYPE qlc_test_counter_total counter\nqlc_test_counter_total 3\n",
+		"# TYPE qlc_test_gauge gauge\nqlc_test_gauge 7\n",
+		"# TYPE qlc_test_float gauge\nqlc_test_float 0.25\n",
+		"# TYPE qlc_test_histogram summary\n",
+		"qlc_test_histogram{quantile=\"0.5\"} 2\n",
+		"qlc_test_histogram_sum 4\nqlc_test_histogram_count 2\n",
+		"# TYPE qlc_test_meter_total counter\nqlc_test_meter_total 2\n",
+		"# TYPE qlc_test_timer_seconds summary\n",
+		"qlc_test_timer_seconds{quantile=\"0.99\"} 2\n",
+		"qlc_test_timer_seconds_sum 2\nqlc_test_timer_seconds_count 1\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("%q not found", line)
+		}
+	}
+	if strings.Index(out, "qlc_test_counter_total") > strings.Index(out, "qlc_test_gauge") {
+		t.Fatal("metrics should be sorted by name")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	r := metrics.NewRegistry()
+	metrics.GetOrRegisterGauge("height", r).Update(10)
+
+	w := httptest.NewRecorder()
+	Handler("qlc", r).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if ct := w.Header().Get("Content-Type"); ct != contentType {
+		t.Fatal(ct)
+	}
+	if !strings.Contains(w.Body.String(), "qlc_height 10\n") {
+		t.Fatal(w.Body.String())
+	}
+}
+
+func TestPrometheus(t *testing.T) {
+	r := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter("blocks", r).Inc(1)
+
+	errs := make(chan error, 1)
+	onError := func(err error) {
+		errs <- err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := Prometheus(ctx, "tcp://invalid:address:1", "/metrics", onError, r); err == nil {
+		t.Fatal("invalid address should return error")
+	}
+	if err := Prometheus(ctx, "tcp://127.0.0.1:19747", "/metrics", onError, r); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get("http://127.0.0.1:19747/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "qlc_blocks_total 1\n") {
+		t.Fatal(string(body))
+	}
+
+	// closing the exporter is not reported as an error
+	cancel()
+	select {
+	case err := <-errs:
+		t.Fatal(err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package tracing records OpenTelemetry style spans of the lifecycle of blocks, spans of the same block
+// share one trace, which is started by the first span of the block, e.g. `LedgerAPI.Process`.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bluele/gcache"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+const (
+	// AttrBlockHash is the attribute key of the block hash
+	AttrBlockHash = "block.hash"
+
+	blockTraceCacheSize = 100000
+	blockTraceExpire    = 10 * time.Minute
+	queueSize           = 4096
+	batchSize           = 512
+	flushInterval       = time.Second
+)
+
+type TraceID [16]byte
+
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+type SpanID [8]byte
+
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+func (s SpanID) IsZero() bool {
+	return s == SpanID{}
+}
+
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func BlockHash(hash types.Hash) Attribute {
+	return Attribute{Key: AttrBlockHash, Value: hash.String()}
+}
+
+// SpanData is an ended span
+type SpanData struct {
+	TraceID  TraceID
+	SpanID   SpanID
+	ParentID SpanID
+	Name     string
+	Start    time.Time
+	End      time.Time
+	Attrs    []Attribute
+	Err      string
+}
+
+// Span is an operation in a trace, methods of nil span do nothing, so callers do not need to check
+// whether the tracing is enabled
+type Span struct {
+	tracer *Tracer
+	mu     sync.Mutex
+	data   SpanData
+	ended  bool
+}
+
+// SetAttributes adds attributes to the span
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Attrs = append(s.data.Attrs, attrs...)
+}
+
+// SetError marks the span failed if err is not nil
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Err = err.Error()
+}
+
+// End ends the span and queues it to be exported, only the first call takes effect
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.End = time.Now()
+	data := s.data
+	s.mu.Unlock()
+	s.tracer.enqueue(&data)
+}
+
+// Child starts a span whose parent is s
+func (s *Span) Child(name string, attrs ...Attribute) *Span {
+	if s == nil {
+		return nil
+	}
+	return s.tracer.newSpan(s.data.TraceID, s.data.SpanID, name, attrs)
+}
+
+// Exporter sends ended spans to a backend
+type Exporter interface {
+	Export(spans []*SpanData) error
+	Close() error
+}
+
+// Tracer creates spans and exports them in batches
+type Tracer struct {
+	exporter Exporter
+	blocks   gcache.Cache
+	queue    chan *SpanData
+	dropped  uint64
+	quit     chan struct{}
+	wg       sync.WaitGroup
+	onError  func(error)
+}
+
+// NewTracer starts a tracer which exports spans by exporter, errors of exporting are passed to onError if it's not nil
+func NewTracer(exporter Exporter, onError func(error)) *Tracer {
+	t := &Tracer{
+		exporter: exporter,
+		blocks:   gcache.New(blockTraceCacheSize).LRU().Expiration(blockTraceExpire).Build(),
+		queue:    make(chan *SpanData, queueSize),
+		quit:     make(chan struct{}),
+		onError:  onError,
+	}
+	t.wg.Add(1)
+	go t.run()
+	return t
+}
+
+type blockTrace struct {
+	traceID TraceID
+	spanID  SpanID
+}
+
+// StartBlock starts a span of the block, the span is a child of the first span of the block,
+// or the root of a new trace if there is no span of the block yet
+func (t *Tracer) StartBlock(hash types.Hash, name string, attrs ...Attribute) *Span {
+	if t == nil {
+		return nil
+	}
+	attrs = append([]Attribute{BlockHash(hash)}, attrs...)
+	if v, err := t.blocks.Get(hash); err == nil {
+		bt := v.(*blockTrace)
+		return t.newSpan(bt.traceID, bt.spanID, name, attrs)
+	}
+
+	var traceID TraceID
+	_, _ = rand.Read(traceID[:])
+	s := t.newSpan(traceID, SpanID{}, name, attrs)
+	_ = t.blocks.Set(hash, &blockTrace{traceID: traceID, spanID: s.data.SpanID})
+	return s
+}
+
+func (t *Tracer) newSpan(traceID TraceID, parent SpanID, name string, attrs []Attribute) *Span {
+	s := &Span{
+		tracer: t,
+		data: SpanData{
+			TraceID:  traceID,
+			ParentID: parent,
+			Name:     name,
+			Start:    time.Now(),
+			Attrs:    attrs,
+		},
+	}
+	_, _ = rand.Read(s.data.SpanID[:])
+	return s
+}
+
+func (t *Tracer) enqueue(data *SpanData) {
+	select {
+	case t.queue <- data:
+	default:
+		atomic.AddUint64(&t.dropped, 1)
+	}
+}
+
+// Dropped returns the number of spans dropped because the export queue is full
+func (t *Tracer) Dropped() uint64 {
+	return atomic.LoadUint64(&t.dropped)
+}
+
+func (t *Tracer) run() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(batch); err != nil && t.onError != nil {
+			t.onError(err)
+		}
+		batch = make([]*SpanData, 0, batchSize)
+	}
+
+	for {
+		select {
+		case <-t.quit:
+			for {
+				select {
+				case data := <-t.queue:
+					batch = append(batch, data)
+				default:
+					flush()
+					return
+				}
+			}
+		case data := <-t.queue:
+			batch = append(batch, data)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// Close exports the queued spans and closes the exporter
+func (t *Tracer) Close() error {
+	if t == nil {
+		return nil
+	}
+	close(t.quit)
+	t.wg.Wait()
+	return t.exporter.Close()
+}
+
+type holder struct {
+	tracer *Tracer
+}
+
+var global atomic.Value
+
+func init() {
+	global.Store(holder{})
+}
+
+// SetTracer sets the global tracer, nil disables tracing
+func SetTracer(t *Tracer) {
+	global.Store(holder{tracer: t})
+}
+
+// GetTracer returns the global tracer, nil if tracing is disabled
+func GetTracer() *Tracer {
+	return global.Load().(holder).tracer
+}
+
+// Enabled returns true if the global tracer is set, callers can skip preparing attributes of spans if it's false
+func Enabled() bool {
+	return GetTracer() != nil
+}
+
+// StartBlock starts a span of the block by the global tracer, it returns nil if tracing is disabled
+func StartBlock(hash types.Hash, name string, attrs ...Attribute) *Span {
+	return GetTracer().StartBlock(hash, name, attrs...)
+}
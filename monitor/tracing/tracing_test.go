@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package tracing
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+type memExporter struct {
+	mu     sync.Mutex
+	spans  []*SpanData
+	closed bool
+}
+
+func (e *memExporter) Export(spans []*SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *memExporter) Close() error {
+	e.closed = true
+	return nil
+}
+
+func attr(s *SpanData, key string) string {
+	for _, a := range s.Attrs {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func TestTracer_StartBlock(t *testing.T) {
+	e := &memExporter{}
+	tracer := NewTracer(e, nil)
+
+	h1 := types.Hash{1}
+	h2 := types.Hash{2}
+	root := tracer.StartBlock(h1, "LedgerAPI.Process")
+	check := root.Child("LedgerVerifier.BlockCacheCheck", String("result", "Progress"))
+	check.End()
+	root.SetError(errors.New("failed"))
+	root.End()
+	root.End()
+	tracer.StartBlock(h1, "Election.haveQuorum").End()
+	tracer.StartBlock(h2, "DPoS.dispatchMsg").End()
+
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !e.closed {
+		t.Fatal("exporter should be closed")
+	}
+	if len(e.spans) != 4 {
+		t.Fatalf("exp 4 spans, got %d", len(e.spans))
+	}
+
+	spans := make(map[string]*SpanData)
+	for _, s := range e.spans {
+		spans[s.Name] = s
+	}
+	r := spans["LedgerAPI.Process"]
+	if !r.ParentID.IsZero() || r.Err != "failed" || attr(r, AttrBlockHash) != h1.String() {
+		t.Fatal("invalid root span", r)
+	}
+	c := spans["LedgerVerifier.BlockCacheCheck"]
+	if c.TraceID != r.TraceID || c.ParentID != r.SpanID || attr(c, "result") != "Progress" {
+		t.Fatal("invalid child span", c)
+	}
+	q := spans["Election.haveQuorum"]
+	if q.TraceID != r.TraceID || q.ParentID != r.SpanID || attr(q, AttrBlockHash) != h1.String() {
+		t.Fatal("span of the same block should be in the trace", q)
+	}
+	d := spans["DPoS.dispatchMsg"]
+	if d.TraceID == r.TraceID || !d.ParentID.IsZero() {
+		t.Fatal("span of another block should start a new trace", d)
+	}
+	if !r.End.After(r.Start) && !r.End.Equal(r.Start) {
+		t.Fatal("invalid time")
+	}
+}
+
+func TestSpan_Nil(t *testing.T) {
+	SetTracer(nil)
+	if Enabled() {
+		t.Fatal("tracing should be disabled")
+	}
+	span := StartBlock(types.Hash{1}, "test")
+	if span != nil {
+		t.Fatal("span should be nil")
+	}
+	span.SetAttributes(String("k", "v"))
+	span.SetError(errors.New("err"))
+	span.Child("child").End()
+	span.End()
+
+	var tracer *Tracer
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetTracer(t *testing.T) {
+	e := &memExporter{}
+	tracer := NewTracer(e, nil)
+	SetTracer(tracer)
+	defer SetTracer(nil)
+	if !Enabled() || GetTracer() != tracer {
+		t.Fatal("invalid global tracer")
+	}
+	StartBlock(types.Hash{3}, "Ledger.AddStateBlock").End()
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(e.spans) != 1 || e.spans[0].Name != "Ledger.AddStateBlock" {
+		t.Fatal(e.spans)
+	}
+}
+
+func TestFileExporter(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "tracing", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	file := filepath.Join(dir, "traces.json")
+	e, err := NewFileExporter(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracer := NewTracer(e, nil)
+	root := tracer.StartBlock(types.Hash{1}, "LedgerAPI.Process")
+	root.Child("LedgerVerifier.BlockCacheProcess").End()
+	root.End()
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	lines := 0
+	for scanner.Scan() {
+		var req otlpRequest
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			t.Fatal(err)
+		}
+		spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+		if len(spans) != 2 || spans[0].ParentSpanID != spans[1].SpanID || spans[0].TraceID != spans[1].TraceID {
+			t.Fatal(spans)
+		}
+		if spans[1].Attributes[0].Key != AttrBlockHash || spans[1].Status.Code != statusCodeOk {
+			t.Fatal(spans[1])
+		}
+		lines++
+	}
+	if lines != 1 {
+		t.Fatalf("exp 1 line, got %d", lines)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var mu sync.Mutex
+	var received []otlpSpan
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		var req otlpRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, req.ResourceSpans[0].ScopeSpans[0].Spans...)
+		mu.Unlock()
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(NewOTLPExporter(collector.URL+"/v1/traces"), func(err error) {
+		t.Error(err)
+	})
+	span := tracer.StartBlock(types.Hash{1}, "LedgerVerifier.BlockProcess")
+	span.SetError(errors.New("gap previous"))
+	span.End()
+	if err := tracer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || received[0].Status.Code != statusCodeError || received[0].Status.Message != "gap previous" {
+		t.Fatal(received)
+	}
+
+	if err := NewOTLPExporter(collector.URL+"/invalid").Export([]*SpanData{{Name: "test"}}); err == nil {
+		t.Fatal("export should fail")
+	}
+}
@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"sync"
+
 	"github.com/libp2p/go-libp2p-core/control"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
@@ -13,18 +15,52 @@ type WhiteList struct {
 }
 
 type ConnectionGater struct {
-	whiteList []WhiteList
+	whiteListEnable bool
+	whiteListLock   sync.RWMutex
+	whiteList       []WhiteList
+	scorer          *PeerScorer
 }
 
 func NewConnectionGater() *ConnectionGater {
 	return &ConnectionGater{}
 }
 
-func (cg *ConnectionGater) InterceptPeerDial(peer.ID) bool {
-	return true
+// addWhiteList adds wl to the whitelist if the peer is not in it yet
+func (cg *ConnectionGater) addWhiteList(wl WhiteList) {
+	cg.whiteListLock.Lock()
+	defer cg.whiteListLock.Unlock()
+	for _, v := range cg.whiteList {
+		if v.id == wl.id {
+			return
+		}
+	}
+	cg.whiteList = append(cg.whiteList, wl)
+}
+
+// resetWhiteList removes all peers from the whitelist
+func (cg *ConnectionGater) resetWhiteList() {
+	cg.whiteListLock.Lock()
+	defer cg.whiteListLock.Unlock()
+	cg.whiteList = nil
+}
+
+func (cg *ConnectionGater) isBanned(p peer.ID) bool {
+	return cg.scorer != nil && cg.scorer.IsBanned(p.Pretty())
+}
+
+func (cg *ConnectionGater) InterceptPeerDial(p peer.ID) bool {
+	return !cg.isBanned(p)
 }
 
 func (cg *ConnectionGater) InterceptAddrDial(p peer.ID, addr ma.Multiaddr) bool {
+	if cg.isBanned(p) {
+		return false
+	}
+	if !cg.whiteListEnable {
+		return true
+	}
+	cg.whiteListLock.RLock()
+	defer cg.whiteListLock.RUnlock()
 	var allow bool
 	for _, v := range cg.whiteList {
 		if p == v.id {
@@ -42,12 +78,12 @@ func (cg *ConnectionGater) InterceptAddrDial(p peer.ID, addr ma.Multiaddr) bool
 	return allow
 }
 
-func (cg *ConnectionGater) InterceptAccept(network.ConnMultiaddrs) bool {
-	return true
+func (cg *ConnectionGater) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	return cg.scorer == nil || !cg.scorer.IsAddrBanned(addrs.RemoteMultiaddr())
 }
 
-func (cg *ConnectionGater) InterceptSecured(network.Direction, peer.ID, network.ConnMultiaddrs) bool {
-	return true
+func (cg *ConnectionGater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return !cg.isBanned(p)
 }
 
 func (cg *ConnectionGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -42,6 +43,9 @@ const (
 	PovPublishReq
 	PovBulkPullReq
 	PovBulkPullRsp
+	PovStateProofReq
+	PovStateProofRsp
+	RepEquivocation
 )
 
 type MessageService struct {
@@ -86,6 +90,7 @@ func (ms *MessageService) Start() {
 	netService.Register(NewSubscriber(ms.publishMessageCh, PublishReq))
 	netService.Register(NewSubscriber(ms.confirmReqMessageCh, ConfirmReq))
 	netService.Register(NewSubscriber(ms.confirmAckMessageCh, ConfirmAck))
+	netService.Register(NewSubscriber(ms.confirmAckMessageCh, RepEquivocation))
 	netService.Register(NewSubscriber(ms.messageCh, FrontierRequest))
 	netService.Register(NewSubscriber(ms.messageCh, FrontierRsp))
 	netService.Register(NewSubscriber(ms.messageCh, BulkPullRequest))
@@ -97,9 +102,14 @@ func (ms *MessageService) Start() {
 	netService.Register(NewSubscriber(ms.povMessageCh, PovPublishReq))
 	netService.Register(NewSubscriber(ms.povMessageCh, PovBulkPullReq))
 	netService.Register(NewSubscriber(ms.povMessageCh, PovBulkPullRsp))
+	netService.Register(NewSubscriber(ms.povMessageCh, PovStateProofReq))
+	netService.Register(NewSubscriber(ms.povMessageCh, PovStateProofRsp))
 	// start loop().
 	go ms.startLoop()
-	go ms.syncService.Start()
+	// light client only follows PoV headers, the DAG is not synced
+	if cfg, _ := netService.cc.Config(); cfg == nil || cfg.PoV == nil || !cfg.PoV.LightMode {
+		go ms.syncService.Start()
+	}
 	go ms.publishReqLoop()
 	go ms.confirmReqLoop()
 	go ms.confirmAckLoop()
@@ -125,6 +135,10 @@ func (ms *MessageService) startLoop() {
 			case FrontierRsp:
 				go ms.syncService.checkFrontier(message)
 			case BulkPullRequest:
+				if ms.netService.node.scorer != nil && !ms.netService.node.scorer.AllowBulkPull(message.MessageFrom()) {
+					ms.netService.node.logger.Debugf("drop bulk pull request from %s", message.MessageFrom())
+					continue
+				}
 				go func() {
 					if err := ms.syncService.onBulkPullRequest(message); err != nil {
 						ms.netService.node.logger.Error(err)
@@ -200,6 +214,8 @@ func (ms *MessageService) confirmAckLoop() {
 			switch message.MessageType() {
 			case ConfirmAck:
 				ms.onConfirmAck(message)
+			case RepEquivocation:
+				ms.onRepEquivocation(message)
 			}
 		}
 	}
@@ -220,6 +236,10 @@ func (ms *MessageService) povMessageLoop() {
 				ms.onPovBulkPullReq(message)
 			case PovBulkPullRsp:
 				ms.onPovBulkPullRsp(message)
+			case PovStateProofReq:
+				ms.onPovStateProofReq(message)
+			case PovStateProofRsp:
+				ms.onPovStateProofRsp(message)
 			default:
 				ms.netService.node.logger.Warn("Received unknown pov message.")
 			}
@@ -231,6 +251,7 @@ func (ms *MessageService) onMessageResponse(message *Message) {
 	ma, err := protos.MessageAckFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
 		return
 	}
 	if v, ok := ms.pullRspMap.Load(message.from); ok {
@@ -248,6 +269,7 @@ func (ms *MessageService) onPublishReq(message *Message) {
 	p, err := protos.PublishBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventPublish, &topic.EventPublishMsg{Block: p.Blk, From: message.MessageFrom()})
@@ -257,6 +279,7 @@ func (ms *MessageService) onConfirmReq(message *Message) {
 	r, err := protos.ConfirmReqBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Error(err)
+		ms.penalize(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventConfirmReq, &topic.EventConfirmReqMsg{Blocks: r.Blk, From: message.MessageFrom()})
@@ -266,15 +289,28 @@ func (ms *MessageService) onConfirmAck(message *Message) {
 	ack, err := protos.ConfirmAckBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventConfirmAck, &EventConfirmAckMsg{ack, message.MessageFrom()})
 }
 
+func (ms *MessageService) onRepEquivocation(message *Message) {
+	e, err := protos.RepEquivocationFromProto(message.Data())
+	if err != nil {
+		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
+		return
+	}
+	ms.netService.msgEvent.Publish(topic.EventRepEquivocation,
+		&EventRepEquivocationMsg{Evidence: e, From: message.MessageFrom()})
+}
+
 func (ms *MessageService) onPovStatus(message *Message) {
 	status, err := protos.PovStatusFromProto(message.data)
 	if err != nil {
 		ms.netService.node.logger.Errorf("failed to decode PovStatus from peer %s", message.from)
+		ms.penalize(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventPovPeerStatus,
@@ -285,6 +321,7 @@ func (ms *MessageService) onPovPublishReq(message *Message) {
 	p, err := protos.PovPublishBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
 		return
 	}
 
@@ -299,6 +336,7 @@ func (ms *MessageService) onPovBulkPullReq(message *Message) {
 	req, err := protos.PovBulkPullReqFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
 		return
 	}
 
@@ -310,6 +348,7 @@ func (ms *MessageService) onPovBulkPullRsp(message *Message) {
 	rsp, err := protos.PovBulkPullRspFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
 		return
 	}
 
@@ -317,6 +356,38 @@ func (ms *MessageService) onPovBulkPullRsp(message *Message) {
 		&EventPovBulkPullRspMsg{Resp: rsp, From: message.MessageFrom()})
 }
 
+func (ms *MessageService) onPovStateProofReq(message *Message) {
+	req, err := protos.PovStateProofReqFromProto(message.Data())
+	if err != nil {
+		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
+		return
+	}
+
+	ms.netService.msgEvent.Publish(topic.EventPovStateProofReq,
+		&EventPovStateProofReqMsg{Req: req, From: message.MessageFrom()})
+}
+
+func (ms *MessageService) onPovStateProofRsp(message *Message) {
+	rsp, err := protos.PovStateProofRspFromProto(message.Data())
+	if err != nil {
+		ms.netService.node.logger.Info(err)
+		ms.penalize(message, err)
+		return
+	}
+
+	ms.netService.msgEvent.Publish(topic.EventPovStateProofRsp,
+		&EventPovStateProofRspMsg{Resp: rsp, From: message.MessageFrom()})
+}
+
+// penalize lowers the score of the peer which sent an undecodable message
+func (ms *MessageService) penalize(message *Message, err error) {
+	if ms.netService.node.scorer != nil {
+		ms.netService.node.scorer.Penalize(message.MessageFrom(), topic.MisbehaviourInvalidMessage,
+			fmt.Sprintf("message type %d: %s", message.MessageType(), err))
+	}
+}
+
 func (ms *MessageService) Stop() {
 	//ms.netService.node.logger.VInfo("stopped message monitor")
 	// quit.
@@ -325,6 +396,7 @@ func (ms *MessageService) Stop() {
 	ms.netService.Deregister(NewSubscriber(ms.publishMessageCh, PublishReq))
 	ms.netService.Deregister(NewSubscriber(ms.confirmReqMessageCh, ConfirmReq))
 	ms.netService.Deregister(NewSubscriber(ms.confirmAckMessageCh, ConfirmAck))
+	ms.netService.Deregister(NewSubscriber(ms.confirmAckMessageCh, RepEquivocation))
 	ms.netService.Deregister(NewSubscriber(ms.messageCh, FrontierRequest))
 	ms.netService.Deregister(NewSubscriber(ms.messageCh, FrontierRsp))
 	ms.netService.Deregister(NewSubscriber(ms.messageCh, BulkPullRequest))
@@ -335,6 +407,8 @@ func (ms *MessageService) Stop() {
 	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovPublishReq))
 	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovBulkPullReq))
 	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovBulkPullRsp))
+	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovStateProofReq))
+	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovStateProofRsp))
 }
 
 func marshalMessage(messageName MessageType, value interface{}) ([]byte, error) {
@@ -363,6 +437,12 @@ func marshalMessage(messageName MessageType, value interface{}) ([]byte, error)
 			return nil, err
 		}
 		return data, nil
+	case RepEquivocation:
+		data, err := protos.RepEquivocationToProto(value.(*types.Equivocation))
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
 	case FrontierRequest:
 		data, err := protos.FrontierReqToProto(value.(*protos.FrontierReq))
 		if err != nil {
@@ -427,6 +507,20 @@ func marshalMessage(messageName MessageType, value interface{}) ([]byte, error)
 			return nil, err
 		}
 		return data, nil
+	case PovStateProofReq:
+		req := value.(*protos.PovStateProofReq)
+		data, err := protos.PovStateProofReqToProto(req)
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
+	case PovStateProofRsp:
+		rsp := value.(*protos.PovStateProofRsp)
+		data, err := protos.PovStateProofRspToProto(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
 	case MessageResponse:
 		rsp := &protos.MessageAckPacket{
 			MessageHash: value.(types.Hash),
@@ -29,6 +29,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
 	ping "github.com/qlcchain/go-qlc/p2p/pinger"
 	"github.com/qlcchain/go-qlc/p2p/pubsub"
 )
@@ -89,6 +90,7 @@ type QlcNode struct {
 	reporter         p2pmetrics.Reporter
 	ping             *ping.Pinger
 	connectionGater  *ConnectionGater
+	scorer           *PeerScorer
 }
 
 // NewNode return new QlcNode according to the config.
@@ -113,6 +115,7 @@ func NewNode(config *config.Config) (*QlcNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	node.connectionGater.whiteListEnable = config.WhiteList.Enable
 	node.reporter = p2pmetrics.NewBandwidthCounter()
 	return node, nil
 }
@@ -124,69 +127,66 @@ func (node *QlcNode) setRepresentativeNode(isRepresentative bool) {
 func (node *QlcNode) updateWhiteList(id string, url string) {
 	if node.cfg.WhiteList.Enable {
 		if node.connectionGater != nil {
-			var b bool
 			peerId, err := peer.Decode(id)
 			if err != nil {
 				return
 			}
-			for _, v := range node.connectionGater.whiteList {
-				if v.id == peerId {
-					b = true
-					break
-				}
-			}
-			if !b {
-				wl := WhiteList{}
-				wl.id = peerId
-				ss := strings.Split(url, ":")
-				if len(ss) >= 2 {
-					multiAddrString := "/ip4/" + ss[0] + "/tcp/" + ss[1]
-					multiAddr, err := ma.NewMultiaddr(multiAddrString)
-					if err != nil {
-						return
-					}
-					wl.addr = multiAddr
+			wl := WhiteList{}
+			wl.id = peerId
+			ss := strings.Split(url, ":")
+			if len(ss) >= 2 {
+				multiAddrString := "/ip4/" + ss[0] + "/tcp/" + ss[1]
+				multiAddr, err := ma.NewMultiaddr(multiAddrString)
+				if err != nil {
+					return
 				}
-				node.connectionGater.whiteList = append(node.connectionGater.whiteList, wl)
+				wl.addr = multiAddr
 			}
+			node.connectionGater.addWhiteList(wl)
 		}
 	}
 }
 
+// peerAddr returns the remote address of the connected peer
+func (node *QlcNode) peerAddr(id string) string {
+	if s := node.streamManager.FindByPeerID(id); s != nil && s.addr != nil {
+		return s.addr.String()
+	}
+	return ""
+}
+
+// disconnectPeer closes all connections to the peer
+func (node *QlcNode) disconnectPeer(id string) {
+	if node.host == nil {
+		return
+	}
+	pid, err := peer.Decode(id)
+	if err != nil {
+		return
+	}
+	if err := node.host.Network().ClosePeer(pid); err != nil {
+		node.logger.Errorf("disconnect peer %s: %s", id, err)
+	}
+}
+
 func (node *QlcNode) buildHost() error {
 	var err error
 	go node.getBootNode(node.cfg.P2P.BootNodes)
 	node.logger.Info("Start Qlc Host...")
 	sourceMultiAddr, _ := ma.NewMultiaddr(node.cfg.P2P.Listen)
-	if node.cfg.WhiteList.Enable {
-		node.host, err = libp2p.New(
-			node.ctx,
-			libp2p.ListenAddrs(sourceMultiAddr),
-			libp2p.Identity(node.privateKey),
-			//libp2p.NATPortMap(),
-			libp2p.BandwidthReporter(node.reporter),
-			libp2p.Ping(false),
-			libp2p.ConnectionGater(node.connectionGater),
-			// libp2p.NoSecurity,
-			// libp2p.DefaultMuxers,
-		)
-		if err != nil {
-			return err
-		}
-	} else {
-		node.host, err = libp2p.New(
-			node.ctx,
-			libp2p.ListenAddrs(sourceMultiAddr),
-			libp2p.Identity(node.privateKey),
-			//libp2p.NATPortMap(),
-			libp2p.BandwidthReporter(node.reporter),
-			libp2p.Ping(false),
-			// libp2p.NoSecurity,
-			// libp2p.DefaultMuxers,
-		)
-		if err != nil {
-			return err
-		}
+	node.host, err = libp2p.New(
+		node.ctx,
+		libp2p.ListenAddrs(sourceMultiAddr),
+		libp2p.Identity(node.privateKey),
+		//libp2p.NATPortMap(),
+		libp2p.BandwidthReporter(node.reporter),
+		libp2p.Ping(false),
+		libp2p.ConnectionGater(node.connectionGater),
+		// libp2p.NoSecurity,
+		// libp2p.DefaultMuxers,
+	)
+	if err != nil {
+		return err
 	}
 	node.host.SetStreamHandler(QlcProtocolID, node.handleStream)
 	node.kadDht, err = dht.New(node.ctx, node.host, dht.Mode(dht.ModeServer))
@@ -566,6 +566,10 @@ func (node *QlcNode) Stop() error {
 
 // BroadcastMessage broadcast message.
 func (node *QlcNode) BroadcastMessage(messageName MessageType, value interface{}) {
+	if blk, ok := value.(*types.StateBlock); ok && tracing.Enabled() {
+		span := tracing.StartBlock(blk.GetHash(), "p2p.BroadcastMessage")
+		defer span.End()
+	}
 	node.streamManager.BroadcastMessage(messageName, value)
 }
 
@@ -8,6 +8,7 @@
 package p2p
 
 import (
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/p2p/protos"
 )
 
@@ -32,11 +33,26 @@ type EventPovBulkPullRspMsg struct {
 	From string
 }
 
+type EventPovStateProofReqMsg struct {
+	Req  *protos.PovStateProofReq
+	From string
+}
+
+type EventPovStateProofRspMsg struct {
+	Resp *protos.PovStateProofRsp
+	From string
+}
+
 type EventConfirmAckMsg struct {
 	Block *protos.ConfirmAckBlock
 	From  string
 }
 
+type EventRepEquivocationMsg struct {
+	Evidence *types.Equivocation
+	From     string
+}
+
 type EventBroadcastMsg struct {
 	Type    MessageType
 	Message interface{}
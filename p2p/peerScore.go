@@ -0,0 +1,265 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+// score recovered per minute
+const scoreRecoveryPerMinute = 1
+
+var penalties = map[topic.Misbehaviour]int64{
+	topic.MisbehaviourInvalidMessage:  10,
+	topic.MisbehaviourInvalidBlock:    20,
+	topic.MisbehaviourBadVote:         20,
+	topic.MisbehaviourInvalidPovBlock: 25,
+	topic.MisbehaviourBulkPullFlood:   5,
+}
+
+var ErrInvalidBanDuration = errors.New("ban duration should be greater than 0")
+
+type peerScore struct {
+	score      int64
+	lastUpdate time.Time
+}
+
+// PeerScorer tracks scores of peers and bans peers whose score drops to the threshold,
+// bans are persisted in the ledger and enforced by ConnectionGater
+type PeerScorer struct {
+	cfg       *config.PeerScoreConfig
+	ledger    ledger.Store
+	logger    *zap.SugaredLogger
+	mu        sync.Mutex
+	scores    map[string]*peerScore
+	bans      map[string]*types.PeerBan
+	bulkPulls map[string]*rate.Limiter
+	// returns the remote address of a connected peer
+	addrOf func(peerID string) string
+	// disconnects a banned peer
+	onBan func(peerID string)
+}
+
+func NewPeerScorer(cfg *config.PeerScoreConfig, l ledger.Store) *PeerScorer {
+	if cfg == nil {
+		cfg = &config.PeerScoreConfig{}
+	}
+	s := &PeerScorer{
+		cfg:       cfg,
+		ledger:    l,
+		logger:    log.NewLogger("p2p_score"),
+		scores:    make(map[string]*peerScore),
+		bans:      make(map[string]*types.PeerBan),
+		bulkPulls: make(map[string]*rate.Limiter),
+	}
+	if l != nil {
+		now := time.Now().Unix()
+		err := l.GetPeerBans(func(ban *types.PeerBan) error {
+			if ban.Until > now {
+				s.bans[ban.PeerID] = ban
+			}
+			return nil
+		})
+		if err != nil {
+			s.logger.Errorf("load peer bans: %s", err)
+		}
+	}
+	return s
+}
+
+// currentScore returns the score of the peer after recovery, the caller must hold the lock
+func (s *PeerScorer) currentScore(peerID string, now time.Time) *peerScore {
+	ps, ok := s.scores[peerID]
+	if !ok {
+		ps = &peerScore{lastUpdate: now}
+		s.scores[peerID] = ps
+		return ps
+	}
+	if recovered := int64(now.Sub(ps.lastUpdate)/time.Minute) * scoreRecoveryPerMinute; recovered > 0 {
+		ps.score += recovered
+		if ps.score > 0 {
+			ps.score = 0
+		}
+		ps.lastUpdate = ps.lastUpdate.Add(time.Duration(recovered/scoreRecoveryPerMinute) * time.Minute)
+	}
+	return ps
+}
+
+// Score returns the current score of the peer, 0 is the best
+func (s *PeerScorer) Score(peerID string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.currentScore(peerID, time.Now()).score
+}
+
+// Penalize decreases the score of the peer and bans it if the score drops to the threshold,
+// it does nothing if the peer score is disabled
+func (s *PeerScorer) Penalize(peerID string, kind topic.Misbehaviour, reason string) {
+	if !s.cfg.Enable || peerID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	ps := s.currentScore(peerID, time.Now())
+	ps.score -= penalties[kind]
+	score := ps.score
+	s.mu.Unlock()
+
+	s.logger.Warnf("peer %s misbehaved (%s: %s), score %d", peerID, kind, reason, score)
+	if score <= s.cfg.BanThreshold && !s.IsBanned(peerID) {
+		d := time.Duration(s.cfg.BanDuration) * time.Second
+		if err := s.Ban(peerID, d, fmt.Sprintf("score %d, last misbehaviour: %s", score, kind)); err != nil {
+			s.logger.Error(err)
+		}
+	}
+}
+
+// Ban bans the peer for d and disconnects it
+func (s *PeerScorer) Ban(peerID string, d time.Duration, reason string) error {
+	if d <= 0 {
+		return ErrInvalidBanDuration
+	}
+	address := ""
+	if s.addrOf != nil {
+		address = s.addrOf(peerID)
+	}
+
+	s.mu.Lock()
+	ban := &types.PeerBan{
+		PeerID:  peerID,
+		Address: address,
+		Score:   s.currentScore(peerID, time.Now()).score,
+		Reason:  reason,
+		Until:   time.Now().Add(d).Unix(),
+	}
+	s.bans[peerID] = ban
+	s.mu.Unlock()
+
+	s.logger.Warnf("ban peer %s until %s: %s", peerID, time.Unix(ban.Until, 0).Format(time.RFC3339), reason)
+	if s.onBan != nil {
+		s.onBan(peerID)
+	}
+	if s.ledger != nil {
+		return s.ledger.AddOrUpdatePeerBan(ban)
+	}
+	return nil
+}
+
+// Unban lifts the ban of the peer and resets its score
+func (s *PeerScorer) Unban(peerID string) error {
+	s.mu.Lock()
+	delete(s.bans, peerID)
+	delete(s.scores, peerID)
+	s.mu.Unlock()
+
+	if s.ledger != nil {
+		return s.ledger.DeletePeerBan(peerID)
+	}
+	return nil
+}
+
+// ban returns the ban of the peer if it has not expired, the caller must hold the lock
+func (s *PeerScorer) ban(peerID string, now int64) *types.PeerBan {
+	ban, ok := s.bans[peerID]
+	if !ok {
+		return nil
+	}
+	if ban.Until <= now {
+		delete(s.bans, peerID)
+		if s.ledger != nil {
+			_ = s.ledger.DeletePeerBan(peerID)
+		}
+		return nil
+	}
+	return ban
+}
+
+func (s *PeerScorer) IsBanned(peerID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ban(peerID, time.Now().Unix()) != nil
+}
+
+// IsAddrBanned returns true if the IP of addr is the address of a banned peer
+func (s *PeerScorer) IsAddrBanned(addr ma.Multiaddr) bool {
+	ip := ipOf(addr)
+	if ip == "" {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now().Unix()
+	for id, ban := range s.bans {
+		if ban.Address == "" {
+			continue
+		}
+		if banned, err := ma.NewMultiaddr(ban.Address); err == nil && ipOf(banned) == ip && s.ban(id, now) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Bans returns the active bans sorted by peer ID
+func (s *PeerScorer) Bans() []*types.PeerBan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now().Unix()
+	bans := make([]*types.PeerBan, 0, len(s.bans))
+	for id := range s.bans {
+		if ban := s.ban(id, now); ban != nil {
+			b := *ban
+			b.Score = s.currentScore(id, time.Now()).score
+			bans = append(bans, &b)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].PeerID < bans[j].PeerID
+	})
+	return bans
+}
+
+// AllowBulkPull returns false and penalizes the peer if it sends BulkPullRequest faster than the limit
+func (s *PeerScorer) AllowBulkPull(peerID string) bool {
+	if !s.cfg.Enable || s.cfg.BulkPullLimit <= 0 {
+		return true
+	}
+	s.mu.Lock()
+	l, ok := s.bulkPulls[peerID]
+	if !ok {
+		l = rate.NewLimiter(rate.Limit(float64(s.cfg.BulkPullLimit)/60), s.cfg.BulkPullLimit)
+		s.bulkPulls[peerID] = l
+	}
+	s.mu.Unlock()
+
+	if l.Allow() {
+		return true
+	}
+	s.Penalize(peerID, topic.MisbehaviourBulkPullFlood, "too many bulk pull requests")
+	return false
+}
+
+func ipOf(addr ma.Multiaddr) string {
+	if addr == nil {
+		return ""
+	}
+	if ip, err := addr.ValueForProtocol(ma.P_IP4); err == nil {
+		return ip
+	}
+	if ip, err := addr.ValueForProtocol(ma.P_IP6); err == nil {
+		return ip
+	}
+	return ""
+}
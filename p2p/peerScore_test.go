@@ -0,0 +1,208 @@
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+const testPeerID = "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"
+
+func setupPeerScoreTestCase(t *testing.T) (func(t *testing.T), *ledger.Ledger) {
+	dir := filepath.Join(config.QlcTestDataDir(), "peerScore", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	l := ledger.NewLedger(cm.ConfigFile)
+	return func(t *testing.T) {
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}, l
+}
+
+func testPeerScoreConfig() *config.PeerScoreConfig {
+	return &config.PeerScoreConfig{
+		Enable:        true,
+		BanThreshold:  -40,
+		BanDuration:   3600,
+		BulkPullLimit: 2,
+	}
+}
+
+func TestPeerScorer_Penalize(t *testing.T) {
+	teardownTestCase, l := setupPeerScoreTestCase(t)
+	defer teardownTestCase(t)
+
+	s := NewPeerScorer(testPeerScoreConfig(), l)
+	var banned string
+	s.addrOf = func(string) string {
+		return "/ip4/10.0.0.1/tcp/9734"
+	}
+	s.onBan = func(id string) {
+		banned = id
+	}
+
+	s.Penalize(testPeerID, topic.MisbehaviourInvalidBlock, "bad signature")
+	if s.Score(testPeerID) != -20 || s.IsBanned(testPeerID) {
+		t.Fatal("invalid score", s.Score(testPeerID))
+	}
+	s.Penalize(testPeerID, topic.MisbehaviourBadVote, "invalid ack")
+	if !s.IsBanned(testPeerID) || banned != testPeerID {
+		t.Fatal("peer should be banned")
+	}
+
+	addr, _ := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/30000")
+	if !s.IsAddrBanned(addr) {
+		t.Fatal("address should be banned")
+	}
+	addr, _ = ma.NewMultiaddr("/ip4/10.0.0.2/tcp/9734")
+	if s.IsAddrBanned(addr) {
+		t.Fatal("address should not be banned")
+	}
+
+	bans := s.Bans()
+	if len(bans) != 1 || bans[0].PeerID != testPeerID || bans[0].Score != -40 {
+		t.Fatal("invalid bans", bans)
+	}
+
+	// bans are reloaded from the ledger
+	s2 := NewPeerScorer(testPeerScoreConfig(), l)
+	if !s2.IsBanned(testPeerID) {
+		t.Fatal("ban should be persisted")
+	}
+
+	if err := s.Unban(testPeerID); err != nil {
+		t.Fatal(err)
+	}
+	if s.IsBanned(testPeerID) || s.Score(testPeerID) != 0 {
+		t.Fatal("peer should be unbanned")
+	}
+	if _, err := l.GetPeerBan(testPeerID); err != ledger.ErrPeerNotFound {
+		t.Fatal(err)
+	}
+}
+
+func TestPeerScorer_Ban(t *testing.T) {
+	teardownTestCase, l := setupPeerScoreTestCase(t)
+	defer teardownTestCase(t)
+
+	s := NewPeerScorer(testPeerScoreConfig(), l)
+	if err := s.Ban(testPeerID, 0, "manual"); err != ErrInvalidBanDuration {
+		t.Fatal(err)
+	}
+	if err := s.Ban(testPeerID, time.Second, "manual"); err != nil {
+		t.Fatal(err)
+	}
+	if !s.IsBanned(testPeerID) {
+		t.Fatal("peer should be banned")
+	}
+
+	// expired ban is removed
+	s.bans[testPeerID].Until = time.Now().Unix()
+	if s.IsBanned(testPeerID) || len(s.Bans()) != 0 {
+		t.Fatal("ban should be expired")
+	}
+	if _, err := l.GetPeerBan(testPeerID); err != ledger.ErrPeerNotFound {
+		t.Fatal(err)
+	}
+}
+
+func TestPeerScorer_Recovery(t *testing.T) {
+	s := NewPeerScorer(testPeerScoreConfig(), nil)
+	s.Penalize(testPeerID, topic.MisbehaviourInvalidMessage, "decode error")
+	s.scores[testPeerID].lastUpdate = time.Now().Add(-3 * time.Minute)
+	if score := s.Score(testPeerID); score != -7 {
+		t.Fatal("invalid score", score)
+	}
+	s.scores[testPeerID].lastUpdate = time.Now().Add(-time.Hour)
+	if score := s.Score(testPeerID); score != 0 {
+		t.Fatal("score should not be greater than 0", score)
+	}
+}
+
+func TestPeerScorer_Disabled(t *testing.T) {
+	s := NewPeerScorer(nil, nil)
+	for i := 0; i < 10; i++ {
+		s.Penalize(testPeerID, topic.MisbehaviourInvalidPovBlock, "invalid pov block")
+		if !s.AllowBulkPull(testPeerID) {
+			t.Fatal("bulk pull should be allowed")
+		}
+	}
+	if s.Score(testPeerID) != 0 || s.IsBanned(testPeerID) {
+		t.Fatal("peer score is disabled")
+	}
+}
+
+func TestPeerScorer_AllowBulkPull(t *testing.T) {
+	s := NewPeerScorer(testPeerScoreConfig(), nil)
+	if !s.AllowBulkPull(testPeerID) || !s.AllowBulkPull(testPeerID) {
+		t.Fatal("bulk pull should be allowed")
+	}
+	if s.AllowBulkPull(testPeerID) {
+		t.Fatal("bulk pull should be limited")
+	}
+	if s.Score(testPeerID) != -5 {
+		t.Fatal("invalid score", s.Score(testPeerID))
+	}
+}
+
+type connAddrs struct {
+	remote ma.Multiaddr
+}
+
+func (c *connAddrs) LocalMultiaddr() ma.Multiaddr {
+	return nil
+}
+
+func (c *connAddrs) RemoteMultiaddr() ma.Multiaddr {
+	return c.remote
+}
+
+func TestConnectionGater_Ban(t *testing.T) {
+	s := NewPeerScorer(testPeerScoreConfig(), nil)
+	s.addrOf = func(string) string {
+		return "/ip4/10.0.0.1/tcp/9734"
+	}
+	cg := NewConnectionGater()
+	cg.scorer = s
+
+	pid, _ := peer.Decode(testPeerID)
+	addr, _ := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/9734")
+	if !cg.InterceptPeerDial(pid) || !cg.InterceptAddrDial(pid, addr) || !cg.InterceptAccept(&connAddrs{remote: addr}) ||
+		!cg.InterceptSecured(0, pid, nil) {
+		t.Fatal("peer should be allowed")
+	}
+
+	if err := s.Ban(testPeerID, time.Hour, "manual"); err != nil {
+		t.Fatal(err)
+	}
+	if cg.InterceptPeerDial(pid) || cg.InterceptAddrDial(pid, addr) || cg.InterceptAccept(&connAddrs{remote: addr}) ||
+		cg.InterceptSecured(0, pid, nil) {
+		t.Fatal("peer should be rejected")
+	}
+
+	// whitelist is checked if it's enabled
+	cg.whiteListEnable = true
+	if err := s.Unban(testPeerID); err != nil {
+		t.Fatal(err)
+	}
+	if cg.InterceptAddrDial(pid, addr) {
+		t.Fatal("peer is not in whitelist")
+	}
+	cg.whiteList = append(cg.whiteList, WhiteList{id: pid})
+	if !cg.InterceptAddrDial(pid, addr) {
+		t.Fatal("peer is in whitelist")
+	}
+}
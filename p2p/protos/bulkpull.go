@@ -14,6 +14,8 @@ const (
 	PullTypeBackward
 	PullTypeForward
 	PullTypeBatch
+	// the responder has pruned the requested history and can not serve it
+	PullTypePruned
 )
 
 type BulkPullReqPacket struct {
@@ -0,0 +1,33 @@
+package protos
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/p2p/protos/pb"
+)
+
+// RepEquivocationToProto converts the evidence of a representative into proto RepEquivocation
+func RepEquivocationToProto(e *types.Equivocation) ([]byte, error) {
+	data, err := e.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	ePb := &pb.RepEquivocation{
+		Equivocation: data,
+	}
+	return proto.Marshal(ePb)
+}
+
+// RepEquivocationFromProto parse the data into the evidence of a representative
+func RepEquivocationFromProto(data []byte) (*types.Equivocation, error) {
+	ePb := new(pb.RepEquivocation)
+	if err := proto.Unmarshal(data, ePb); err != nil {
+		return nil, err
+	}
+	e := new(types.Equivocation)
+	if err := e.Deserialize(ePb.Equivocation); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
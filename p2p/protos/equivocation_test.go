@@ -0,0 +1,49 @@
+package protos
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestRepEquivocation(t *testing.T) {
+	blk1 := mock.StateBlockWithoutWork()
+	blk2 := blk1.Clone()
+	blk2.Balance = blk2.Balance.Add(types.NewBalance(1))
+	e := &types.Equivocation{
+		Account: mock.Address(),
+		Root:    types.VoteRoot(blk1),
+		First: &types.SignedVote{
+			Sequence: 1,
+			Hashes:   []types.Hash{blk1.GetHash()},
+			Block:    blk1,
+		},
+		Second: &types.SignedVote{
+			Sequence: 2,
+			Hashes:   []types.Hash{mock.Hash(), blk2.GetHash()},
+			Block:    blk2,
+		},
+		Timestamp: 1,
+	}
+
+	data, err := RepEquivocationToProto(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e2, err := RepEquivocationFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e2.Account != e.Account || e2.Root != e.Root || e2.Timestamp != e.Timestamp {
+		t.Fatal("invalid equivocation", e2)
+	}
+	if e2.First.Block.GetHash() != blk1.GetHash() || e2.Second.Block.GetHash() != blk2.GetHash() ||
+		len(e2.Second.Hashes) != 2 || e2.Second.Sequence != 2 {
+		t.Fatal("invalid votes", e2)
+	}
+
+	if _, err := RepEquivocationFromProto([]byte{1, 2, 3}); err == nil {
+		t.Fatal("invalid data should fail")
+	}
+}
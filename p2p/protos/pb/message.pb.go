@@ -606,6 +606,7 @@ type PovPullBlockReq struct {
 	PullType             uint32   `protobuf:"varint,4,opt,name=PullType,proto3" json:"PullType,omitempty"`
 	Reason               uint32   `protobuf:"varint,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
 	Locators             []byte   `protobuf:"bytes,6,opt,name=Locators,proto3" json:"Locators,omitempty"`
+	HeaderOnly           bool     `protobuf:"varint,7,opt,name=HeaderOnly,proto3" json:"HeaderOnly,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -685,10 +686,18 @@ func (m *PovPullBlockReq) GetLocators() []byte {
 	return nil
 }
 
+func (m *PovPullBlockReq) GetHeaderOnly() bool {
+	if m != nil {
+		return m.HeaderOnly
+	}
+	return false
+}
+
 type PovPullBlockRsp struct {
 	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	Block                []byte   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 	Reason               uint32   `protobuf:"varint,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Headers              [][]byte `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -747,6 +756,13 @@ func (m *PovPullBlockRsp) GetReason() uint32 {
 	return 0
 }
 
+func (m *PovPullBlockRsp) GetHeaders() [][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 type MessageAck struct {
 	MessageHash          []byte   `protobuf:"bytes,1,opt,name=messageHash,proto3" json:"messageHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -793,6 +809,200 @@ func (m *MessageAck) GetMessageHash() []byte {
 	return nil
 }
 
+type PovStateProofReq struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKey               []byte   `protobuf:"bytes,4,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PovStateProofReq) Reset()      { *m = PovStateProofReq{} }
+func (*PovStateProofReq) ProtoMessage() {}
+func (*PovStateProofReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{13}
+}
+func (m *PovStateProofReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PovStateProofReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PovStateProofReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PovStateProofReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PovStateProofReq.Merge(m, src)
+}
+func (m *PovStateProofReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PovStateProofReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PovStateProofReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PovStateProofReq proto.InternalMessageInfo
+
+func (m *PovStateProofReq) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *PovStateProofReq) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *PovStateProofReq) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *PovStateProofReq) GetSubKey() []byte {
+	if m != nil {
+		return m.SubKey
+	}
+	return nil
+}
+
+type PovStateProofRsp struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Proof                [][]byte `protobuf:"bytes,3,rep,name=Proof,proto3" json:"Proof,omitempty"`
+	SubProof             [][]byte `protobuf:"bytes,4,rep,name=SubProof,proto3" json:"SubProof,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PovStateProofRsp) Reset()      { *m = PovStateProofRsp{} }
+func (*PovStateProofRsp) ProtoMessage() {}
+func (*PovStateProofRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{14}
+}
+func (m *PovStateProofRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PovStateProofRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PovStateProofRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PovStateProofRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PovStateProofRsp.Merge(m, src)
+}
+func (m *PovStateProofRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PovStateProofRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PovStateProofRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PovStateProofRsp proto.InternalMessageInfo
+
+func (m *PovStateProofRsp) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *PovStateProofRsp) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *PovStateProofRsp) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *PovStateProofRsp) GetSubProof() [][]byte {
+	if m != nil {
+		return m.SubProof
+	}
+	return nil
+}
+
+func (m *PovStateProofRsp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type RepEquivocation struct {
+	Equivocation         []byte   `protobuf:"bytes,1,opt,name=equivocation,proto3" json:"equivocation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepEquivocation) Reset()      { *m = RepEquivocation{} }
+func (*RepEquivocation) ProtoMessage() {}
+func (*RepEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{15}
+}
+func (m *RepEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepEquivocation.Merge(m, src)
+}
+func (m *RepEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepEquivocation proto.InternalMessageInfo
+
+func (m *RepEquivocation) GetEquivocation() []byte {
+	if m != nil {
+		return m.Equivocation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FrontierReq)(nil), "pb.FrontierReq")
 	proto.RegisterType((*FrontierRsp)(nil), "pb.FrontierRsp")
@@ -807,49 +1017,60 @@ func init() {
 	proto.RegisterType((*PovPullBlockReq)(nil), "pb.PovPullBlockReq")
 	proto.RegisterType((*PovPullBlockRsp)(nil), "pb.PovPullBlockRsp")
 	proto.RegisterType((*MessageAck)(nil), "pb.MessageAck")
+	proto.RegisterType((*PovStateProofReq)(nil), "pb.PovStateProofReq")
+	proto.RegisterType((*PovStateProofRsp)(nil), "pb.PovStateProofRsp")
+	proto.RegisterType((*RepEquivocation)(nil), "pb.RepEquivocation")
 }
 
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbd, 0x8e, 0xd3, 0x4c,
-	0x14, 0xfd, 0x26, 0x4e, 0xf2, 0x91, 0x9b, 0x58, 0x20, 0x6b, 0xb5, 0xb2, 0x56, 0x2b, 0x2b, 0x1a,
-	0xad, 0xb4, 0x91, 0x80, 0x6c, 0xc1, 0x13, 0x24, 0xe1, 0x67, 0x0b, 0x10, 0x91, 0x13, 0x1e, 0xc0,
-	0x76, 0x66, 0x1d, 0x2b, 0x8e, 0xc7, 0xeb, 0x19, 0xaf, 0x44, 0xc7, 0x1b, 0xf0, 0x1a, 0xb4, 0x54,
-	0x50, 0x52, 0x52, 0x52, 0x52, 0x6e, 0xfc, 0x04, 0x94, 0x94, 0x68, 0x7e, 0x1c, 0x4f, 0x16, 0x24,
-	0xe8, 0x7c, 0xce, 0x9c, 0xb9, 0xf7, 0xdc, 0x1f, 0x0f, 0xd8, 0x5b, 0xc2, 0x58, 0x10, 0x93, 0x71,
-	0x5e, 0x50, 0x4e, 0x9d, 0x56, 0x1e, 0x9e, 0x3c, 0x8e, 0x13, 0xbe, 0x2e, 0xc3, 0x71, 0x44, 0xb7,
-	0x17, 0x31, 0x8d, 0xe9, 0x85, 0x3c, 0x0a, 0xcb, 0x2b, 0x89, 0x24, 0x90, 0x5f, 0xea, 0x0a, 0x7e,
-	0x0d, 0xfd, 0xe7, 0x05, 0xcd, 0x78, 0x42, 0x0a, 0x9f, 0x5c, 0x3b, 0x2e, 0xfc, 0x3f, 0x59, 0xad,
-	0x0a, 0xc2, 0x98, 0x8b, 0x86, 0x68, 0x34, 0xf0, 0x6b, 0xe8, 0x3c, 0x00, 0x6b, 0x12, 0x13, 0xb7,
-	0x35, 0x44, 0x23, 0xdb, 0x17, 0x9f, 0xce, 0x11, 0x74, 0x66, 0xb4, 0xcc, 0xb8, 0x6b, 0x49, 0x4e,
-	0x01, 0xfc, 0xd0, 0x08, 0xc8, 0x72, 0xe7, 0x14, 0x7a, 0x35, 0x14, 0x21, 0xad, 0xd1, 0xc0, 0x6f,
-	0x08, 0xfc, 0x1e, 0x41, 0x7f, 0x5a, 0xa6, 0x9b, 0x79, 0x99, 0xa6, 0x22, 0xfd, 0x29, 0xf4, 0x16,
-	0x3c, 0x28, 0xf8, 0x65, 0xc0, 0xd6, 0xda, 0x40, 0x43, 0x08, 0x73, 0xcf, 0xb2, 0x95, 0x3c, 0x6b,
-	0x29, 0x73, 0x1a, 0x3a, 0x27, 0x70, 0x4f, 0x84, 0x58, 0xbe, 0xcd, 0x89, 0x76, 0xb3, 0xc7, 0x8d,
-	0xcd, 0xb6, 0x61, 0xd3, 0x39, 0x86, 0xae, 0xb8, 0x49, 0x98, 0xdb, 0x91, 0xa1, 0x34, 0xc2, 0x13,
-	0xc3, 0x10, 0xcb, 0x0f, 0x02, 0xa3, 0x3b, 0x81, 0x8f, 0xa1, 0x1b, 0xa6, 0x34, 0xda, 0x30, 0xed,
-	0x46, 0x23, 0x7c, 0x0e, 0xb6, 0x0a, 0xc1, 0xd6, 0x53, 0xc1, 0x18, 0x42, 0x74, 0x20, 0x3c, 0x83,
-	0xc1, 0xbc, 0x0c, 0xd3, 0xa4, 0xd6, 0x1d, 0x41, 0x47, 0x9e, 0x68, 0x99, 0x02, 0x18, 0x03, 0xcc,
-	0x68, 0x76, 0x95, 0x14, 0x5b, 0xd1, 0x21, 0x43, 0x63, 0x35, 0x1a, 0xbe, 0xd7, 0x4c, 0xa2, 0x8d,
-	0x1c, 0x62, 0x14, 0xc9, 0x9a, 0xeb, 0x21, 0x2a, 0x28, 0xfb, 0x9b, 0xc4, 0x59, 0xc0, 0xcb, 0x82,
-	0x68, 0xd7, 0x0d, 0x21, 0x8a, 0x5d, 0x90, 0xeb, 0x92, 0x64, 0xd1, 0xbe, 0x8b, 0x35, 0x76, 0x1c,
-	0x68, 0xcb, 0xc6, 0xb7, 0x65, 0x5a, 0xf9, 0x8d, 0x3f, 0x22, 0xe8, 0xcd, 0xe9, 0xcd, 0x82, 0x07,
-	0xbc, 0x64, 0xce, 0x19, 0xd8, 0xb3, 0xb2, 0x28, 0x48, 0xc6, 0x2f, 0x49, 0x12, 0xaf, 0x55, 0xee,
-	0xb6, 0x7f, 0x48, 0x3a, 0x43, 0xe8, 0xd7, 0x44, 0x33, 0x47, 0x93, 0x12, 0x8a, 0x17, 0x24, 0x23,
-	0x2c, 0x61, 0x52, 0x61, 0x29, 0x85, 0x41, 0x89, 0x2a, 0xf4, 0x85, 0xe5, 0x53, 0x39, 0xd5, 0x81,
-	0xdf, 0x10, 0xe2, 0x74, 0x99, 0x6c, 0x09, 0xe3, 0xc1, 0x36, 0x97, 0xc3, 0xb5, 0xfc, 0x86, 0xc0,
-	0xe7, 0x70, 0x7f, 0x4e, 0x6f, 0xfe, 0xa1, 0xed, 0x9f, 0x90, 0x56, 0xa6, 0xa9, 0x94, 0xfd, 0x7d,
-	0x3d, 0x87, 0xd0, 0x57, 0x40, 0x95, 0xdf, 0x92, 0xe5, 0x9b, 0xd4, 0x9f, 0xff, 0x98, 0x83, 0x1d,
-	0x6b, 0xff, 0xbe, 0x63, 0x3e, 0x09, 0x18, 0xcd, 0x64, 0x25, 0xb6, 0xaf, 0x91, 0xb8, 0xf3, 0x92,
-	0x46, 0x01, 0xa7, 0x05, 0x73, 0xbb, 0xd2, 0xc8, 0x1e, 0xe3, 0x37, 0x77, 0x8c, 0xb3, 0x5c, 0x24,
-	0x6e, 0xf6, 0xc1, 0xf6, 0x15, 0x68, 0x0a, 0x6f, 0x19, 0x85, 0x1b, 0x29, 0x2d, 0x33, 0x25, 0x1e,
-	0x03, 0xbc, 0x52, 0xaf, 0x8d, 0xd8, 0xb1, 0x21, 0xf4, 0xf5, 0xdb, 0x63, 0x34, 0xc3, 0xa4, 0xa6,
-	0x8f, 0xbe, 0xef, 0xbc, 0xff, 0x6e, 0x77, 0x1e, 0xfa, 0xb1, 0xf3, 0xd0, 0xcf, 0x9d, 0x87, 0xde,
-	0x55, 0x1e, 0xfa, 0x50, 0x79, 0xe8, 0x73, 0xe5, 0xa1, 0x2f, 0x95, 0x87, 0xbe, 0x56, 0x1e, 0xfa,
-	0x56, 0x79, 0xe8, 0xb6, 0xf2, 0x50, 0xd8, 0x95, 0xcf, 0xd1, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x61, 0xae, 0x70, 0xf8, 0xd2, 0x04, 0x00, 0x00,
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xbe, 0xce, 0x24, 0x69, 0x73, 0x92, 0xdc, 0x56, 0xa3, 0xaa, 0x1a, 0x55, 0xd5, 0x28, 0xb2,
+	0x2a, 0x35, 0xd2, 0xbd, 0xb4, 0x0b, 0xc4, 0x03, 0xa4, 0x3f, 0xd0, 0x0a, 0x50, 0xa3, 0x49, 0x5f,
+	0x60, 0x26, 0x71, 0x93, 0xa1, 0x93, 0xf1, 0xd4, 0x9e, 0xa9, 0xd4, 0x1d, 0x4b, 0x76, 0xbc, 0x06,
+	0x5b, 0x76, 0x2c, 0x59, 0xb2, 0x64, 0x07, 0xcb, 0x26, 0x4f, 0xc0, 0x92, 0x25, 0xf2, 0xb1, 0x93,
+	0x71, 0x0a, 0x12, 0xb0, 0xf3, 0xf7, 0xf9, 0xf3, 0xf1, 0x77, 0x7e, 0x6c, 0x68, 0x4f, 0x99, 0x94,
+	0xe1, 0x98, 0x1d, 0x64, 0x82, 0xe7, 0xdc, 0xad, 0x64, 0xd1, 0xce, 0xa3, 0x71, 0x9c, 0x4f, 0x8a,
+	0xe8, 0x60, 0xc8, 0xa7, 0x87, 0x63, 0x3e, 0xe6, 0x87, 0xb8, 0x15, 0x15, 0x57, 0x88, 0x10, 0xe0,
+	0x4a, 0x1f, 0xa1, 0x17, 0xd0, 0x7c, 0x2a, 0x78, 0x9a, 0xc7, 0x4c, 0x04, 0xec, 0xc6, 0xf5, 0x60,
+	0xad, 0x37, 0x1a, 0x09, 0x26, 0xa5, 0x47, 0x3a, 0xa4, 0xdb, 0x0a, 0x16, 0xd0, 0xdd, 0x04, 0xa7,
+	0x37, 0x66, 0x5e, 0xa5, 0x43, 0xba, 0xed, 0x40, 0x2d, 0xdd, 0x2d, 0xa8, 0x1d, 0xf3, 0x22, 0xcd,
+	0x3d, 0x07, 0x39, 0x0d, 0xe8, 0x7f, 0x56, 0x40, 0x99, 0xb9, 0xbb, 0xd0, 0x58, 0x40, 0x15, 0xd2,
+	0xe9, 0xb6, 0x82, 0x92, 0xa0, 0x6f, 0x09, 0x34, 0x8f, 0x8a, 0xe4, 0xba, 0x5f, 0x24, 0x89, 0xba,
+	0x7e, 0x17, 0x1a, 0x83, 0x3c, 0x14, 0xf9, 0x59, 0x28, 0x27, 0xc6, 0x40, 0x49, 0x28, 0x73, 0xa7,
+	0xe9, 0x08, 0xf7, 0x2a, 0xda, 0x9c, 0x81, 0xee, 0x0e, 0xac, 0xab, 0x10, 0x97, 0x77, 0x19, 0x33,
+	0x6e, 0x96, 0xb8, 0xb4, 0x59, 0xb5, 0x6c, 0xba, 0xdb, 0x50, 0x57, 0x27, 0x99, 0xf4, 0x6a, 0x18,
+	0xca, 0x20, 0xda, 0xb3, 0x0c, 0xc9, 0x6c, 0x25, 0x30, 0x79, 0x10, 0x78, 0x1b, 0xea, 0x51, 0xc2,
+	0x87, 0xd7, 0xd2, 0xb8, 0x31, 0x88, 0xee, 0x43, 0x5b, 0x87, 0x90, 0x93, 0x23, 0xc5, 0x58, 0x42,
+	0xb2, 0x22, 0xdc, 0x83, 0x56, 0xbf, 0x88, 0x92, 0x78, 0xa1, 0xdb, 0x82, 0x1a, 0xee, 0x18, 0x99,
+	0x06, 0x94, 0x02, 0x1c, 0xf3, 0xf4, 0x2a, 0x16, 0x53, 0x55, 0x21, 0x4b, 0xe3, 0x94, 0x9a, 0x7c,
+	0xa9, 0xe9, 0x0d, 0xaf, 0xb1, 0x89, 0xc3, 0x21, 0xe6, 0xbc, 0x68, 0xa2, 0x86, 0x58, 0xdf, 0x78,
+	0x9c, 0x86, 0x79, 0x21, 0x98, 0x71, 0x5d, 0x12, 0x2a, 0xd9, 0x01, 0xbb, 0x29, 0x58, 0x3a, 0x5c,
+	0x56, 0x71, 0x81, 0x5d, 0x17, 0xaa, 0x58, 0xf8, 0x2a, 0x5e, 0x8b, 0x6b, 0xfa, 0x9e, 0x40, 0xa3,
+	0xcf, 0x6f, 0x07, 0x79, 0x98, 0x17, 0xd2, 0xdd, 0x83, 0xf6, 0x71, 0x21, 0x04, 0x4b, 0xf3, 0x33,
+	0x16, 0x8f, 0x27, 0xfa, 0xee, 0x6a, 0xb0, 0x4a, 0xba, 0x1d, 0x68, 0x2e, 0x88, 0xb2, 0x8f, 0x36,
+	0xa5, 0x14, 0xcf, 0x58, 0xca, 0x64, 0x2c, 0x51, 0xe1, 0x68, 0x85, 0x45, 0xa9, 0x2c, 0xcc, 0x81,
+	0xcb, 0x13, 0xec, 0x6a, 0x2b, 0x28, 0x09, 0xb5, 0x7b, 0x19, 0x4f, 0x99, 0xcc, 0xc3, 0x69, 0x86,
+	0xcd, 0x75, 0x82, 0x92, 0xa0, 0xfb, 0xb0, 0xd1, 0xe7, 0xb7, 0x7f, 0x50, 0xf6, 0x2f, 0xc4, 0x28,
+	0x93, 0x04, 0x65, 0xbf, 0x1f, 0xcf, 0x0e, 0x34, 0x35, 0xd0, 0xe9, 0x57, 0x30, 0x7d, 0x9b, 0xfa,
+	0xf5, 0x8b, 0x59, 0x99, 0xb1, 0xea, 0xcf, 0x33, 0x16, 0xb0, 0x50, 0xf2, 0x14, 0x33, 0x69, 0x07,
+	0x06, 0xa9, 0x33, 0x2f, 0xf8, 0x30, 0xcc, 0xb9, 0x90, 0x5e, 0x1d, 0x8d, 0x2c, 0xb1, 0xeb, 0x03,
+	0x9c, 0xb1, 0x70, 0xc4, 0xc4, 0x45, 0x9a, 0xdc, 0x79, 0x6b, 0x1d, 0xd2, 0x5d, 0x0f, 0x2c, 0x86,
+	0xf2, 0x07, 0x89, 0xc9, 0x4c, 0x19, 0x2b, 0xe7, 0xa5, 0x1d, 0x68, 0x50, 0x16, 0xa6, 0x62, 0x15,
+	0xc6, 0xb2, 0xe4, 0xac, 0x58, 0xf2, 0x60, 0x6d, 0x82, 0x97, 0x48, 0x33, 0x24, 0x0b, 0x48, 0x0f,
+	0x00, 0x5e, 0xea, 0x7f, 0x4a, 0x4d, 0x67, 0x07, 0x9a, 0xe6, 0xd7, 0xb2, 0xca, 0x68, 0x53, 0xf4,
+	0x15, 0x6c, 0x9a, 0xb1, 0x62, 0x7d, 0xc1, 0xf9, 0x95, 0x2a, 0xfd, 0xbf, 0x50, 0x39, 0x3f, 0x31,
+	0x23, 0x55, 0x39, 0xc7, 0x2e, 0xa3, 0x7b, 0x6b, 0x8a, 0x4a, 0x42, 0x7d, 0x56, 0xcf, 0xd9, 0x9d,
+	0x99, 0x1d, 0xb5, 0x54, 0xae, 0x07, 0x45, 0xa4, 0x48, 0x3d, 0x30, 0x06, 0xd1, 0x37, 0xe4, 0xe1,
+	0x65, 0x32, 0xfb, 0xcb, 0xcb, 0xb6, 0xa0, 0x86, 0x27, 0x3d, 0x47, 0x3f, 0x49, 0x04, 0xf8, 0x98,
+	0x8a, 0x48, 0x6f, 0xe8, 0x7a, 0x2c, 0xb1, 0x3a, 0x71, 0x2a, 0x04, 0x17, 0xd8, 0xd4, 0x46, 0xa0,
+	0x01, 0x7d, 0x02, 0x1b, 0x01, 0xcb, 0x4e, 0x6f, 0x8a, 0xf8, 0x56, 0xb5, 0x32, 0xe6, 0xa9, 0x4b,
+	0xa1, 0xc5, 0x2c, 0x6c, 0x8a, 0xb5, 0xc2, 0x1d, 0xfd, 0xff, 0x75, 0xe6, 0xff, 0x73, 0x3f, 0xf3,
+	0xc9, 0xb7, 0x99, 0x4f, 0xbe, 0xcf, 0x7c, 0xf2, 0x7a, 0xee, 0x93, 0x77, 0x73, 0x9f, 0x7c, 0x98,
+	0xfb, 0xe4, 0xe3, 0xdc, 0x27, 0x9f, 0xe6, 0x3e, 0xf9, 0x3c, 0xf7, 0xc9, 0xfd, 0xdc, 0x27, 0x51,
+	0x1d, 0xbf, 0xfd, 0xc7, 0x3f, 0x06, 0x00, 0x55, 0x8d, 0x74, 0x43, 0x3a, 0x06, 0x00, 0x00,
 }
 
 func (this *FrontierReq) VerboseEqual(that interface{}) error {
@@ -1609,6 +1830,9 @@ func (this *PovPullBlockReq) VerboseEqual(that interface{}) error {
 	if !bytes.Equal(this.Locators, that1.Locators) {
 		return fmt.Errorf("Locators this(%v) Not Equal that(%v)", this.Locators, that1.Locators)
 	}
+	if this.HeaderOnly != that1.HeaderOnly {
+		return fmt.Errorf("HeaderOnly this(%v) Not Equal that(%v)", this.HeaderOnly, that1.HeaderOnly)
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
@@ -1651,6 +1875,9 @@ func (this *PovPullBlockReq) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Locators, that1.Locators) {
 		return false
 	}
+	if this.HeaderOnly != that1.HeaderOnly {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1690,6 +1917,14 @@ func (this *PovPullBlockRsp) VerboseEqual(that interface{}) error {
 	if this.Reason != that1.Reason {
 		return fmt.Errorf("Reason this(%v) Not Equal that(%v)", this.Reason, that1.Reason)
 	}
+	if len(this.Headers) != len(that1.Headers) {
+		return fmt.Errorf("Headers this(%v) Not Equal that(%v)", len(this.Headers), len(that1.Headers))
+	}
+	for i := range this.Headers {
+		if !bytes.Equal(this.Headers[i], that1.Headers[i]) {
+			return fmt.Errorf("Headers this[%v](%v) Not Equal that[%v](%v)", i, this.Headers[i], i, that1.Headers[i])
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
@@ -1723,6 +1958,14 @@ func (this *PovPullBlockRsp) Equal(that interface{}) bool {
 	if this.Reason != that1.Reason {
 		return false
 	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !bytes.Equal(this.Headers[i], that1.Headers[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1788,100 +2031,342 @@ func (this *MessageAck) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *FrontierReq) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PovStateProofReq) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.FrontierReq{")
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "Age: "+fmt.Sprintf("%#v", this.Age)+",\n")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*PovStateProofReq)
+	if !ok {
+		that2, ok := that.(PovStateProofReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PovStateProofReq")
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *FrontierRsp) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PovStateProofReq but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PovStateProofReq but is not nil && this == nil")
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.FrontierRsp{")
-	s = append(s, "Frontiers: "+fmt.Sprintf("%#v", this.Frontiers)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BulkPullReq) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return fmt.Errorf("BlockHash this(%v) Not Equal that(%v)", this.BlockHash, that1.BlockHash)
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&pb.BulkPullReq{")
-	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
-	s = append(s, "EndHash: "+fmt.Sprintf("%#v", this.EndHash)+",\n")
-	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	s = append(s, "Hashes: "+fmt.Sprintf("%#v", this.Hashes)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.Key, that1.Key) {
+		return fmt.Errorf("Key this(%v) Not Equal that(%v)", this.Key, that1.Key)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BulkPullRsp) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.SubKey, that1.SubKey) {
+		return fmt.Errorf("SubKey this(%v) Not Equal that(%v)", this.SubKey, that1.SubKey)
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.BulkPullRsp{")
-	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
-	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *BulkPushBlock) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PovStateProofReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.BulkPushBlock{")
-	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*PovStateProofReq)
+	if !ok {
+		that2, ok := that.(PovStateProofReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PublishBlock) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.PublishBlock{")
-	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.ID != that1.ID {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ConfirmReq) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ConfirmReq{")
-	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.SubKey, that1.SubKey) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PovStateProofRsp) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PovStateProofRsp)
+	if !ok {
+		that2, ok := that.(PovStateProofRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PovStateProofRsp")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PovStateProofRsp but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PovStateProofRsp but is not nil && this == nil")
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return fmt.Errorf("BlockHash this(%v) Not Equal that(%v)", this.BlockHash, that1.BlockHash)
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return fmt.Errorf("Proof this(%v) Not Equal that(%v)", len(this.Proof), len(that1.Proof))
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return fmt.Errorf("Proof this[%v](%v) Not Equal that[%v](%v)", i, this.Proof[i], i, that1.Proof[i])
+		}
+	}
+	if len(this.SubProof) != len(that1.SubProof) {
+		return fmt.Errorf("SubProof this(%v) Not Equal that(%v)", len(this.SubProof), len(that1.SubProof))
+	}
+	for i := range this.SubProof {
+		if !bytes.Equal(this.SubProof[i], that1.SubProof[i]) {
+			return fmt.Errorf("SubProof this[%v](%v) Not Equal that[%v](%v)", i, this.SubProof[i], i, that1.SubProof[i])
+		}
+	}
+	if this.Error != that1.Error {
+		return fmt.Errorf("Error this(%v) Not Equal that(%v)", this.Error, that1.Error)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *PovStateProofRsp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PovStateProofRsp)
+	if !ok {
+		that2, ok := that.(PovStateProofRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	if len(this.SubProof) != len(that1.SubProof) {
+		return false
+	}
+	for i := range this.SubProof {
+		if !bytes.Equal(this.SubProof[i], that1.SubProof[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RepEquivocation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RepEquivocation)
+	if !ok {
+		that2, ok := that.(RepEquivocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RepEquivocation")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RepEquivocation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RepEquivocation but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.Equivocation, that1.Equivocation) {
+		return fmt.Errorf("Equivocation this(%v) Not Equal that(%v)", this.Equivocation, that1.Equivocation)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *RepEquivocation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RepEquivocation)
+	if !ok {
+		that2, ok := that.(RepEquivocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Equivocation, that1.Equivocation) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FrontierReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.FrontierReq{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Age: "+fmt.Sprintf("%#v", this.Age)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FrontierRsp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.FrontierRsp{")
+	s = append(s, "Frontiers: "+fmt.Sprintf("%#v", this.Frontiers)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BulkPullReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&pb.BulkPullReq{")
+	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
+	s = append(s, "EndHash: "+fmt.Sprintf("%#v", this.EndHash)+",\n")
+	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Hashes: "+fmt.Sprintf("%#v", this.Hashes)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BulkPullRsp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.BulkPullRsp{")
+	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
+	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BulkPushBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.BulkPushBlock{")
+	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PublishBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.PublishBlock{")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConfirmReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ConfirmReq{")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
@@ -1936,7 +2421,7 @@ func (this *PovPullBlockReq) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&pb.PovPullBlockReq{")
 	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
 	s = append(s, "StartHeight: "+fmt.Sprintf("%#v", this.StartHeight)+",\n")
@@ -1944,6 +2429,7 @@ func (this *PovPullBlockReq) GoString() string {
 	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
 	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
 	s = append(s, "Locators: "+fmt.Sprintf("%#v", this.Locators)+",\n")
+	s = append(s, "HeaderOnly: "+fmt.Sprintf("%#v", this.HeaderOnly)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1954,11 +2440,12 @@ func (this *PovPullBlockRsp) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.PovPullBlockRsp{")
 	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
 	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
 	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Headers: "+fmt.Sprintf("%#v", this.Headers)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1978,6 +2465,52 @@ func (this *MessageAck) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *PovStateProofReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.PovStateProofReq{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PovStateProofRsp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&pb.PovStateProofRsp{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "SubProof: "+fmt.Sprintf("%#v", this.SubProof)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RepEquivocation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.RepEquivocation{")
+	s = append(s, "Equivocation: "+fmt.Sprintf("%#v", this.Equivocation)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2438,10 +2971,20 @@ func (m *PovPullBlockReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Locators) > 0 {
-		i -= len(m.Locators)
-		copy(dAtA[i:], m.Locators)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Locators)))
+	if m.HeaderOnly {
+		i--
+		if m.HeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Locators) > 0 {
+		i -= len(m.Locators)
+		copy(dAtA[i:], m.Locators)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Locators)))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -2499,6 +3042,15 @@ func (m *PovPullBlockRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Headers[iNdEx])
+			copy(dAtA[i:], m.Headers[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Headers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Reason != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.Reason))
 		i--
@@ -2553,6 +3105,157 @@ func (m *MessageAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PovStateProofReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PovStateProofReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PovStateProofReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SubKey) > 0 {
+		i -= len(m.SubKey)
+		copy(dAtA[i:], m.SubKey)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PovStateProofRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PovStateProofRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PovStateProofRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SubProof) > 0 {
+		for iNdEx := len(m.SubProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubProof[iNdEx])
+			copy(dAtA[i:], m.SubProof[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.SubProof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Equivocation) > 0 {
+		i -= len(m.Equivocation)
+		copy(dAtA[i:], m.Equivocation)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Equivocation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -2763,8 +3466,9 @@ func NewPopulatedPovPullBlockReq(r randyMessage, easy bool) *PovPullBlockReq {
 	for i := 0; i < v21; i++ {
 		this.Locators[i] = byte(r.Intn(256))
 	}
+	this.HeaderOnly = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 7)
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 8)
 	}
 	return this
 }
@@ -2778,17 +3482,26 @@ func NewPopulatedPovPullBlockRsp(r randyMessage, easy bool) *PovPullBlockRsp {
 		this.Block[i] = byte(r.Intn(256))
 	}
 	this.Reason = uint32(r.Uint32())
+	v23 := r.Intn(10)
+	this.Headers = make([][]byte, v23)
+	for i := 0; i < v23; i++ {
+		v24 := r.Intn(100)
+		this.Headers[i] = make([]byte, v24)
+		for j := 0; j < v24; j++ {
+			this.Headers[i][j] = byte(r.Intn(256))
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 5)
 	}
 	return this
 }
 
 func NewPopulatedMessageAck(r randyMessage, easy bool) *MessageAck {
 	this := &MessageAck{}
-	v23 := r.Intn(100)
-	this.MessageHash = make([]byte, v23)
-	for i := 0; i < v23; i++ {
+	v25 := r.Intn(100)
+	this.MessageHash = make([]byte, v25)
+	for i := 0; i < v25; i++ {
 		this.MessageHash[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -2797,6 +3510,76 @@ func NewPopulatedMessageAck(r randyMessage, easy bool) *MessageAck {
 	return this
 }
 
+func NewPopulatedPovStateProofReq(r randyMessage, easy bool) *PovStateProofReq {
+	this := &PovStateProofReq{}
+	this.ID = uint64(uint64(r.Uint32()))
+	v26 := r.Intn(100)
+	this.BlockHash = make([]byte, v26)
+	for i := 0; i < v26; i++ {
+		this.BlockHash[i] = byte(r.Intn(256))
+	}
+	v27 := r.Intn(100)
+	this.Key = make([]byte, v27)
+	for i := 0; i < v27; i++ {
+		this.Key[i] = byte(r.Intn(256))
+	}
+	v28 := r.Intn(100)
+	this.SubKey = make([]byte, v28)
+	for i := 0; i < v28; i++ {
+		this.SubKey[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedPovStateProofRsp(r randyMessage, easy bool) *PovStateProofRsp {
+	this := &PovStateProofRsp{}
+	this.ID = uint64(uint64(r.Uint32()))
+	v29 := r.Intn(100)
+	this.BlockHash = make([]byte, v29)
+	for i := 0; i < v29; i++ {
+		this.BlockHash[i] = byte(r.Intn(256))
+	}
+	v30 := r.Intn(10)
+	this.Proof = make([][]byte, v30)
+	for i := 0; i < v30; i++ {
+		v31 := r.Intn(100)
+		this.Proof[i] = make([]byte, v31)
+		for j := 0; j < v31; j++ {
+			this.Proof[i][j] = byte(r.Intn(256))
+		}
+	}
+	v32 := r.Intn(10)
+	this.SubProof = make([][]byte, v32)
+	for i := 0; i < v32; i++ {
+		v33 := r.Intn(100)
+		this.SubProof[i] = make([]byte, v33)
+		for j := 0; j < v33; j++ {
+			this.SubProof[i][j] = byte(r.Intn(256))
+		}
+	}
+	this.Error = string(randStringMessage(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedRepEquivocation(r randyMessage, easy bool) *RepEquivocation {
+	this := &RepEquivocation{}
+	v34 := r.Intn(100)
+	this.Equivocation = make([]byte, v34)
+	for i := 0; i < v34; i++ {
+		this.Equivocation[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
 type randyMessage interface {
 	Float32() float32
 	Float64() float64
@@ -2816,9 +3599,9 @@ func randUTF8RuneMessage(r randyMessage) rune {
 	return rune(ru + 61)
 }
 func randStringMessage(r randyMessage) string {
-	v24 := r.Intn(100)
-	tmps := make([]rune, v24)
-	for i := 0; i < v24; i++ {
+	v35 := r.Intn(100)
+	tmps := make([]rune, v35)
+	for i := 0; i < v35; i++ {
 		tmps[i] = randUTF8RuneMessage(r)
 	}
 	return string(tmps)
@@ -2840,11 +3623,11 @@ func randFieldMessage(dAtA []byte, r randyMessage, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
-		v25 := r.Int63()
+		v36 := r.Int63()
 		if r.Intn(2) == 0 {
-			v25 *= -1
+			v36 *= -1
 		}
-		dAtA = encodeVarintPopulateMessage(dAtA, uint64(v25))
+		dAtA = encodeVarintPopulateMessage(dAtA, uint64(v36))
 	case 1:
 		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3109,6 +3892,9 @@ func (m *PovPullBlockReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.HeaderOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3131,6 +3917,12 @@ func (m *PovPullBlockRsp) Size() (n int) {
 	if m.Reason != 0 {
 		n += 1 + sovMessage(uint64(m.Reason))
 	}
+	if len(m.Headers) > 0 {
+		for _, b := range m.Headers {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3153,6 +3945,84 @@ func (m *MessageAck) Size() (n int) {
 	return n
 }
 
+func (m *PovStateProofReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMessage(uint64(m.ID))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PovStateProofRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMessage(uint64(m.ID))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.SubProof) > 0 {
+		for _, b := range m.SubProof {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Equivocation)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3294,6 +4164,7 @@ func (this *PovPullBlockReq) String() string {
 		`PullType:` + fmt.Sprintf("%v", this.PullType) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`Locators:` + fmt.Sprintf("%v", this.Locators) + `,`,
+		`HeaderOnly:` + fmt.Sprintf("%v", this.HeaderOnly) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3307,6 +4178,7 @@ func (this *PovPullBlockRsp) String() string {
 		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
 		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Headers:` + fmt.Sprintf("%v", this.Headers) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3323,6 +4195,46 @@ func (this *MessageAck) String() string {
 	}, "")
 	return s
 }
+func (this *PovStateProofReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PovStateProofReq{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PovStateProofRsp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PovStateProofRsp{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`SubProof:` + fmt.Sprintf("%v", this.SubProof) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepEquivocation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepEquivocation{`,
+		`Equivocation:` + fmt.Sprintf("%v", this.Equivocation) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4734,6 +5646,26 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 				m.Locators = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4860,8 +5792,40 @@ func (m *PovPullBlockRsp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, make([]byte, postIndex-iNdEx))
+			copy(m.Headers[len(m.Headers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -4973,6 +5937,472 @@ func (m *MessageAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PovStateProofReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PovStateProofReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PovStateProofReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = append(m.SubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubKey == nil {
+				m.SubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PovStateProofRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PovStateProofRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PovStateProofRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubProof = append(m.SubProof, make([]byte, postIndex-iNdEx))
+			copy(m.SubProof[len(m.SubProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equivocation = append(m.Equivocation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Equivocation == nil {
+				m.Equivocation = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,12 +27,14 @@ type PovBulkPullReq struct {
 	PullType    uint32
 	Reason      uint32
 	Locators    []*types.Hash
+	HeaderOnly  bool
 }
 
 type PovBulkPullRsp struct {
-	Count  uint32
-	Reason uint32
-	Blocks types.PovBlocks
+	Count   uint32
+	Reason  uint32
+	Blocks  types.PovBlocks
+	Headers []*types.PovHeader
 }
 
 func PovBulkPullReqToProto(req *PovBulkPullReq) ([]byte, error) {
@@ -47,6 +49,7 @@ func PovBulkPullReqToProto(req *PovBulkPullReq) ([]byte, error) {
 		PullType:    req.PullType,
 		Reason:      req.Reason,
 		Locators:    locBytes,
+		HeaderOnly:  req.HeaderOnly,
 	}
 	data, err := proto.Marshal(pbReq)
 	if err != nil {
@@ -79,6 +82,7 @@ func PovBulkPullReqFromProto(data []byte) (*PovBulkPullReq, error) {
 		PullType:    pbReq.PullType,
 		Reason:      pbReq.Reason,
 		Locators:    locators,
+		HeaderOnly:  pbReq.HeaderOnly,
 	}
 
 	err := req.StartHash.UnmarshalBinary(pbReq.StartHash)
@@ -95,10 +99,20 @@ func PovBulkPullRspToProto(rsp *PovBulkPullRsp) ([]byte, error) {
 		return nil, err
 	}
 
+	headerBytes := make([][]byte, 0, len(rsp.Headers))
+	for _, header := range rsp.Headers {
+		data, err := header.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		headerBytes = append(headerBytes, data)
+	}
+
 	pbReq := &pb.PovPullBlockRsp{
-		Count:  rsp.Count,
-		Block:  blockBytes,
-		Reason: rsp.Reason,
+		Count:   rsp.Count,
+		Block:   blockBytes,
+		Reason:  rsp.Reason,
+		Headers: headerBytes,
 	}
 
 	data, err := proto.Marshal(pbReq)
@@ -120,10 +134,20 @@ func PovBulkPullRspFromProto(data []byte) (*PovBulkPullRsp, error) {
 		return nil, err
 	}
 
+	headers := make([]*types.PovHeader, 0, len(pbRsp.Headers))
+	for _, data := range pbRsp.Headers {
+		header := new(types.PovHeader)
+		if err := header.Deserialize(data); err != nil {
+			return nil, err
+		}
+		headers = append(headers, header)
+	}
+
 	rsp := &PovBulkPullRsp{
-		Count:  pbRsp.Count,
-		Blocks: blocks,
-		Reason: pbRsp.Reason,
+		Count:   pbRsp.Count,
+		Blocks:  blocks,
+		Reason:  pbRsp.Reason,
+		Headers: headers,
 	}
 	return rsp, nil
 }
@@ -27,6 +27,7 @@ func TestPovBulkPullReq(t *testing.T) {
 		PullType:    PovPullTypeBackward,
 		Reason:      PovReasonFetch,
 		Locators:    Locators,
+		HeaderOnly:  true,
 	}
 	data, err := PovBulkPullReqToProto(povReq)
 	if err != nil {
@@ -36,7 +37,7 @@ func TestPovBulkPullReq(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if r.StartHash != start1 || r.StartHeight != 1000 || r.Count != 2 || r.PullType != PovPullTypeBackward || r.Reason != PovReasonFetch || len(r.Locators) != 2 {
+	if r.StartHash != start1 || r.StartHeight != 1000 || r.Count != 2 || r.PullType != PovPullTypeBackward || r.Reason != PovReasonFetch || len(r.Locators) != 2 || !r.HeaderOnly {
 		t.Fatal("there is some error in PovBulkPullReqToProto or PovBulkPullReqFromProto")
 	}
 }
@@ -61,3 +62,27 @@ func TestPovBulkPullRsp(t *testing.T) {
 		t.Fatal("there is some error in PovBulkPullRspToProto or PovBulkPullRspFromProto")
 	}
 }
+
+func TestPovBulkPullRsp_Headers(t *testing.T) {
+	blk1, _ := mock.GeneratePovBlock(nil, 0)
+	blk2, _ := mock.GeneratePovBlock(blk1, 0)
+	rsp := &PovBulkPullRsp{
+		Count:   2,
+		Reason:  PovReasonSync,
+		Headers: []*types.PovHeader{blk1.GetHeader(), blk2.GetHeader()},
+	}
+	data, err := PovBulkPullRspToProto(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := PovBulkPullRspFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Count != 2 || len(r.Blocks) != 0 || len(r.Headers) != 2 {
+		t.Fatal("there is some error in PovBulkPullRspToProto or PovBulkPullRspFromProto")
+	}
+	if r.Headers[0].GetHash() != blk1.GetHash() || r.Headers[1].GetHash() != blk2.GetHash() {
+		t.Fatal("invalid headers")
+	}
+}
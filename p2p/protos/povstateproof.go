@@ -0,0 +1,89 @@
+package protos
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/p2p/protos/pb"
+)
+
+// PovStateProofReq asks a full node for the merkle proof of Key in the state trie of the block,
+// when SubKey is set Key must be a contract state key and SubKey is proved in the contract trie
+type PovStateProofReq struct {
+	ID        uint64
+	BlockHash types.Hash
+	Key       []byte
+	SubKey    []byte
+}
+
+type PovStateProofRsp struct {
+	ID        uint64
+	BlockHash types.Hash
+	Proof     [][]byte
+	SubProof  [][]byte
+	Error     string
+}
+
+func PovStateProofReqToProto(req *PovStateProofReq) ([]byte, error) {
+	pbReq := &pb.PovStateProofReq{
+		ID:        req.ID,
+		BlockHash: req.BlockHash[:],
+		Key:       req.Key,
+		SubKey:    req.SubKey,
+	}
+	data, err := proto.Marshal(pbReq)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func PovStateProofReqFromProto(data []byte) (*PovStateProofReq, error) {
+	pbReq := new(pb.PovStateProofReq)
+	if err := proto.Unmarshal(data, pbReq); err != nil {
+		return nil, err
+	}
+
+	req := &PovStateProofReq{
+		ID:     pbReq.ID,
+		Key:    pbReq.Key,
+		SubKey: pbReq.SubKey,
+	}
+	if err := req.BlockHash.UnmarshalBinary(pbReq.BlockHash); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func PovStateProofRspToProto(rsp *PovStateProofRsp) ([]byte, error) {
+	pbRsp := &pb.PovStateProofRsp{
+		ID:        rsp.ID,
+		BlockHash: rsp.BlockHash[:],
+		Proof:     rsp.Proof,
+		SubProof:  rsp.SubProof,
+		Error:     rsp.Error,
+	}
+	data, err := proto.Marshal(pbRsp)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func PovStateProofRspFromProto(data []byte) (*PovStateProofRsp, error) {
+	pbRsp := new(pb.PovStateProofRsp)
+	if err := proto.Unmarshal(data, pbRsp); err != nil {
+		return nil, err
+	}
+
+	rsp := &PovStateProofRsp{
+		ID:       pbRsp.ID,
+		Proof:    pbRsp.Proof,
+		SubProof: pbRsp.SubProof,
+		Error:    pbRsp.Error,
+	}
+	if err := rsp.BlockHash.UnmarshalBinary(pbRsp.BlockHash); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
@@ -0,0 +1,52 @@
+package protos
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestPovStateProofReq(t *testing.T) {
+	req := &PovStateProofReq{
+		ID:        10,
+		BlockHash: mock.Hash(),
+		Key:       []byte("key"),
+		SubKey:    []byte("subKey"),
+	}
+	data, err := PovStateProofReqToProto(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := PovStateProofReqFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.ID != req.ID || r.BlockHash != req.BlockHash || !bytes.Equal(r.Key, req.Key) || !bytes.Equal(r.SubKey, req.SubKey) {
+		t.Fatal("there is some error in PovStateProofReqToProto or PovStateProofReqFromProto")
+	}
+}
+
+func TestPovStateProofRsp(t *testing.T) {
+	rsp := &PovStateProofRsp{
+		ID:        10,
+		BlockHash: mock.Hash(),
+		Proof:     [][]byte{[]byte("node1"), []byte("node2")},
+		SubProof:  [][]byte{[]byte("node3")},
+		Error:     "error",
+	}
+	data, err := PovStateProofRspToProto(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := PovStateProofRspFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.ID != rsp.ID || r.BlockHash != rsp.BlockHash || len(r.Proof) != 2 || len(r.SubProof) != 1 || r.Error != rsp.Error {
+		t.Fatal("there is some error in PovStateProofRspToProto or PovStateProofRspFromProto")
+	}
+	if !bytes.Equal(r.Proof[1], rsp.Proof[1]) {
+		t.Fatal("invalid proof")
+	}
+}
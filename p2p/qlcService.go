@@ -1,13 +1,16 @@
 package p2p
 
 import (
+	"errors"
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 
 	chainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/event"
 	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/vm/contract/abi"
 )
@@ -20,6 +23,12 @@ type QlcService struct {
 	msgEvent   event.EventBus
 	msgService *MessageService
 	cc         *chainctx.ChainContext
+
+	feb            *event.FeedEventBus
+	febRpcMsgCh    chan *topic.EventRPCSyncCallMsg
+	febRpcMsgSubID event.FeedSubscription
+	quitCh         chan struct{}
+	cfgSubID       int
 }
 
 // NewQlcService create netService
@@ -35,9 +44,17 @@ func NewQlcService(cfgFile string) (*QlcService, error) {
 		dispatcher: NewDispatcher(),
 		msgEvent:   cc.EventBus(),
 		cc:         cc,
+
+		feb:         cc.FeedEventBus(),
+		febRpcMsgCh: make(chan *topic.EventRPCSyncCallMsg, 100),
+		quitCh:      make(chan struct{}),
 	}
 	node.SetQlcService(ns)
 	l := ledger.NewLedger(cfgFile)
+	node.scorer = NewPeerScorer(cfg.P2P.PeerScore, l)
+	node.scorer.addrOf = node.peerAddr
+	node.scorer.onBan = node.disconnectPeer
+	node.connectionGater.scorer = node.scorer
 	msgService := NewMessageService(ns, l)
 	ns.msgService = msgService
 	return ns, nil
@@ -68,6 +85,9 @@ func (ns *QlcService) Start() error {
 	if err := ns.setWhiteList(); err != nil {
 		return err
 	}
+	if cm, err := ns.cc.ConfigManager(); err == nil {
+		ns.cfgSubID = cm.Subscribe(ns.reloadWhiteList, config.SectionWhiteList)
+	}
 
 	// start node.
 	if err := ns.node.StartServices(); err != nil {
@@ -103,6 +123,14 @@ func (ns *QlcService) setWhiteList() error {
 	return nil
 }
 
+// reloadWhiteList rebuilds the whitelist from the permission nodes and the reloaded config
+func (ns *QlcService) reloadWhiteList(_, _ *config.Config) {
+	ns.node.connectionGater.resetWhiteList()
+	if err := ns.setWhiteList(); err != nil {
+		ns.node.logger.Error(err)
+	}
+}
+
 func (ns *QlcService) setEvent() error {
 	ns.subscriber = event.NewActorSubscriber(event.SpawnWithPool(func(c actor.Context) {
 		switch msg := c.Message().(type) {
@@ -124,18 +152,83 @@ func (ns *QlcService) setEvent() error {
 			if len(msg.NodeId) != 0 {
 				ns.node.updateWhiteList(msg.NodeId, msg.NodeUrl)
 			}
+		case *topic.EventPeerMisbehaviourMsg:
+			ns.node.scorer.Penalize(msg.PeerID, msg.Kind, msg.Reason)
 		}
 	}), ns.msgEvent)
 
 	if err := ns.subscriber.Subscribe(topic.EventBroadcast, topic.EventSendMsgToSingle, topic.EventFrontiersReq,
-		topic.EventRepresentativeNode, topic.EventConsensusSyncFinished, topic.EventPermissionNodeUpdate); err != nil {
+		topic.EventRepresentativeNode, topic.EventConsensusSyncFinished, topic.EventPermissionNodeUpdate,
+		topic.EventPeerMisbehaviour); err != nil {
 		ns.node.logger.Error(err)
 		return err
 	}
 
+	ns.febRpcMsgSubID = ns.feb.Subscribe(topic.EventRpcSyncCall, ns.febRpcMsgCh)
+	if ns.febRpcMsgSubID == nil {
+		ns.node.logger.Error("failed to subscribe EventRpcSyncCall")
+	}
+	common.Go(ns.rpcLoop)
+
 	return nil
 }
 
+func (ns *QlcService) rpcLoop() {
+	for {
+		select {
+		case <-ns.quitCh:
+			return
+		case msg := <-ns.febRpcMsgCh:
+			ns.onEventRPCSyncCall(msg)
+		}
+	}
+}
+
+func (ns *QlcService) onEventRPCSyncCall(msg *topic.EventRPCSyncCallMsg) {
+	needRsp := false
+	switch msg.Name {
+	case "P2P.GetBans":
+		ns.getBans(msg.In, msg.Out)
+		needRsp = true
+	case "P2P.BanPeer":
+		ns.banPeer(msg.In, msg.Out)
+		needRsp = true
+	case "P2P.UnbanPeer":
+		ns.unbanPeer(msg.In, msg.Out)
+		needRsp = true
+	}
+	if needRsp && msg.ResponseChan != nil {
+		msg.ResponseChan <- msg.Out
+	}
+}
+
+func (ns *QlcService) getBans(in interface{}, out interface{}) {
+	outArgs := out.(map[interface{}]interface{})
+	outArgs["err"] = nil
+	outArgs["bans"] = ns.node.scorer.Bans()
+}
+
+func (ns *QlcService) banPeer(in interface{}, out interface{}) {
+	inArgs := in.(map[interface{}]interface{})
+	outArgs := out.(map[interface{}]interface{})
+
+	peerID := inArgs["peerID"].(string)
+	if peerID == ns.node.ID.Pretty() {
+		outArgs["err"] = errors.New("can not ban self")
+		return
+	}
+	duration := inArgs["duration"].(time.Duration)
+	reason := inArgs["reason"].(string)
+	outArgs["err"] = ns.node.scorer.Ban(peerID, duration, reason)
+}
+
+func (ns *QlcService) unbanPeer(in interface{}, out interface{}) {
+	inArgs := in.(map[interface{}]interface{})
+	outArgs := out.(map[interface{}]interface{})
+
+	outArgs["err"] = ns.node.scorer.Unban(inArgs["peerID"].(string))
+}
+
 // Stop stop p2p manager.
 func (ns *QlcService) Stop() error {
 	// ns.node.logger.VInfo("Stopping QlcService...")
@@ -145,6 +238,13 @@ func (ns *QlcService) Stop() error {
 	if err != nil {
 		return err
 	}
+	if ns.febRpcMsgSubID != nil {
+		ns.febRpcMsgSubID.Unsubscribe()
+	}
+	if cm, err := ns.cc.ConfigManager(); err == nil {
+		cm.Unsubscribe(ns.cfgSubID)
+	}
+	close(ns.quitCh)
 
 	if err := ns.node.Stop(); err != nil {
 		return err
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/bluele/gcache"
 	"go.uber.org/zap"
 
 	"github.com/qlcchain/go-qlc/chain/context"
@@ -22,6 +23,8 @@ const (
 	maxResendTime  = 3
 	pullRspTimeOut = 10 * time.Minute
 	pullReqTimeOut = 60 * time.Second
+
+	completeHistoryCacheSize = 100000
 )
 
 // Service manage sync tasks
@@ -43,6 +46,7 @@ type ServiceSync struct {
 	lastSyncHash       types.Hash
 	quitChanForSync    chan bool
 	mu                 *sync.Mutex
+	completeHistory    gcache.Cache // block hash -> pruned height at which the block's chain was complete up to its frontier
 }
 
 // NewService return new Service.
@@ -56,6 +60,7 @@ func NewSyncService(netService *QlcService, ledger ledger.Store) *ServiceSync {
 		pullRequestStartCh: make(chan bool, 1),
 		quitChanForSync:    make(chan bool, 1),
 		mu:                 &sync.Mutex{},
+		completeHistory:    gcache.New(completeHistoryCacheSize).LRU().Build(),
 	}
 	return ss
 }
@@ -375,6 +380,11 @@ func (ss *ServiceSync) onBulkPullRequest(message *Message) error {
 	//if pullType != protos.PullTypeSegment {
 	//	return ss.onBulkPullRequestExt(message, pullRemote)
 	//}
+	if !ss.canServeBulkPull(startHash, openBlockHash) {
+		ss.logger.Infof("history from %s to %s is pruned, can not serve bulk pull", startHash, endHash)
+		req := &protos.BulkPullRspPacket{PullType: protos.PullTypePruned}
+		return ss.netService.SendMessageToPeer(BulkPullRsp, req, message.MessageFrom())
+	}
 
 	if exitPullRsp != nil {
 		exitPullRsp.pullRspTimer.Reset(pullRspTimeOut)
@@ -604,6 +614,16 @@ func (ss *ServiceSync) onBulkPullRsp(message *Message) error {
 	if err != nil {
 		return err
 	}
+	if blkPacket.PullType == protos.PullTypePruned {
+		ss.logger.Warnf("peer %s has pruned history of [%s-%s], skip it", message.MessageFrom(), ss.pullStartHash, ss.pullEndHash)
+		ss.lastSyncHash = types.ZeroHash
+		ss.pullTimer.Reset(pullReqTimeOut)
+		select {
+		case ss.pullRequestStartCh <- true:
+		default:
+		}
+		return nil
+	}
 	blocks := blkPacket.Blocks
 	if len(blocks) == 0 {
 		return nil
@@ -731,6 +751,43 @@ func (ss *ServiceSync) requestTxsByHashes(reqTxHashes []*types.Hash, peerID stri
 	}
 }
 
+// canServeBulkPull checks that the account chain from the requested start block is still complete in the ledger,
+// nodes which never pruned their ledger always have full history.
+// The account chain is walked up to its frontier once, blocks on a complete chain are cached
+// until the next pruning, so following segments of the same account are answered without a walk.
+func (ss *ServiceSync) canServeBulkPull(startHash, openBlockHash types.Hash) bool {
+	h, err := ss.qlcLedger.GetPrunedHeight()
+	if err != nil || h == 0 {
+		return true
+	}
+	temp := startHash
+	if temp.IsZero() {
+		temp = openBlockHash
+	} else if has, _ := ss.qlcLedger.HasStateBlockConfirmed(temp); !has {
+		temp = openBlockHash
+	}
+	visited := make([]types.Hash, 0)
+	for {
+		if v, err := ss.completeHistory.Get(temp); err == nil && v.(uint64) == h {
+			break
+		}
+		if has, _ := ss.qlcLedger.HasStateBlockConfirmed(temp); !has {
+			return false
+		}
+		visited = append(visited, temp)
+		child, err := ss.qlcLedger.GetBlockChild(temp)
+		if err != nil {
+			// temp is the frontier of the account
+			break
+		}
+		temp = child
+	}
+	for _, hash := range visited {
+		_ = ss.completeHistory.Set(hash, h)
+	}
+	return true
+}
+
 func (ss *ServiceSync) getOpenBlockHash(hash types.Hash) (types.Hash, error) {
 	blk, err := ss.qlcLedger.GetStateBlockConfirmed(hash)
 	if err != nil {
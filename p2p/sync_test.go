@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger/process"
 	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/mock/mocks"
 )
 
 func TestServiceSync(t *testing.T) {
@@ -186,3 +188,28 @@ func TestServiceSync(t *testing.T) {
 	node1.msgService.syncService.onConsensusSyncFinished()
 	time.Sleep(10 * time.Second)
 }
+
+func TestServiceSync_CanServeBulkPull(t *testing.T) {
+	l := new(mocks.Store)
+	ss := NewSyncService(nil, l)
+
+	open, b1, b2 := mock.Hash(), mock.Hash(), mock.Hash()
+	l.On("GetPrunedHeight").Return(uint64(10), nil)
+	l.On("HasStateBlockConfirmed", open).Return(true, nil)
+	l.On("HasStateBlockConfirmed", b1).Return(false, nil)
+	l.On("HasStateBlockConfirmed", b2).Return(true, nil)
+	l.On("GetBlockChild", open).Return(b1, nil)
+	l.On("GetBlockChild", b2).Return(types.ZeroHash, errors.New("block child not found"))
+
+	if ss.canServeBulkPull(types.ZeroHash, open) {
+		t.Fatal("history of the account is pruned")
+	}
+	if !ss.canServeBulkPull(b2, open) {
+		t.Fatal("history from b2 is complete")
+	}
+	// answered from cache
+	if !ss.canServeBulkPull(b2, open) {
+		t.Fatal("history from b2 is complete")
+	}
+	l.AssertNumberOfCalls(t, "GetBlockChild", 2)
+}
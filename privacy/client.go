@@ -16,15 +16,20 @@ type Transport interface {
 }
 
 type Client struct {
+	node       string
 	scheme     string // http+unix, http
 	rootPath   string
 	transport  Transport
 	httpClient *http.Client
 }
 
-func NewClient(ptmNode string) *Client {
+func normalizeNode(ptmNode string) string {
 	ptmNode = strings.TrimSpace(ptmNode)
-	ptmNode = strings.TrimRight(ptmNode, "/")
+	return strings.TrimRight(ptmNode, "/")
+}
+
+func NewClient(ptmNode string) *Client {
+	ptmNode = normalizeNode(ptmNode)
 
 	parts := strings.Split(ptmNode, ":")
 	if len(parts) < 2 {
@@ -36,7 +41,7 @@ func NewClient(ptmNode string) *Client {
 	}
 	rootPath := ptmNode[len(parts[0])+1:]
 
-	c := &Client{scheme: scheme, rootPath: rootPath}
+	c := &Client{node: ptmNode, scheme: scheme, rootPath: rootPath}
 	c.httpClient = &http.Client{}
 
 	if c.scheme == "http+unix" {
@@ -25,6 +25,9 @@ type Controller struct {
 	feb            *event.FeedEventBus
 	febRpcMsgCh    chan *topic.EventRPCSyncCallMsg
 	febRpcMsgSubID event.FeedSubscription
+
+	cfgManager *config.CfgManager
+	cfgSubID   int
 }
 
 func NewController(cc *context.ChainContext) *Controller {
@@ -33,6 +36,7 @@ func NewController(cc *context.ChainContext) *Controller {
 	c.cfg, _ = cc.Config()
 	c.eb = cc.EventBus()
 	c.ptm = NewPTM(c.cfg)
+	c.cfgManager, _ = cc.ConfigManager()
 	c.quitCh = make(chan struct{})
 
 	c.feb = cc.FeedEventBus()
@@ -74,6 +78,14 @@ func (c *Controller) Start() error {
 		return err
 	}
 
+	if c.cfgManager != nil {
+		c.cfgSubID = c.cfgManager.Subscribe(func(old, cur *config.Config) {
+			if err := c.ptm.SetPtmNode(cur.Privacy.PtmNode); err != nil {
+				c.logger.Errorf("failed to set ptm node %s, %s", cur.Privacy.PtmNode, err)
+			}
+		}, config.SectionPrivacy)
+	}
+
 	common.Go(c.mainLoop)
 
 	return nil
@@ -81,6 +93,9 @@ func (c *Controller) Start() error {
 
 func (c *Controller) Stop() error {
 	c.febRpcMsgSubID.Unsubscribe()
+	if c.cfgManager != nil {
+		c.cfgManager.Unsubscribe(c.cfgSubID)
+	}
 
 	err := c.subscriber.UnsubscribeAll()
 	if err != nil {
@@ -158,3 +158,40 @@ func TestController_Debug(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestController_ReloadPtmNode(t *testing.T) {
+	tearDown, md := setupPrivacyTestCase(t, "http")
+	defer tearDown(t)
+
+	err := md.privacy.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cm, err := md.cc.ConfigManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := "http://127.0.0.1:9998/__UnitTestCase__"
+	if _, err := cm.UpdateParams([]string{"privacy.ptmNode=" + node}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := cm.Reload(false); !ok || err != nil {
+		t.Fatal(ok, err)
+	}
+	if md.privacy.ptm.getNode() != node {
+		t.Fatal("ptm node not changed", md.privacy.ptm.getNode())
+	}
+	if c := md.privacy.ptm.acquireClient(); c == nil || c.node != node {
+		t.Fatal("invalid client")
+	}
+
+	if err := md.privacy.ptm.SetPtmNode("ftp://127.0.0.1"); err == nil {
+		t.Fatal("invalid ptm node should be rejected")
+	}
+
+	err = md.privacy.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
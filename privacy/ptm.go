@@ -25,6 +25,8 @@ type PTM struct {
 	cfg        *config.Config
 	logger     *zap.SugaredLogger
 	clientPool sync.Pool
+	nodeLock   sync.RWMutex
+	node       string
 	mainClient *Client
 	cache      gcache.Cache
 	status     atomic.Int32
@@ -43,9 +45,9 @@ var (
 )
 
 func NewPTM(cfg *config.Config) *PTM {
-	m := &PTM{cfg: cfg}
+	m := &PTM{cfg: cfg, node: cfg.Privacy.PtmNode}
 	m.clientPool.New = func() interface{} {
-		return NewClient(m.cfg.Privacy.PtmNode)
+		return NewClient(m.getNode())
 	}
 	m.status.Store(ptmNodeUnknown)
 	return m
@@ -55,14 +57,14 @@ func (m *PTM) Init() error {
 	m.cache = gcache.New(common.DPoSMaxBlocks).LRU().Build()
 	m.logger = log.NewLogger("privacy_ptm")
 
-	m.mainClient = NewClient(m.cfg.Privacy.PtmNode)
+	m.mainClient = NewClient(m.node)
 	if m.mainClient == nil {
 		return errors.New("invalid ptm node")
 	}
 
 	nCPU := runtime.NumCPU()
 	for i := 0; i < nCPU; i++ {
-		c := NewClient(m.cfg.Privacy.PtmNode)
+		c := NewClient(m.node)
 		if c == nil {
 			return errors.New("invalid ptm node")
 		}
@@ -75,7 +77,7 @@ func (m *PTM) Init() error {
 func (m *PTM) Start() error {
 	m.quitCh = make(chan struct{})
 
-	m.logger.Info("ptm node at ", m.cfg.Privacy.PtmNode)
+	m.logger.Info("ptm node at ", m.getNode())
 
 	common.Go(m.mainLoop)
 
@@ -175,7 +177,7 @@ func (m *PTM) onUpCheckTicker() {
 	oldStatus := m.status.Load()
 	newStatus := int32(ptmNodeUnknown)
 
-	chkOk, chkErr := m.mainClient.Upcheck()
+	chkOk, chkErr := m.getMainClient().Upcheck()
 	if chkOk {
 		newStatus = int32(ptmNodeRunning)
 	} else {
@@ -187,9 +189,9 @@ func (m *PTM) onUpCheckTicker() {
 	}
 
 	if newStatus == int32(ptmNodeRunning) {
-		m.logger.Infof("ptm node is online, url %s", m.cfg.Privacy.PtmNode)
+		m.logger.Infof("ptm node is online, url %s", m.getNode())
 	} else {
-		m.logger.Errorf("ptm node is offline, url [%s], err [%s]", m.cfg.Privacy.PtmNode, chkErr)
+		m.logger.Errorf("ptm node is offline, url [%s], err [%s]", m.getNode(), chkErr)
 	}
 
 	m.status.Store(newStatus)
@@ -197,11 +199,16 @@ func (m *PTM) onUpCheckTicker() {
 
 func (m *PTM) acquireClient() *Client {
 	if m.fakeMode {
-		return m.mainClient
+		return m.getMainClient()
 	}
+	node := m.getNode()
 	v := m.clientPool.Get()
 	if v != nil {
-		return v.(*Client)
+		// drop the pooled client of the previous ptm node
+		if c := v.(*Client); c.node == normalizeNode(node) {
+			return c
+		}
+		return NewClient(node)
 	}
 	return nil
 }
@@ -224,5 +231,36 @@ func (m *PTM) GetDebugInfo() map[string]interface{} {
 
 func (m *PTM) SetFakeMode(mode bool) {
 	m.fakeMode = mode
-	m.mainClient.transport.SetFakeMode(mode)
+	m.getMainClient().transport.SetFakeMode(mode)
+}
+
+func (m *PTM) getNode() string {
+	m.nodeLock.RLock()
+	defer m.nodeLock.RUnlock()
+	return m.node
+}
+
+func (m *PTM) getMainClient() *Client {
+	m.nodeLock.RLock()
+	defer m.nodeLock.RUnlock()
+	return m.mainClient
+}
+
+// SetPtmNode switches to a new ptm node, the status is unknown until the node is checked
+func (m *PTM) SetPtmNode(node string) error {
+	c := NewClient(node)
+	if c == nil {
+		return errors.New("invalid ptm node")
+	}
+	c.transport.SetFakeMode(m.fakeMode)
+
+	m.nodeLock.Lock()
+	m.node = node
+	m.mainClient = c
+	m.nodeLock.Unlock()
+
+	m.status.Store(ptmNodeUnknown)
+	m.logger.Info("ptm node changed to ", node)
+	common.Go(m.onUpCheckTicker)
+	return nil
 }
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+type AutoReceiveApi struct {
+	cfgManager *config.CfgManager
+	logger     *zap.SugaredLogger
+}
+
+var ErrNoReceivePolicy = errors.New("account has no auto receive policy")
+
+func NewAutoReceiveApi(cfgFile string) *AutoReceiveApi {
+	cc := context.NewChainContext(cfgFile)
+	cfgManager, _ := cc.ConfigManager()
+	return &AutoReceiveApi{
+		cfgManager: cfgManager,
+		logger:     log.NewLogger("rpc/autoReceive"),
+	}
+}
+
+// GetPolicies returns the auto receive policies of all accounts
+func (a *AutoReceiveApi) GetPolicies() ([]*config.ReceivePolicy, error) {
+	cfg, err := a.cfgManager.Config()
+	if err != nil {
+		return nil, err
+	}
+	policies := cfg.ReceivePolicies()
+	if policies == nil {
+		policies = make([]*config.ReceivePolicy, 0)
+	}
+	return policies, nil
+}
+
+// GetPolicy returns the auto receive policy of the account
+func (a *AutoReceiveApi) GetPolicy(account types.Address) (*config.ReceivePolicy, error) {
+	policies, err := a.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		if p.Account == account.String() {
+			return p, nil
+		}
+	}
+	return nil, ErrNoReceivePolicy
+}
+
+// SetPolicy adds or replaces the auto receive policy of an account, the change is saved and applied at once
+func (a *AutoReceiveApi) SetPolicy(policy *config.ReceivePolicy) (bool, error) {
+	if policy == nil {
+		return false, ErrParameterNil
+	}
+	err := a.cfgManager.UpdateSection(config.SectionAutoReceive, func(cfg *config.Config) error {
+		return cfg.SetReceivePolicy(policy)
+	})
+	if err != nil {
+		return false, err
+	}
+	a.logger.Infof("set auto receive policy of %s", policy.Account)
+	return true, nil
+}
+
+// RemovePolicy removes the auto receive policy of the account, its pendings are received without restriction
+func (a *AutoReceiveApi) RemovePolicy(account types.Address) (bool, error) {
+	err := a.cfgManager.UpdateSection(config.SectionAutoReceive, func(cfg *config.Config) error {
+		if !cfg.RemoveReceivePolicy(account.String()) {
+			return ErrNoReceivePolicy
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	a.logger.Infof("remove auto receive policy of %s", account)
+	return true, nil
+}
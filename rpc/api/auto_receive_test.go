@@ -0,0 +1,50 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestAutoReceiveApi(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "autoReceive", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cm := config.NewCfgManager(dir)
+	if _, err := cm.Load(); err != nil {
+		t.Fatal(err)
+	}
+	api := NewAutoReceiveApi(cm.ConfigFile)
+
+	if ps, err := api.GetPolicies(); err != nil || len(ps) != 0 {
+		t.Fatal(ps, err)
+	}
+	account := mock.Address()
+	if _, err := api.SetPolicy(nil); err == nil {
+		t.Fatal("nil policy should be rejected")
+	}
+	if _, err := api.SetPolicy(&config.ReceivePolicy{Account: account.String(), MinAmount: "x"}); err == nil {
+		t.Fatal("invalid policy should be rejected")
+	}
+	if ok, err := api.SetPolicy(&config.ReceivePolicy{Account: account.String(), DenyTokens: []string{"QGAS"}}); !ok || err != nil {
+		t.Fatal(ok, err)
+	}
+	if p, err := api.GetPolicy(account); err != nil || len(p.DenyTokens) != 1 {
+		t.Fatal(p, err)
+	}
+	if ok, err := api.RemovePolicy(account); !ok || err != nil {
+		t.Fatal(ok, err)
+	}
+	if _, err := api.GetPolicy(account); err != ErrNoReceivePolicy {
+		t.Fatal(err)
+	}
+	if _, err := api.RemovePolicy(account); err != ErrNoReceivePolicy {
+		t.Fatal(err)
+	}
+}
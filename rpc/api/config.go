@@ -88,8 +88,7 @@ func (c *ConfigApi) Commit(token string, mark string) (bool, error) {
 	if mark != c.mark {
 		return false, ErrOperation
 	}
-	c.eb.Publish(topic.EventRestartChain, types.NewTuple(c.cfgManager.ConfigFile, false))
-	return true, nil
+	return c.apply(false)
 }
 
 func (c *ConfigApi) Save(token string, mark string) (bool, error) {
@@ -99,6 +98,19 @@ func (c *ConfigApi) Save(token string, mark string) (bool, error) {
 	if mark != c.mark {
 		return false, ErrOperation
 	}
-	c.eb.Publish(topic.EventRestartChain, types.NewTuple(c.cfgManager.ConfigFile, true))
+	return c.apply(true)
+}
+
+// apply reloads the changed config live, and restarts the chain only if some changes can not be reloaded
+func (c *ConfigApi) apply(isSave bool) (bool, error) {
+	reloaded, err := c.cfgManager.Reload(isSave)
+	if err != nil {
+		return false, err
+	}
+	if reloaded {
+		c.logger.Info("config reloaded without restarting the chain")
+		return true, nil
+	}
+	c.eb.Publish(topic.EventRestartChain, types.NewTuple(c.cfgManager.ConfigFile, isSave))
 	return true, nil
 }
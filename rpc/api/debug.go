@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	rpc "github.com/qlcchain/jsonrpc2"
@@ -16,6 +19,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/consensus/dpos"
@@ -745,3 +749,50 @@ func (l *DebugApi) GetPrivacyInfo() (map[string]interface{}, error) {
 
 	return outArgs, nil
 }
+
+type APISnapshot struct {
+	*ledger.SnapshotInfo
+	File string `json:"file"`
+}
+
+// only one snapshot is exported at a time, an export holds a read transaction of the whole store
+var snapshotExporting int32
+
+// ExportSnapshot exports the ledger of the running node at its latest PoV height,
+// the file is written to the snapshot folder of the data dir, name defaults to a time stamped one
+func (l *DebugApi) ExportSnapshot(name string) (*APISnapshot, error) {
+	if !atomic.CompareAndSwapInt32(&snapshotExporting, 0, 1) {
+		return nil, errors.New("snapshot export is in progress")
+	}
+	defer atomic.StoreInt32(&snapshotExporting, 0)
+
+	cfg, err := qctx.NewChainContext(l.cfgFile).Config()
+	if err != nil {
+		return nil, err
+	}
+	file := ledger.DefaultSnapshotFile(cfg.DataDir)
+	if name != "" {
+		if filepath.Base(name) != name {
+			return nil, fmt.Errorf("invalid snapshot file name %s", name)
+		}
+		file = filepath.Join(filepath.Dir(file), name)
+	}
+	if err := util.CreateDirIfNotExist(filepath.Dir(file)); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	info, err := l.ledger.ExportSnapshot(f)
+	if e := f.Close(); e != nil && err == nil {
+		err = e
+	}
+	if err != nil {
+		_ = os.Remove(file)
+		return nil, err
+	}
+	l.logger.Infof("export snapshot %s at pov height %d", file, info.PovHeight)
+	return &APISnapshot{SnapshotInfo: info, File: file}, nil
+}
@@ -396,3 +396,34 @@ func TestDebugApi_UncheckBlocks(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDebugApi_ExportSnapshot(t *testing.T) {
+	teardownTestCase, l, debugApi := setupDefaultDebugAPI(t)
+	defer teardownTestCase(t)
+
+	if err := l.AddStateBlock(mock.StateBlockWithoutWork()); err != nil {
+		t.Fatal(err)
+	}
+	r, err := debugApi.ExportSnapshot("test.qsnap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(r.File) != "test.qsnap" || r.Records == 0 {
+		t.Fatal(r)
+	}
+	f, err := os.Open(r.File)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if info, err := ledger.VerifySnapshot(f); err != nil || info.Checksum != r.Checksum {
+		t.Fatal(err, info)
+	}
+
+	if _, err := debugApi.ExportSnapshot("test.qsnap"); err == nil {
+		t.Fatal("existing snapshot should not be overwritten")
+	}
+	if _, err := debugApi.ExportSnapshot("../test.qsnap"); err == nil {
+		t.Fatal("snapshot should be written to the snapshot folder")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,13 +15,19 @@ import (
 	"go.uber.org/zap"
 
 	chainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/event"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/consensus/dpos"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/ledger/relation"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/monitor/tracing"
+	"github.com/qlcchain/go-qlc/vm/abi"
+	"github.com/qlcchain/go-qlc/vm/contract"
 )
 
 var (
@@ -67,6 +74,11 @@ type APIBlock struct {
 	PovConfirmCount  uint64 `json:"povConfirmCount"`
 }
 
+type APIConfirmedStatus struct {
+	Confirmed bool `json:"confirmed"`
+	*dpos.ConfirmDepth
+}
+
 type APIAccount struct {
 	Address        types.Address   `json:"account"`
 	CoinBalance    *types.Balance  `json:"coinBalance,omitempty"`
@@ -102,6 +114,12 @@ type APIPending struct {
 	BlockType types.BlockType `json:"blockType"`
 }
 
+// APIAsOf selects the PoV block of a historical query, by Hash if it is set, otherwise by Height
+type APIAsOf struct {
+	Height uint64     `json:"height"`
+	Hash   types.Hash `json:"hash"`
+}
+
 type ApiTokenInfo struct {
 	types.TokenInfo
 }
@@ -164,7 +182,7 @@ func (l *LedgerAPI) AccountHistoryTopn(address types.Address, count int, offset
 	latestPov, err := l.ledger.GetLatestPovHeader()
 
 	for _, h := range hashes {
-		block, err := l.ledger.GetStateBlockConfirmed(h)
+		block, err := l.ledger.GetStateBlock(h)
 		if err != nil {
 			return nil, fmt.Errorf("can not get block %s", block.GetHash().String())
 		}
@@ -177,7 +195,20 @@ func (l *LedgerAPI) AccountHistoryTopn(address types.Address, count int, offset
 	return bs, nil
 }
 
-func (l *LedgerAPI) AccountInfo(address types.Address) (*APIAccount, error) {
+// AccountInfo returns the current account info, or the confirmed account info at PoV block asOf if it is set
+func (l *LedgerAPI) AccountInfo(address types.Address, asOf *APIAsOf) (*APIAccount, error) {
+	if asOf != nil {
+		height, err := l.povHeightOf(asOf)
+		if err != nil {
+			return nil, err
+		}
+		am, err := l.ledger.GetAccountMetaAtPovHeight(address, height)
+		if err != nil {
+			return nil, err
+		}
+		return l.generateAPIAccountMetaAtPovHeight(am, height)
+	}
+
 	am, err := l.ledger.GetAccountMeta(address)
 	if err != nil {
 		return nil, err
@@ -193,8 +224,8 @@ func (l *LedgerAPI) ConfirmedAccountInfo(address types.Address) (*APIAccount, er
 	return GenerateAPIAccountMeta(l.ledger, am)
 }
 
-func (l *LedgerAPI) AccountRepresentative(addr types.Address) (types.Address, error) {
-	am, err := l.ledger.GetAccountMetaConfirmed(addr)
+func (l *LedgerAPI) AccountRepresentative(addr types.Address, asOf *APIAsOf) (types.Address, error) {
+	am, err := l.accountMeta(addr, asOf)
 	if err != nil {
 		return types.ZeroAddress, err
 	}
@@ -217,10 +248,28 @@ func (l *LedgerAPI) AccountVotingWeight(addr types.Address) (types.Balance, erro
 	return b.Total, err
 }
 
-func (l *LedgerAPI) AccountsBalance(addresses []types.Address) (map[types.Address]map[string]*APIAccountsBalance, error) {
+func (l *LedgerAPI) AccountsBalance(addresses []types.Address, asOf *APIAsOf) (map[types.Address]map[string]*APIAccountsBalance, error) {
+	var height uint64
+	if asOf != nil {
+		h, err := l.povHeightOf(asOf)
+		if err != nil {
+			return nil, err
+		}
+		height = h
+	}
+
 	as := make(map[types.Address]map[string]*APIAccountsBalance)
 	for _, addr := range addresses {
-		ac, err := l.ledger.GetAccountMetaConfirmed(addr)
+		var ac *types.AccountMeta
+		var pendings map[types.Hash]types.Balance
+		var err error
+		if asOf != nil {
+			if ac, err = l.ledger.GetAccountMetaAtPovHeight(addr, height); err == nil {
+				pendings, err = l.pendingAmountsAtPovHeight(addr, height)
+			}
+		} else {
+			ac, err = l.ledger.GetAccountMetaConfirmed(addr)
+		}
 		if err != nil {
 			if err == ledger.ErrAccountNotFound {
 				continue
@@ -234,8 +283,12 @@ func (l *LedgerAPI) AccountsBalance(addresses []types.Address) (map[types.Addres
 				return nil, err
 			}
 			b := new(APIAccountsBalance)
-			amount, err := l.ledger.PendingAmount(addr, t.Type)
-			if err != nil {
+			amount := types.ZeroBalance
+			if pendings != nil {
+				if p, ok := pendings[t.Type]; ok {
+					amount = p
+				}
+			} else if amount, err = l.ledger.PendingAmount(addr, t.Type); err != nil {
 				l.logger.Errorf("pending amount error: %s", err)
 				return nil, err
 			}
@@ -278,12 +331,23 @@ func (l *LedgerAPI) AccountsFrontiers(addresses []types.Address) (map[types.Addr
 	return r, nil
 }
 
-func (l *LedgerAPI) AccountsPending(addresses []types.Address, n int) (map[types.Address][]*APIPending, error) {
+func (l *LedgerAPI) AccountsPending(addresses []types.Address, n int, asOf *APIAsOf) (map[types.Address][]*APIPending, error) {
+	getPendings := l.ledger.GetPendingsByAddress
+	if asOf != nil {
+		height, err := l.povHeightOf(asOf)
+		if err != nil {
+			return nil, err
+		}
+		getPendings = func(address types.Address, fn func(key *types.PendingKey, value *types.PendingInfo) error) error {
+			return l.ledger.GetPendingsAtPovHeight(address, height, fn)
+		}
+	}
+
 	apMap := make(map[types.Address][]*APIPending)
 
 	for _, addr := range addresses {
 		ps := make([]*APIPending, 0)
-		err := l.ledger.GetPendingsByAddress(addr, func(key *types.PendingKey, info *types.PendingInfo) error {
+		err := getPendings(addr, func(key *types.PendingKey, info *types.PendingInfo) error {
 			token, err := l.ledger.GetTokenById(info.Type)
 			if err != nil {
 				return err
@@ -364,6 +428,26 @@ func (l *LedgerAPI) BlockConfirmedStatus(hash types.Hash) (bool, error) {
 	return b, nil
 }
 
+// BlockConfirmedDepth returns whether the block is confirmed and its votes, the votes are only known
+// for blocks in election or confirmed recently by the node
+func (l *LedgerAPI) BlockConfirmedDepth(hash types.Hash) (*APIConfirmedStatus, error) {
+	b, err := l.ledger.HasStateBlockConfirmed(hash)
+	if err != nil {
+		return nil, err
+	}
+	status := &APIConfirmedStatus{
+		Confirmed: b,
+		ConfirmDepth: &dpos.ConfirmDepth{
+			Weight:    types.ZeroBalance,
+			Threshold: types.ZeroBalance,
+		},
+	}
+	if sv, err := l.cc.Service(chainctx.ConsensusService); err == nil {
+		sv.(common.InterceptCall).RpcCall(common.RpcDPoSConfirmDepth, hash, status.ConfirmDepth)
+	}
+	return status, nil
+}
+
 func (l *LedgerAPI) BlockHash(block types.StateBlock) types.Hash {
 	return block.GetHash()
 }
@@ -458,7 +542,7 @@ func (l *LedgerAPI) ConfirmedBlocksInfo(hash []types.Hash) ([]*APIBlock, error)
 	latestPov, _ := l.ledger.GetLatestPovHeader()
 
 	for _, h := range hash {
-		block, err := l.ledger.GetStateBlockConfirmed(h)
+		block, err := l.ledger.GetStateBlock(h)
 		if err != nil {
 			if err == ledger.ErrBlockNotFound {
 				continue
@@ -503,6 +587,131 @@ func (l *LedgerAPI) Blocks(count int, offset *int) ([]*APIBlock, error) {
 	return bs, nil
 }
 
+// APIBlockQuery is the filter of QueryBlocks, fields of zero value are not filtered
+type APIBlockQuery struct {
+	Address   *types.Address `json:"address,omitempty"`
+	Token     *types.Hash    `json:"token,omitempty"`
+	TokenName string         `json:"tokenName,omitempty"`
+	Types     []string       `json:"types,omitempty"`
+	StartTime int64          `json:"startTime,omitempty"`
+	EndTime   int64          `json:"endTime,omitempty"`
+	MinAmount *types.Balance `json:"minAmount,omitempty"`
+	MaxAmount *types.Balance `json:"maxAmount,omitempty"`
+	// Method is the hex of method selector, or method name of the chain contract specified by Link
+	Method string      `json:"method,omitempty"`
+	Link   *types.Hash `json:"link,omitempty"`
+	Cursor string      `json:"cursor,omitempty"`
+	Count  int         `json:"count,omitempty"`
+}
+
+type APIBlockQueryResult struct {
+	Blocks []*APIBlock `json:"blocks"`
+	Cursor string      `json:"cursor"`
+}
+
+// QueryBlocks returns blocks matching the query, newest first, pass the returned cursor to get the next page
+func (l *LedgerAPI) QueryBlocks(para *APIBlockQuery) (*APIBlockQueryResult, error) {
+	if para == nil {
+		return nil, ErrParameterNil
+	}
+	q, err := l.blockQuery(para)
+	if err != nil {
+		return nil, err
+	}
+	hashes, cursor, err := l.ledger.QueryBlocks(q)
+	if err != nil {
+		return nil, err
+	}
+
+	bs := make([]*APIBlock, 0)
+	latestPov, _ := l.ledger.GetLatestPovHeader()
+	for _, h := range hashes {
+		block, err := l.ledger.GetStateBlock(h)
+		if err != nil && err != ledger.ErrBlockNotFound {
+			return nil, err
+		}
+		if block != nil {
+			b, err := GenerateAPIBlock(l.ledger, block, latestPov)
+			if err != nil {
+				return nil, err
+			}
+			bs = append(bs, b)
+		}
+	}
+	return &APIBlockQueryResult{Blocks: bs, Cursor: cursor}, nil
+}
+
+func (l *LedgerAPI) blockQuery(para *APIBlockQuery) (*relation.BlockQuery, error) {
+	if para.Count < 0 || para.Count > relation.MaxQueryLimit {
+		return nil, fmt.Errorf("count should be between 0 and %d", relation.MaxQueryLimit)
+	}
+	q := &relation.BlockQuery{
+		StartTime: para.StartTime,
+		EndTime:   para.EndTime,
+		MinAmount: para.MinAmount,
+		MaxAmount: para.MaxAmount,
+		Cursor:    para.Cursor,
+		Limit:     para.Count,
+	}
+	if para.Address != nil {
+		q.Address = *para.Address
+	}
+	if para.Token != nil {
+		q.Token = *para.Token
+	} else if para.TokenName != "" {
+		info, err := l.ledger.GetTokenByName(para.TokenName)
+		if err != nil {
+			return nil, err
+		}
+		q.Token = info.TokenId
+	}
+	for _, t := range para.Types {
+		bt := types.BlockTypeFromStr(t)
+		if bt == types.Invalid {
+			return nil, fmt.Errorf("invalid block type: %s", t)
+		}
+		q.Types = append(q.Types, bt)
+	}
+	if para.Link != nil {
+		q.Link = *para.Link
+	}
+	if para.Method != "" {
+		method, err := methodSelector(para.Method, q.Link)
+		if err != nil {
+			return nil, err
+		}
+		q.Method = method
+	}
+	return q, nil
+}
+
+// methodSelector decodes method as a selector in hex, otherwise looks up the method name in the abi of chain contract link
+func methodSelector(method string, link types.Hash) ([]byte, error) {
+	s := strings.TrimPrefix(method, "0x")
+	if len(s) == 8 {
+		if b, err := hex.DecodeString(s); err == nil {
+			return b, nil
+		}
+	}
+	addr := types.Address(link)
+	if link.IsZero() || !contract.IsChainContract(addr) {
+		return nil, fmt.Errorf("method %s should be a selector in hex, or link should be a chain contract", method)
+	}
+	abiJson, err := contract.GetAbiByContractAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	c, err := abi.JSONToABIContract(strings.NewReader(abiJson))
+	if err != nil {
+		return nil, err
+	}
+	m, ok := c.Methods[method]
+	if !ok {
+		return nil, fmt.Errorf("method %s not found in contract %s", method, addr.String())
+	}
+	return m.Id(), nil
+}
+
 // Chain returns a consecutive list of block hashes in the account chain starting at block up to count
 func (l *LedgerAPI) Chain(hash types.Hash, n int) ([]types.Hash, error) {
 	if n < -1 {
@@ -762,6 +971,14 @@ func (l *LedgerAPI) Process(block *types.StateBlock) (types.Hash, error) {
 	if block == nil {
 		return types.ZeroHash, ErrParameterNil
 	}
+	span := tracing.StartBlock(block.GetHash(), "LedgerAPI.Process")
+	hash, err := l.processBlock(block, span)
+	span.SetError(err)
+	span.End()
+	return hash, err
+}
+
+func (l *LedgerAPI) processBlock(block *types.StateBlock, span *tracing.Span) (types.Hash, error) {
 	if !l.cc.IsPoVDone() {
 		return types.ZeroHash, chainctx.ErrPoVNotFinish
 	}
@@ -779,7 +996,11 @@ func (l *LedgerAPI) Process(block *types.StateBlock) (types.Hash, error) {
 	}()
 	ledger := l.ledger
 	verifier := process.NewLedgerVerifier(ledger)
+	checkSpan := span.Child("LedgerVerifier.BlockCacheCheck")
 	flag, err := verifier.BlockCacheCheck(block)
+	checkSpan.SetAttributes(tracing.String("result", flag.String()))
+	checkSpan.SetError(err)
+	checkSpan.End()
 	if flag == process.Other {
 		l.logger.Error(err)
 		return types.ZeroHash, err
@@ -790,7 +1011,10 @@ func (l *LedgerAPI) Process(block *types.StateBlock) (types.Hash, error) {
 	case process.Progress:
 		hash := block.GetHash()
 		verify := process.NewLedgerVerifier(ledger)
+		processSpan := span.Child("LedgerVerifier.BlockCacheProcess")
 		err := verify.BlockCacheProcess(block)
+		processSpan.SetError(err)
+		processSpan.End()
 		if err != nil {
 			l.logger.Errorf("Block %s add to blockCache error[%s]", hash, err)
 			return types.ZeroHash, err
@@ -1199,6 +1423,88 @@ func GenerateAPIBlock(store ledger.Store, block *types.StateBlock, latestPov *ty
 	return ab, nil
 }
 
+func (l *LedgerAPI) accountMeta(address types.Address, asOf *APIAsOf) (*types.AccountMeta, error) {
+	if asOf == nil {
+		return l.ledger.GetAccountMetaConfirmed(address)
+	}
+	height, err := l.povHeightOf(asOf)
+	if err != nil {
+		return nil, err
+	}
+	return l.ledger.GetAccountMetaAtPovHeight(address, height)
+}
+
+// povHeightOf returns the height of PoV block asOf, a block given by hash must be on the best chain
+func (l *LedgerAPI) povHeightOf(asOf *APIAsOf) (uint64, error) {
+	if !asOf.Hash.IsZero() {
+		header, err := l.ledger.GetPovHeaderByHash(asOf.Hash)
+		if err != nil {
+			return 0, fmt.Errorf("pov block %s not found", asOf.Hash)
+		}
+		if best, err := l.ledger.GetPovBestHash(header.GetHeight()); err != nil || best != asOf.Hash {
+			return 0, fmt.Errorf("pov block %s is not on the best chain", asOf.Hash)
+		}
+		return header.GetHeight(), nil
+	}
+
+	latest, err := l.ledger.GetPovLatestHeight()
+	if err != nil {
+		return 0, err
+	}
+	if asOf.Height > latest {
+		return 0, fmt.Errorf("pov height %d is higher than latest height %d", asOf.Height, latest)
+	}
+	return asOf.Height, nil
+}
+
+func (l *LedgerAPI) pendingAmountsAtPovHeight(address types.Address, height uint64) (map[types.Hash]types.Balance, error) {
+	amounts := make(map[types.Hash]types.Balance)
+	err := l.ledger.GetPendingsAtPovHeight(address, height, func(key *types.PendingKey, info *types.PendingInfo) error {
+		if amount, ok := amounts[info.Type]; ok {
+			amounts[info.Type] = amount.Add(info.Amount)
+		} else {
+			amounts[info.Type] = info.Amount
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return amounts, nil
+}
+
+func (l *LedgerAPI) generateAPIAccountMetaAtPovHeight(am *types.AccountMeta, height uint64) (*APIAccount, error) {
+	pendings, err := l.pendingAmountsAtPovHeight(am.Address, height)
+	if err != nil {
+		return nil, fmt.Errorf("pending amount error: %s", err)
+	}
+	aa := &APIAccount{Address: am.Address}
+	for _, t := range am.Tokens {
+		if t.Type == config.ChainToken() {
+			aa.CoinBalance = &t.Balance
+			aa.Representative = &t.Representative
+			aa.CoinVote = &am.CoinVote
+			aa.CoinNetwork = &am.CoinNetwork
+			aa.CoinOracle = &am.CoinOracle
+			aa.CoinStorage = &am.CoinStorage
+		}
+		info, err := l.ledger.GetTokenById(t.Type)
+		if err != nil {
+			return nil, err
+		}
+		amount := types.ZeroBalance
+		if p, ok := pendings[t.Type]; ok {
+			amount = p
+		}
+		aa.Tokens = append(aa.Tokens, &APITokenMeta{
+			TokenMeta: t,
+			TokenName: info.TokenName,
+			Pending:   amount,
+		})
+	}
+	return aa, nil
+}
+
 func GenerateAPIAccountMeta(store ledger.Store, am *types.AccountMeta) (*APIAccount, error) {
 	aa := new(APIAccount)
 	for _, t := range am.Tokens {
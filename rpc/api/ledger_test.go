@@ -19,6 +19,8 @@ import (
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/common/vmcontract/mintage"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/ledger/process"
@@ -278,7 +280,7 @@ func TestLedgerAPI_AccountHistoryTopn(t *testing.T) {
 func TestLedgerAPI_AccountInfo(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
-	r, err := ledgerApi.AccountInfo(account1.Address())
+	r, err := ledgerApi.AccountInfo(account1.Address(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -287,6 +289,44 @@ func TestLedgerAPI_AccountInfo(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_AccountInfoAsOf(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	current, err := ledgerApi.AccountInfo(account1.Address(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ledgerApi.AccountInfo(account1.Address(), &APIAsOf{Height: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Address != account1.Address() || r.CoinBalance.Compare(*current.CoinBalance) != types.BalanceCompEqual {
+		t.Fatal(r)
+	}
+
+	header, err := l.GetLatestPovHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := header.GetHash()
+	if rep, err := ledgerApi.AccountRepresentative(account1.Address(), &APIAsOf{Hash: hash}); err != nil || rep != account1.Address() {
+		t.Fatal(rep, err)
+	}
+	if r, err := ledgerApi.AccountsBalance([]types.Address{account1.Address()}, &APIAsOf{Hash: hash}); err != nil || len(r) != 1 {
+		t.Fatal(r, err)
+	}
+	if _, err := ledgerApi.AccountsPending([]types.Address{account1.Address()}, 10, &APIAsOf{Height: 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ledgerApi.AccountInfo(account1.Address(), &APIAsOf{Height: header.GetHeight() + 1}); err == nil {
+		t.Fatal("height higher than latest should fail")
+	}
+	if _, err := ledgerApi.AccountInfo(account1.Address(), &APIAsOf{Hash: mock.Hash()}); err == nil {
+		t.Fatal("unknown pov block should fail")
+	}
+}
+
 func TestLedgerAPI_ConfirmedAccountInfo(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -302,14 +342,14 @@ func TestLedgerAPI_ConfirmedAccountInfo(t *testing.T) {
 func TestLedgerAPI_AccountRepresentative(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
-	r, err := ledgerApi.AccountRepresentative(account1.Address())
+	r, err := ledgerApi.AccountRepresentative(account1.Address(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if r != account1.Address() {
 		t.Fatal()
 	}
-	r, err = ledgerApi.AccountRepresentative(mock.Address())
+	r, err = ledgerApi.AccountRepresentative(mock.Address(), nil)
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -373,7 +413,7 @@ func TestLedgerAPI_AccountsBalance(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
 	addrs := []types.Address{account1.Address(), account2.Address()}
-	r, err := ledgerApi.AccountsBalance(addrs)
+	r, err := ledgerApi.AccountsBalance(addrs, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -422,7 +462,7 @@ func TestLedgerAPI_AccountsPending(t *testing.T) {
 	if err := l.Flush(); err != nil {
 		t.Fatal(err)
 	}
-	r, err := ledgerApi.AccountsPending([]types.Address{sAdd}, 10)
+	r, err := ledgerApi.AccountsPending([]types.Address{sAdd}, 10, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -530,6 +570,20 @@ func TestLedgerAPI_BlockConfirmedStatus(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_BlockConfirmedDepth(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupMockLedgerAPI(t)
+	defer teardownTestCase(t)
+	blk := mock.StateBlockWithoutWork()
+	l.On("HasStateBlockConfirmed", blk.GetHash()).Return(true, nil)
+	r, err := ledgerApi.BlockConfirmedDepth(blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Confirmed || r.Votes != 0 || !r.Weight.IsZero() {
+		t.Fatal(r)
+	}
+}
+
 func TestLedgerAPI_BlockHash(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupMockLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -599,6 +653,56 @@ func TestLedgerAPI_Blocks(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_QueryBlocks(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	l.Flush()
+
+	blocks := make([]*APIBlock, 0)
+	para := &APIBlockQuery{Count: 5}
+	for {
+		r, err := ledgerApi.QueryBlocks(para)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, r.Blocks...)
+		if r.Cursor == "" {
+			break
+		}
+		para.Cursor = r.Cursor
+	}
+	if len(blocks) != 12 {
+		t.Fatalf("invalid len %d", len(blocks))
+	}
+
+	r, err := ledgerApi.QueryBlocks(&APIBlockQuery{Types: []string{"Open"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range r.Blocks {
+		if b.Type != types.Open {
+			t.Fatal("invalid block type", b.Type)
+		}
+	}
+
+	if _, err := ledgerApi.QueryBlocks(&APIBlockQuery{Types: []string{"unknown"}}); err == nil {
+		t.Fatal("block type should be checked")
+	}
+	if _, err := ledgerApi.QueryBlocks(&APIBlockQuery{Cursor: "0 or 1=1"}); err == nil {
+		t.Fatal("cursor should be checked")
+	}
+	if _, err := ledgerApi.QueryBlocks(&APIBlockQuery{Method: "Mintage"}); err == nil {
+		t.Fatal("method name should be used with a chain contract")
+	}
+	link := types.Hash(contractaddress.MintageAddress)
+	if _, err := ledgerApi.QueryBlocks(&APIBlockQuery{Method: mintage.MethodNameMintage, Link: &link}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ledgerApi.QueryBlocks(&APIBlockQuery{Method: "0xa9059cbb"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestLedgerAPI_Chain(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
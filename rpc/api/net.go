@@ -1,7 +1,9 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -20,6 +22,7 @@ type NetApi struct {
 	eb     event.EventBus
 	logger *zap.SugaredLogger
 	cc     *chainctx.ChainContext
+	feb    *event.FeedEventBus
 }
 
 type OnlineRepTotal struct {
@@ -34,7 +37,7 @@ type OnlineRepInfo struct {
 }
 
 func NewNetApi(l ledger.Store, eb event.EventBus, cc *chainctx.ChainContext) *NetApi {
-	return &NetApi{ledger: l, eb: eb, logger: log.NewLogger("api_net"), cc: cc}
+	return &NetApi{ledger: l, eb: eb, logger: log.NewLogger("api_net"), cc: cc, feb: cc.FeedEventBus()}
 }
 
 func (q *NetApi) OnlineRepresentatives() []types.Address {
@@ -152,3 +155,55 @@ func (q *NetApi) GetPeerId() string {
 	cfg, _ := q.cc.Config()
 	return cfg.P2P.ID.PeerID
 }
+
+func (q *NetApi) p2pSyncCall(name string, inArgs map[interface{}]interface{}) (map[interface{}]interface{}, error) {
+	outArgs := make(map[interface{}]interface{})
+	q.feb.RpcSyncCall(&topic.EventRPCSyncCallMsg{Name: name, In: inArgs, Out: outArgs})
+
+	err, ok := outArgs["err"]
+	if !ok {
+		return nil, errors.New("api not support")
+	}
+	if err != nil {
+		return nil, err.(error)
+	}
+	return outArgs, nil
+}
+
+// BannedPeers returns the peers which are banned temporarily
+func (q *NetApi) BannedPeers() ([]*types.PeerBan, error) {
+	outArgs, err := q.p2pSyncCall("P2P.GetBans", make(map[interface{}]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return outArgs["bans"].([]*types.PeerBan), nil
+}
+
+// BanPeer bans the peer for duration seconds and disconnects it, the ban duration of the config is used if duration is 0
+func (q *NetApi) BanPeer(peerID string, duration int64, reason string) error {
+	if peerID == "" {
+		return errors.New("invalid peer id")
+	}
+	if duration < 0 {
+		return errors.New("invalid ban duration")
+	}
+	if duration == 0 {
+		if cfg, err := q.cc.Config(); err == nil && cfg.P2P.PeerScore != nil {
+			duration = cfg.P2P.PeerScore.BanDuration
+		}
+	}
+	inArgs := make(map[interface{}]interface{})
+	inArgs["peerID"] = peerID
+	inArgs["duration"] = time.Duration(duration) * time.Second
+	inArgs["reason"] = reason
+	_, err := q.p2pSyncCall("P2P.BanPeer", inArgs)
+	return err
+}
+
+// UnbanPeer lifts the ban of the peer
+func (q *NetApi) UnbanPeer(peerID string) error {
+	inArgs := make(map[interface{}]interface{})
+	inArgs["peerID"] = peerID
+	_, err := q.p2pSyncCall("P2P.UnbanPeer", inArgs)
+	return err
+}
@@ -261,3 +261,63 @@ func TestNetApi_GetPeerId(t *testing.T) {
 		t.Fatal("get peer id error")
 	}
 }
+
+func TestNetApi_BanPeer(t *testing.T) {
+	teardownTestCase, _, netApi := setupTestCaseNet(t)
+	defer teardownTestCase(t)
+
+	bans := make(map[string]*types.PeerBan)
+	ch := make(chan *topic.EventRPCSyncCallMsg, 10)
+	sub := netApi.feb.Subscribe(topic.EventRpcSyncCall, ch)
+	defer sub.Unsubscribe()
+	go func() {
+		for msg := range ch {
+			in := msg.In.(map[interface{}]interface{})
+			out := msg.Out.(map[interface{}]interface{})
+			switch msg.Name {
+			case "P2P.GetBans":
+				bs := make([]*types.PeerBan, 0)
+				for _, b := range bans {
+					bs = append(bs, b)
+				}
+				out["bans"] = bs
+			case "P2P.BanPeer":
+				id := in["peerID"].(string)
+				d := in["duration"].(time.Duration)
+				bans[id] = &types.PeerBan{PeerID: id, Reason: in["reason"].(string), Until: time.Now().Add(d).Unix()}
+			case "P2P.UnbanPeer":
+				delete(bans, in["peerID"].(string))
+			default:
+				continue
+			}
+			out["err"] = nil
+			msg.ResponseChan <- out
+		}
+	}()
+
+	if err := netApi.BanPeer("", 0, ""); err == nil {
+		t.Fatal("empty peer id should return error")
+	}
+	peerID := "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"
+	if err := netApi.BanPeer(peerID, -1, "manual"); err == nil {
+		t.Fatal("negative duration should return error")
+	}
+	if err := netApi.BanPeer(peerID, 0, "manual"); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := netApi.BannedPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, _ := netApi.cc.Config()
+	if len(bs) != 1 || bs[0].PeerID != peerID || bs[0].Reason != "manual" ||
+		bs[0].Until < time.Now().Unix()+cfg.P2P.PeerScore.BanDuration-10 {
+		t.Fatal("invalid bans", bs)
+	}
+	if err := netApi.UnbanPeer(peerID); err != nil {
+		t.Fatal(err)
+	}
+	if bs, err := netApi.BannedPeers(); err != nil || len(bs) != 0 {
+		t.Fatal(err, bs)
+	}
+}
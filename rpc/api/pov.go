@@ -19,11 +19,16 @@ import (
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/consensus/pov"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/trie"
 )
 
+// ErrPovLightState is returned by the apis which read the whole state trie, a light client keeps
+// only the headers and fetches the state of single accounts from full peers
+var ErrPovLightState = errors.New("local pov state is not available in light mode")
+
 type PovApi struct {
 	cfg    *config.Config
 	l      ledger.Store
@@ -69,6 +74,23 @@ type PovApiState struct {
 	ContractState *types.PovContractState `json:"contractState"`
 }
 
+type PovApiStateProof struct {
+	BlockHash types.Hash       `json:"blockHash"`
+	Height    uint64           `json:"height"`
+	StateHash types.Hash       `json:"stateHash"`
+	Key       types.HexBytes   `json:"key"`
+	Value     types.HexBytes   `json:"value"`
+	Proof     []types.HexBytes `json:"proof"`
+}
+
+type PovApiContractValueProof struct {
+	ContractState *PovApiStateProof `json:"contractState"`
+	StateHash     types.Hash        `json:"stateHash"`
+	Key           types.HexBytes    `json:"key"`
+	Value         types.HexBytes    `json:"value"`
+	Proof         []types.HexBytes  `json:"proof"`
+}
+
 type PovApiDumpState struct {
 	StateHash types.Hash                                `json:"stateHash"`
 	Accounts  map[types.Address]*types.PovAccountState  `json:"accounts"`
@@ -435,6 +457,9 @@ func (api *PovApi) GetTransactionByBlockHeightAndIndex(height uint64, index uint
 }
 
 func (api *PovApi) GetAccountState(address types.Address, stateHash types.Hash) (*PovApiState, error) {
+	if api.isLightMode() {
+		return nil, ErrPovLightState
+	}
 	apiState := &PovApiState{}
 	stateExist := false
 
@@ -471,6 +496,9 @@ func (api *PovApi) GetLatestAccountState(address types.Address) (*PovApiState, e
 		return nil, err
 	}
 
+	if api.isLightMode() {
+		return api.getLightAccountState(address, header.GetHash())
+	}
 	return api.GetAccountState(address, header.GetStateHash())
 }
 
@@ -480,6 +508,9 @@ func (api *PovApi) GetAccountStateByBlockHash(address types.Address, blockHash t
 		return nil, err
 	}
 
+	if api.isLightMode() {
+		return api.getLightAccountState(address, header.GetHash())
+	}
 	return api.GetAccountState(address, header.GetStateHash())
 }
 
@@ -489,10 +520,170 @@ func (api *PovApi) GetAccountStateByBlockHeight(address types.Address, height ui
 		return nil, err
 	}
 
+	if api.isLightMode() {
+		return api.getLightAccountState(address, header.GetHash())
+	}
 	return api.GetAccountState(address, header.GetStateHash())
 }
 
+func (api *PovApi) isLightMode() bool {
+	return api.cfg.PoV.PovEnabled && api.cfg.PoV.LightMode
+}
+
+// getLightState fetches the value of key at the block from a full peer by the light client,
+// the value is verified against the state hash of the local header
+func (api *PovApi) getLightState(blockHash types.Hash, key []byte, subKey []byte) ([]byte, error) {
+	sv, err := api.cc.Service(chainctx.PovService)
+	if err != nil {
+		return nil, err
+	}
+	req := &pov.PovLightStateReq{BlockHash: blockHash, Key: key, SubKey: subKey}
+	rsp := new(pov.PovLightStateRsp)
+	sv.(common.InterceptCall).RpcCall(common.RpcPovLightState, req, rsp)
+	return rsp.Value, rsp.Err
+}
+
+func (api *PovApi) getLightAccountState(address types.Address, blockHash types.Hash) (*PovApiState, error) {
+	apiState := &PovApiState{}
+	stateExist := false
+
+	value, err := api.getLightState(blockHash, statedb.PovCreateAccountStateKey(address), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) > 0 {
+		as := types.NewPovAccountState()
+		if err := as.Deserialize(value); err != nil {
+			return nil, err
+		}
+		stateExist = true
+		apiState.AccountState = as
+	}
+
+	value, err = api.getLightState(blockHash, statedb.PovCreateRepStateKey(address), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) > 0 {
+		rs := types.NewPovRepState()
+		if err := rs.Deserialize(value); err != nil {
+			return nil, err
+		}
+		stateExist = true
+		apiState.RepState = rs
+	}
+
+	value, err = api.getLightState(blockHash, statedb.PovCreateContractStateKey(address), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) > 0 {
+		cs := types.NewPovContractState()
+		if err := cs.Deserialize(value); err != nil {
+			return nil, err
+		}
+		stateExist = true
+		apiState.ContractState = cs
+	}
+
+	if !stateExist {
+		return nil, errors.New("account state value not exist")
+	}
+
+	return apiState, nil
+}
+
+// GetAccountStateProof returns the merkle proof of the account state against the state hash of the block
+func (api *PovApi) GetAccountStateProof(address types.Address, blockHash types.Hash) (*PovApiStateProof, error) {
+	return api.getStateProof(statedb.PovCreateAccountStateKey(address), blockHash)
+}
+
+// GetRepStateProof returns the merkle proof of the rep state against the state hash of the block
+func (api *PovApi) GetRepStateProof(address types.Address, blockHash types.Hash) (*PovApiStateProof, error) {
+	return api.getStateProof(statedb.PovCreateRepStateKey(address), blockHash)
+}
+
+// GetContractValueProof returns the merkle proof of the contract state against the state hash of the block,
+// and the merkle proof of the value against the state hash of the contract
+func (api *PovApi) GetContractValueProof(address types.Address, key types.HexBytes, blockHash types.Hash) (*PovApiContractValueProof, error) {
+	if api.isLightMode() {
+		return nil, ErrPovLightState
+	}
+	csProof, err := api.getStateProof(statedb.PovCreateContractStateKey(address), blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(csProof.Value) == 0 {
+		return nil, errors.New("contract state not exist")
+	}
+
+	cs := types.NewPovContractState()
+	if err := cs.Deserialize(csProof.Value); err != nil {
+		return nil, fmt.Errorf("deserialize contract state err %s", err)
+	}
+	if cs.StateHash.IsZero() {
+		return nil, errors.New("contract state trie not exist")
+	}
+
+	proof, err := trie.NewTrie(api.l.DBStore(), &cs.StateHash, nil).Prove(key)
+	if err != nil {
+		return nil, err
+	}
+	value, err := trie.VerifyProof(cs.StateHash, key, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PovApiContractValueProof{
+		ContractState: csProof,
+		StateHash:     cs.StateHash,
+		Key:           key,
+		Value:         value,
+		Proof:         toHexBytesSlice(proof),
+	}, nil
+}
+
+func (api *PovApi) getStateProof(key []byte, blockHash types.Hash) (*PovApiStateProof, error) {
+	if api.isLightMode() {
+		return nil, ErrPovLightState
+	}
+	header, err := api.l.GetPovHeaderByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	stateHash := header.GetStateHash()
+
+	proof, err := trie.NewTrie(api.l.DBStore(), &stateHash, nil).Prove(key)
+	if err != nil {
+		return nil, err
+	}
+	value, err := trie.VerifyProof(stateHash, key, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PovApiStateProof{
+		BlockHash: header.GetHash(),
+		Height:    header.GetHeight(),
+		StateHash: stateHash,
+		Key:       key,
+		Value:     value,
+		Proof:     toHexBytesSlice(proof),
+	}, nil
+}
+
+func toHexBytesSlice(data [][]byte) []types.HexBytes {
+	result := make([]types.HexBytes, 0, len(data))
+	for _, d := range data {
+		result = append(result, d)
+	}
+	return result
+}
+
 func (api *PovApi) DumpBlockState(blockHash types.Hash) (*PovApiDumpState, error) {
+	if api.isLightMode() {
+		return nil, ErrPovLightState
+	}
 	block, err := api.l.GetPovBlockByHash(blockHash)
 	if err != nil {
 		return nil, err
@@ -569,6 +760,9 @@ func (api *PovApi) DumpBlockState(blockHash types.Hash) (*PovApiDumpState, error
 }
 
 func (api *PovApi) DumpContractState(stateHash types.Hash, address types.Address) (*PovApiContractState, error) {
+	if api.isLightMode() {
+		return nil, ErrPovLightState
+	}
 	gsdb := statedb.NewPovGlobalStateDB(api.l.DBStore(), stateHash)
 	csdb, err := gsdb.LookupContractStateDB(address)
 	if err != nil {
@@ -595,6 +789,9 @@ func (api *PovApi) DumpContractState(stateHash types.Hash, address types.Address
 }
 
 func (api *PovApi) GetAllRepStatesByStateHash(stateHash types.Hash) (*PovApiRepState, error) {
+	if api.isLightMode() {
+		return nil, ErrPovLightState
+	}
 	apiRsp := new(PovApiRepState)
 
 	apiRsp.StateHash = stateHash
@@ -1732,6 +1929,9 @@ type PovApiCheckStateRsp struct {
 }
 
 func (api *PovApi) CheckAllAccountStates() (*PovApiCheckStateRsp, error) {
+	if api.isLightMode() {
+		return nil, ErrPovLightState
+	}
 	apiRsp := new(PovApiCheckStateRsp)
 	apiRsp.AccountStates = make(map[types.Address]*types.PovAccountState)
 	apiRsp.AccountMetas = make(map[types.Address]*types.AccountMeta)
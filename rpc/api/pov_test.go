@@ -11,14 +11,17 @@ import (
 	rpc "github.com/qlcchain/jsonrpc2"
 
 	qctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/event"
 	"github.com/qlcchain/go-qlc/common/statedb"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/consensus/pov"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/trie"
 )
 
 type mockDataTestPovApi struct {
@@ -478,6 +481,160 @@ func TestPovAPI_ManyBlocks(t *testing.T) {
 	_, err = md.api.GetDiffDayStatByHeight(latestHdr.GetHeight())
 }
 
+func TestPovAPI_StateProof(t *testing.T) {
+	tearDone, md := setupTestCasePov(t)
+	defer tearDone(t)
+
+	acc := mock.Address()
+	contract := mock.Address()
+	gsdb := statedb.NewPovGlobalStateDB(md.l.DBStore(), types.ZeroHash)
+	as := types.NewPovAccountState()
+	as.Balance = types.NewBalance(100)
+	_ = gsdb.SetAccountState(acc, as)
+	_ = gsdb.SetContractValue(contract, []byte("key"), []byte("value"))
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := md.l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := md.l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+
+	blk, td := mock.GeneratePovBlock(nil, 0)
+	blk.Header.CbTx.StateHash = gsdb.GetCurHash()
+	blk.Header.CbTx.Hash = blk.Header.CbTx.ComputeHash()
+	blk.Header.BasHdr.Hash = blk.ComputeHash()
+	if err := md.l.AddPovBlock(blk, td); err != nil {
+		t.Fatal(err)
+	}
+
+	asProof, err := md.api.GetAccountStateProof(acc, blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asProof.StateHash != blk.GetStateHash() || len(asProof.Value) == 0 {
+		t.Fatal(asProof)
+	}
+	proof := make([][]byte, 0, len(asProof.Proof))
+	for _, p := range asProof.Proof {
+		proof = append(proof, p)
+	}
+	value, err := trie.VerifyProof(blk.GetStateHash(), statedb.PovCreateAccountStateKey(acc), proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retAs := types.NewPovAccountState()
+	if err := retAs.Deserialize(value); err != nil || retAs.Balance.Compare(as.Balance) != types.BalanceCompEqual {
+		t.Fatal(retAs, err)
+	}
+
+	rsProof, err := md.api.GetRepStateProof(acc, blk.GetHash())
+	if err != nil || len(rsProof.Value) != 0 {
+		t.Fatal(rsProof, err)
+	}
+
+	cvProof, err := md.api.GetContractValueProof(contract, []byte("key"), blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(cvProof.Value) != "value" {
+		t.Fatal(cvProof)
+	}
+	if _, err := md.api.GetContractValueProof(acc, []byte("key"), blk.GetHash()); err == nil {
+		t.Fatal("contract should not exist")
+	}
+}
+
+// mockPovLightService answers the light state requests by the proofs of the local state trie
+type mockPovLightService struct {
+	common.ServiceLifecycle
+	l *ledger.Ledger
+}
+
+func (s *mockPovLightService) Init() error   { return nil }
+func (s *mockPovLightService) Start() error  { return nil }
+func (s *mockPovLightService) Stop() error   { return nil }
+func (s *mockPovLightService) Status() int32 { return s.State() }
+
+func (s *mockPovLightService) RpcCall(kind uint, in, out interface{}) {
+	req := in.(*pov.PovLightStateReq)
+	rsp := out.(*pov.PovLightStateRsp)
+	header, err := s.l.GetPovHeaderByHash(req.BlockHash)
+	if err != nil {
+		rsp.Err = err
+		return
+	}
+	proof, subProof, err := statedb.PovProveState(s.l.DBStore(), header.GetStateHash(), req.Key, req.SubKey)
+	if err != nil {
+		rsp.Err = err
+		return
+	}
+	rsp.Value, rsp.Err = statedb.PovVerifyState(header.GetStateHash(), req.Key, req.SubKey, proof, subProof)
+}
+
+func TestPovAPI_LightMode(t *testing.T) {
+	tearDone, md := setupTestCasePov(t)
+	defer tearDone(t)
+
+	acc := mock.Address()
+	gsdb := statedb.NewPovGlobalStateDB(md.l.DBStore(), types.ZeroHash)
+	as := types.NewPovAccountState()
+	as.Balance = types.NewBalance(100)
+	_ = gsdb.SetAccountState(acc, as)
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := md.l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := md.l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+
+	blk, td := mock.GeneratePovBlock(nil, 0)
+	blk.Header.CbTx.StateHash = gsdb.GetCurHash()
+	blk.Header.CbTx.Hash = blk.Header.CbTx.ComputeHash()
+	blk.Header.BasHdr.Hash = blk.ComputeHash()
+	if err := md.l.AddPovBlock(blk, td); err != nil {
+		t.Fatal(err)
+	}
+
+	md.cfg.PoV.PovEnabled = true
+	md.cfg.PoV.LightMode = true
+	if _, err := md.api.GetAccountStateByBlockHash(acc, blk.GetHash()); err == nil {
+		t.Fatal("state should not be fetched without pov service")
+	}
+	if err := md.cc.Register(qctx.PovService, &mockPovLightService{l: md.l}); err != nil {
+		t.Fatal(err)
+	}
+
+	apiState, err := md.api.GetAccountStateByBlockHash(acc, blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apiState.AccountState == nil || apiState.AccountState.Balance.Compare(as.Balance) != types.BalanceCompEqual ||
+		apiState.RepState != nil || apiState.ContractState != nil {
+		t.Fatal(apiState)
+	}
+	if _, err := md.api.GetAccountStateByBlockHash(mock.Address(), blk.GetHash()); err == nil {
+		t.Fatal("account state should not exist")
+	}
+
+	if _, err := md.api.GetAccountState(acc, blk.GetStateHash()); err != ErrPovLightState {
+		t.Fatal(err)
+	}
+	if _, err := md.api.GetAccountStateProof(acc, blk.GetHash()); err != ErrPovLightState {
+		t.Fatal(err)
+	}
+	if _, err := md.api.GetAllRepStatesByBlockHash(blk.GetHash()); err != ErrPovLightState {
+		t.Fatal(err)
+	}
+}
+
 func TestPovAPI_PubSub_NewBlock(t *testing.T) {
 	tearDone, md := setupTestCasePov(t)
 	defer tearDone(t)
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -54,6 +56,13 @@ type RepHistoryRewardInfo struct {
 	LastRewardTime int64         `json:"lastRewardTime"`
 }
 
+// RepEquivocation is the evidence of a rep voting for two forked blocks, the votes of the rep are excluded
+// from the tally until ExcludedUntil
+type RepEquivocation struct {
+	*types.Equivocation
+	ExcludedUntil int64 `json:"excludedUntil"`
+}
+
 func NewRepApi(cfg *config.Config, ledger ledger.Store) *RepApi {
 	return &RepApi{
 		cfg:    cfg,
@@ -310,3 +319,31 @@ func (r *RepApi) GetRewardHistory(account types.Address) (*RepHistoryRewardInfo,
 
 	return history, nil
 }
+
+// GetEquivocations returns the equivocation evidence of the account, or of all reps if account is nil,
+// the latest evidence first
+func (r *RepApi) GetEquivocations(account *types.Address) ([]*RepEquivocation, error) {
+	es := make([]*RepEquivocation, 0)
+	fn := func(e *types.Equivocation) error {
+		es = append(es, &RepEquivocation{
+			Equivocation:  e,
+			ExcludedUntil: time.Unix(e.Timestamp, 0).Add(r.cfg.EquivocationExclusionTime()).Unix(),
+		})
+		return nil
+	}
+
+	var err error
+	if account != nil {
+		err = r.ledger.GetEquivocationsByAccount(*account, fn)
+	} else {
+		err = r.ledger.GetEquivocations(fn)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(es, func(i, j int) bool {
+		return es[i].Timestamp > es[j].Timestamp
+	})
+	return es, nil
+}
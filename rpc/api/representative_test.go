@@ -263,3 +263,57 @@ func TestRepApi_GetRewardHistory(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestRepApi_GetEquivocations(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	cc := chainctx.NewChainContext(cfgFile)
+	cfg, _ := cc.Config()
+	r := NewRepApi(cfg, l)
+
+	account := mock.Address()
+	for i := 0; i < 3; i++ {
+		addr := account
+		if i == 2 {
+			addr = mock.Address()
+		}
+		blk1 := mock.StateBlockWithoutWork()
+		blk2 := blk1.Clone()
+		blk2.Balance = blk2.Balance.Add(types.NewBalance(1))
+		e := &types.Equivocation{
+			Account:   addr,
+			Root:      types.VoteRoot(blk1),
+			First:     &types.SignedVote{Hashes: []types.Hash{blk1.GetHash()}, Block: blk1},
+			Second:    &types.SignedVote{Hashes: []types.Hash{blk2.GetHash()}, Block: blk2},
+			Timestamp: int64(100 + i),
+		}
+		if err := l.AddEquivocation(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	es, err := r.GetEquivocations(nil)
+	if err != nil || len(es) != 3 {
+		t.Fatal(err, len(es))
+	}
+	if es[0].Timestamp != 102 || es[2].Timestamp != 100 {
+		t.Fatal("evidence should be sorted by time")
+	}
+	if es[0].ExcludedUntil != 102+int64(cfg.EquivocationExclusionTime().Seconds()) {
+		t.Fatal("invalid exclusion", es[0].ExcludedUntil)
+	}
+
+	es, err = r.GetEquivocations(&account)
+	if err != nil || len(es) != 2 {
+		t.Fatal(err, len(es))
+	}
+	for _, e := range es {
+		if e.Account != account {
+			t.Fatal("invalid account", e.Account)
+		}
+	}
+}
@@ -49,6 +49,11 @@ type SettlementAPI struct {
 	updateNextStop    *contract.UpdateNextStop
 	terminateContract *contract.TerminateContract
 	registerAsset     *contract.RegisterAsset
+	updateRateCard    *contract.UpdateRateCard
+	openDispute       *contract.OpenDispute
+	addEvidence       *contract.AddDisputeEvidence
+	proposeResolution *contract.ProposeDisputeResolution
+	signResolution    *contract.SignDisputeResolution
 	cc                *context.ChainContext
 }
 
@@ -60,19 +65,24 @@ type SignContractParam struct {
 
 func NewSettlement(l ledger.Store, cc *context.ChainContext) *SettlementAPI {
 	return &SettlementAPI{
-		logger:         log.NewLogger("rpc/settlement"),
-		l:              l,
-		createContract: &contract.CreateContract{},
-		signContract:   &contract.SignContract{},
-		cdrContract:    &contract.ProcessCDR{},
-		addPreStop:     &contract.AddPreStop{},
-		removePreStop:  &contract.RemovePreStop{},
-		updatePreStop:  &contract.UpdatePreStop{},
-		addNextStop:    &contract.AddNextStop{},
-		removeNextStop: &contract.RemoveNextStop{},
-		updateNextStop: &contract.UpdateNextStop{},
-		registerAsset:  &contract.RegisterAsset{},
-		cc:             cc,
+		logger:            log.NewLogger("rpc/settlement"),
+		l:                 l,
+		createContract:    &contract.CreateContract{},
+		signContract:      &contract.SignContract{},
+		cdrContract:       &contract.ProcessCDR{},
+		addPreStop:        &contract.AddPreStop{},
+		removePreStop:     &contract.RemovePreStop{},
+		updatePreStop:     &contract.UpdatePreStop{},
+		addNextStop:       &contract.AddNextStop{},
+		removeNextStop:    &contract.RemoveNextStop{},
+		updateNextStop:    &contract.UpdateNextStop{},
+		registerAsset:     &contract.RegisterAsset{},
+		updateRateCard:    &contract.UpdateRateCard{},
+		openDispute:       &contract.OpenDispute{},
+		addEvidence:       &contract.AddDisputeEvidence{},
+		proposeResolution: &contract.ProposeDisputeResolution{},
+		signResolution:    &contract.SignDisputeResolution{},
+		cc:                cc,
 	}
 }
 
@@ -129,6 +139,7 @@ type CreateContractParam struct {
 	Services  []cabi.ContractService `json:"services"`
 	StartDate int64                  `json:"startDate"`
 	EndDate   int64                  `json:"endDate"`
+	RateCards []*cabi.RateCard       `json:"rateCards,omitempty"`
 }
 
 // GetCreateContractBlock
@@ -169,6 +180,7 @@ func (s *SettlementAPI) GetCreateContractBlock(param *CreateContractParam) (*typ
 			SignDate:  now,
 			StartDate: param.StartDate,
 			EndDate:   param.EndDate,
+			RateCards: param.RateCards,
 		}
 		if isVerified, err := createParam.Verify(); err != nil {
 			return nil, err
@@ -443,6 +455,99 @@ func (s *SettlementAPI) GetUpdateNextStopBlock(param *UpdateStopParam) (*types.S
 	}, s.updateNextStop)
 }
 
+type RateCardParam struct {
+	cabi.RateCardParam
+	Address types.Address `json:"address"`
+}
+
+// GetUpdateRateCardBlock generate ContractSend block to add a new version of the rate card of the settlement contract,
+// only PartyA can update it and the new prices are in force from the effective date
+// @param param rate card param
+// @return state block to be processed
+func (s *SettlementAPI) GetUpdateRateCardBlock(param *RateCardParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, errInvalidParam
+	}
+	return s.handleStopAction(param.Address, func() error {
+		return param.RateCardParam.Verify()
+	}, func() (bytes []byte, err error) {
+		return param.RateCardParam.ToABI()
+	}, s.updateRateCard)
+}
+
+// GetRateCards get all versions of the rate card of the settlement contract
+// @param addr settlement contract address
+// @return rate cards in ascending order of effective date
+func (s *SettlementAPI) GetRateCards(addr *types.Address) ([]*cabi.RateCard, error) {
+	ctx := vmstore.NewVMContext(s.l, &contractaddress.SettlementAddress)
+	c, err := cabi.GetSettlementContract(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetRateCards(), nil
+}
+
+type DisputeParam struct {
+	cabi.DisputeParam
+	Address types.Address `json:"address"`
+}
+
+// GetOpenDisputeBlock generate ContractSend block to open a dispute on the CDRs of the settlement contract,
+// CDRs of the unresolved dispute are not charged in the invoices
+// @param param dispute param with contract address, CDR hashes and reason
+// @return state block to be processed
+func (s *SettlementAPI) GetOpenDisputeBlock(param *DisputeParam) (*types.StateBlock, error) {
+	return s.handleDisputeAction(param, cabi.MethodNameOpenDispute, s.openDispute)
+}
+
+// GetAddDisputeEvidenceBlock generate ContractSend block to attach the evidence to the dispute
+// @param param dispute param with contract address, dispute id and evidence
+// @return state block to be processed
+func (s *SettlementAPI) GetAddDisputeEvidenceBlock(param *DisputeParam) (*types.StateBlock, error) {
+	return s.handleDisputeAction(param, cabi.MethodNameAddDisputeEvidence, s.addEvidence)
+}
+
+// GetProposeDisputeResolutionBlock generate ContractSend block to propose the resolution of the dispute,
+// which replaces the previous proposal and is signed by the proposer
+// @param param dispute param with contract address, dispute id, resolution and split rate
+// @return state block to be processed
+func (s *SettlementAPI) GetProposeDisputeResolutionBlock(param *DisputeParam) (*types.StateBlock, error) {
+	return s.handleDisputeAction(param, cabi.MethodNameProposeDisputeResolution, s.proposeResolution)
+}
+
+// GetSignDisputeResolutionBlock generate ContractSend block to sign off the proposal of the dispute,
+// the dispute is resolved when both parties have signed
+// @param param dispute param with contract address and dispute id
+// @return state block to be processed
+func (s *SettlementAPI) GetSignDisputeResolutionBlock(param *DisputeParam) (*types.StateBlock, error) {
+	return s.handleDisputeAction(param, cabi.MethodNameSignDisputeResolution, s.signResolution)
+}
+
+func (s *SettlementAPI) handleDisputeAction(param *DisputeParam, methodName string, c contract.Contract) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, errInvalidParam
+	}
+	return s.handleStopAction(param.Address, func() error {
+		return param.DisputeParam.Verify(methodName)
+	}, func() (bytes []byte, err error) {
+		return param.DisputeParam.ToABI(methodName)
+	}, c)
+}
+
+// GetDisputes get all disputes of the settlement contract
+// @param addr settlement contract address
+func (s *SettlementAPI) GetDisputes(addr *types.Address) ([]*cabi.Dispute, error) {
+	return cabi.GetDisputes(s.l, addr)
+}
+
+// GetDispute get the dispute of the settlement contract by id
+// @param addr settlement contract address
+// @param id dispute id
+func (s *SettlementAPI) GetDispute(addr *types.Address, id types.Hash) (*cabi.Dispute, error) {
+	ctx := vmstore.NewVMContext(s.l, &contractaddress.SettlementAddress)
+	return cabi.GetDispute(ctx, addr, id)
+}
+
 // SettlementContract settlement contract for RPC
 type SettlementContract struct {
 	cabi.ContractParam
@@ -919,6 +1024,15 @@ func (s *SettlementAPI) GenerateMultiPartySummaryReport(firstAddr, secondAddr *t
 	return cabi.GetMultiPartySummaryReport(s.l, firstAddr, secondAddr, start, end)
 }
 
+// GetSLAReport report the SLA attainment of the asset of the settlement contract and the matched compensations
+// @param addr settlement contract address
+// @param start report start date (UTC unix time)
+// @param end report end data (UTC unix time)
+// @return SLA report
+func (s *SettlementAPI) GetSLAReport(addr *types.Address, start, end int64) (*cabi.SLAReport, error) {
+	return cabi.GetSLAReport(s.l, addr, start, end)
+}
+
 // GetPreStopNames get all previous stop names by user address
 func (s *SettlementAPI) GetPreStopNames(addr *types.Address) ([]string, error) {
 	ctx := vmstore.NewVMContext(s.l, &contractaddress.SettlementAddress)
@@ -931,7 +1045,7 @@ func (s *SettlementAPI) GetNextStopNames(addr *types.Address) ([]string, error)
 	return cabi.GetNextStopNames(ctx, addr)
 }
 
-//RegisterAssetParam asset registration  param
+// RegisterAssetParam asset registration  param
 type RegisterAssetParam struct {
 	Owner     cabi.Contractor `json:"owner"`
 	Assets    []*cabi.Asset   `json:"assets"`
@@ -946,6 +1060,12 @@ func (r *RegisterAssetParam) ToAssetParam() (*cabi.AssetParam, error) {
 	if err != nil {
 		return nil, err
 	}
+	// latencies of the RPC param are in seconds
+	for _, asset := range r.Assets {
+		for _, sla := range asset.SLAs {
+			sla.Version = cabi.SLAVersion
+		}
+	}
 	return &cabi.AssetParam{
 		Owner:     r.Owner,
 		Assets:    r.Assets,
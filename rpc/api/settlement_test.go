@@ -477,6 +477,22 @@ func TestSettlementAPI_Integration(t *testing.T) {
 			} else {
 				t.Log(invoices)
 			}
+			// no asset with SLA is registered for the contract
+			if _, err := api.GetSLAReport(&contractAddr1, 0, 0); err != cabi.ErrNoSLAAsset {
+				t.Fatal(err)
+			}
+			if disputes, err := api.GetDisputes(&contractAddr1); err != nil || len(disputes) != 0 {
+				t.Fatal(err, disputes)
+			}
+			if _, err := api.GetDispute(&contractAddr1, mock.Hash()); err == nil {
+				t.Fatal("dispute should not exist")
+			}
+			if _, err := api.GetOpenDisputeBlock(&DisputeParam{
+				DisputeParam: cabi.DisputeParam{ContractAddress: contractAddr1},
+				Address:      pccwAddr,
+			}); err == nil {
+				t.Fatal("dispute without CDRs should be invalid")
+			}
 
 			if report, err := api.GetSummaryReportByCustomer(&contractAddr1, customer, 0, 0); err != nil {
 				t.Fatal(err)
@@ -1055,6 +1071,10 @@ func TestRegisterAssetParam_ToAssetParam(t *testing.T) {
 		if err := param.Verify(); err != nil {
 			t.Fatal(err)
 		}
+		// latencies of the RPC param are in seconds
+		if sla := param.Assets[0].SLAs[0]; sla.Version != cabi.SLAVersion {
+			t.Fatal(sla)
+		}
 	}
 }
 
@@ -87,6 +87,13 @@ func (r *RPC) getApi(apiModule string) rpc.API {
 			Service:   api.NewConfigApi(r.cfgFile),
 			Public:    true,
 		}
+	case "autoReceive":
+		return rpc.API{
+			Namespace: "autoReceive",
+			Version:   "1.0",
+			Service:   api.NewAutoReceiveApi(r.cfgFile),
+			Public:    true,
+		}
 	case "rep":
 		return rpc.API{
 			Namespace: "rep",
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package auth authenticates RPC requests by API keys or JWT, and authorizes methods by the permissions of roles.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+var (
+	ErrNoCredential      = errors.New("authentication required")
+	ErrInvalidCredential = errors.New("invalid credential")
+	ErrForbidden         = errors.New("permission denied")
+)
+
+// Claims is the claims of JWT accepted by Authenticator
+type Claims struct {
+	Role string `json:"role"`
+	jwt.StandardClaims
+}
+
+type role struct {
+	allow []string
+	deny  []string
+}
+
+type Authenticator struct {
+	secret    []byte
+	anonymous string
+	keys      map[string]string
+	roles     map[string]*role
+}
+
+// NewAuthenticator creates Authenticator from the rpc auth config, it returns nil if authentication is disabled,
+// the admin token of manager is accepted as an API key of admin
+func NewAuthenticator(cfg *config.Config) (*Authenticator, error) {
+	if cfg.RPC == nil || cfg.RPC.Auth == nil || !cfg.RPC.Auth.Enable {
+		return nil, nil
+	}
+	c := cfg.RPC.Auth
+
+	a := &Authenticator{
+		secret:    []byte(c.JWTSecret),
+		anonymous: c.AnonymousRole,
+		keys:      make(map[string]string),
+		roles:     make(map[string]*role),
+	}
+	permissions := config.DefaultRolePermissions()
+	for name, p := range c.Roles {
+		if p == nil {
+			delete(permissions, name)
+			continue
+		}
+		permissions[name] = p
+	}
+	for name, p := range permissions {
+		allow, err := lowerPatterns(p.Allow)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed methods of role %s: %s", name, err)
+		}
+		deny, err := lowerPatterns(p.Deny)
+		if err != nil {
+			return nil, fmt.Errorf("invalid denied methods of role %s: %s", name, err)
+		}
+		a.roles[name] = &role{allow: allow, deny: deny}
+	}
+
+	if a.anonymous != "" {
+		if _, ok := a.roles[a.anonymous]; !ok {
+			return nil, fmt.Errorf("undefined anonymous role %s", a.anonymous)
+		}
+	}
+	for key, name := range c.APIKeys {
+		if key == "" {
+			return nil, errors.New("empty API key")
+		}
+		if _, ok := a.roles[name]; !ok {
+			return nil, fmt.Errorf("undefined role %s of API key", name)
+		}
+		a.keys[key] = name
+	}
+	if cfg.Manager != nil && cfg.Manager.AdminToken != "" {
+		if _, ok := a.roles[config.RoleAdmin]; ok {
+			a.keys[cfg.Manager.AdminToken] = config.RoleAdmin
+		}
+	}
+	return a, nil
+}
+
+// lowerPatterns checks method patterns and converts them to lower case, methods are matched case-insensitively
+func lowerPatterns(patterns []string) ([]string, error) {
+	r := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		p = strings.ToLower(p)
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("%q, %s", p, err)
+		}
+		r = append(r, p)
+	}
+	return r, nil
+}
+
+// Authenticate returns the role of credential, empty credential gets the anonymous role
+func (a *Authenticator) Authenticate(credential string) (string, error) {
+	if credential == "" {
+		if a.anonymous == "" {
+			return "", ErrNoCredential
+		}
+		return a.anonymous, nil
+	}
+	if r, ok := a.keys[credential]; ok {
+		return r, nil
+	}
+	if len(a.secret) == 0 || strings.Count(credential, ".") != 2 {
+		return "", ErrInvalidCredential
+	}
+
+	claims := new(Claims)
+	token, err := jwt.ParseWithClaims(credential, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return a.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return "", ErrInvalidCredential
+	}
+	if _, ok := a.roles[claims.Role]; !ok {
+		return "", ErrInvalidCredential
+	}
+	return claims.Role, nil
+}
+
+// Allowed checks if role has permission to call method, method is like `ledger_accountInfo`
+func (a *Authenticator) Allowed(roleName, method string) bool {
+	r, ok := a.roles[roleName]
+	if !ok {
+		return false
+	}
+	method = strings.ToLower(method)
+	for _, p := range r.deny {
+		if ok, _ := path.Match(p, method); ok {
+			return false
+		}
+	}
+	for _, p := range r.allow {
+		if ok, _ := path.Match(p, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize authenticates credential and checks the permission of method
+func (a *Authenticator) Authorize(credential, method string) error {
+	r, err := a.Authenticate(credential)
+	if err != nil {
+		return err
+	}
+	if !a.Allowed(r, method) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// NewToken signs a JWT of role, which expires after duration, zero duration means never expires
+func NewToken(secret string, role string, duration time.Duration) (string, error) {
+	claims := Claims{Role: role}
+	if duration > 0 {
+		claims.ExpiresAt = time.Now().Add(duration).Unix()
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+}
+
+// credential extracts the credential from the value of `Authorization` header
+func credential(authorization string) string {
+	const prefix = "bearer "
+	if len(authorization) > len(prefix) && strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return strings.TrimSpace(authorization[len(prefix):])
+	}
+	return strings.TrimSpace(authorization)
+}
@@ -0,0 +1,302 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	rpc "github.com/qlcchain/jsonrpc2"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+const (
+	testSecret   = "98a7d8e5d2a64c1bb0b0bb5e3c7f7a14"
+	testAdmin    = "admin-token"
+	walletKey    = "wallet-key"
+	readOnlyKey  = "readonly-key"
+	customRole   = "partner"
+	customKey    = "partner-key"
+	unknownToken = "unknown-key"
+)
+
+func testConfig() *config.Config {
+	cfg, _ := config.DefaultConfig(config.QlcTestDataDir())
+	cfg.Manager.AdminToken = testAdmin
+	cfg.RPC.Auth = &config.RPCAuthConfig{
+		Enable:    true,
+		JWTSecret: testSecret,
+		APIKeys: map[string]string{
+			walletKey:   config.RoleWallet,
+			readOnlyKey: config.RoleReadOnly,
+			customKey:   customRole,
+		},
+		Roles: map[string]*config.RolePermission{
+			customRole: {Allow: []string{"ledger_*", "pov_getLatest*"}, Deny: []string{"ledger_process"}},
+		},
+	}
+	return cfg
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	cfg := testConfig()
+	cfg.RPC.Auth.Enable = false
+	if a, err := NewAuthenticator(cfg); err != nil || a != nil {
+		t.Fatal("authenticator should be nil if auth is disabled", err)
+	}
+
+	cfg = testConfig()
+	cfg.RPC.Auth.APIKeys["key"] = "undefined"
+	if _, err := NewAuthenticator(cfg); err == nil {
+		t.Fatal("role of API key should be defined")
+	}
+
+	cfg = testConfig()
+	cfg.RPC.Auth.AnonymousRole = "undefined"
+	if _, err := NewAuthenticator(cfg); err == nil {
+		t.Fatal("anonymous role should be defined")
+	}
+
+	cfg = testConfig()
+	cfg.RPC.Auth.Roles[customRole].Allow = []string{"ledger_["}
+	if _, err := NewAuthenticator(cfg); err == nil {
+		t.Fatal("malformed pattern should be rejected")
+	}
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	a, err := NewAuthenticator(testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := NewToken(testSecret, config.RoleMiner, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+		Role:           config.RoleMiner,
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+	}).SignedString([]byte(testSecret))
+	none, _ := jwt.NewWithClaims(jwt.SigningMethodNone, Claims{Role: config.RoleAdmin}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	forged, _ := NewToken("another secret", config.RoleAdmin, time.Minute)
+	undefined, _ := NewToken(testSecret, "undefined", 0)
+
+	tests := []struct {
+		credential string
+		role       string
+		err        error
+	}{
+		{walletKey, config.RoleWallet, nil},
+		{testAdmin, config.RoleAdmin, nil},
+		{customKey, customRole, nil},
+		{token, config.RoleMiner, nil},
+		{"", "", ErrNoCredential},
+		{unknownToken, "", ErrInvalidCredential},
+		{forged, "", ErrInvalidCredential},
+		{expired, "", ErrInvalidCredential},
+		{none, "", ErrInvalidCredential},
+		{undefined, "", ErrInvalidCredential},
+	}
+	for _, tt := range tests {
+		r, err := a.Authenticate(tt.credential)
+		if r != tt.role || err != tt.err {
+			t.Fatalf("authenticate %s: %s, %v, want %s, %v", tt.credential, r, err, tt.role, tt.err)
+		}
+	}
+
+	cfg := testConfig()
+	cfg.RPC.Auth.AnonymousRole = config.RoleReadOnly
+	cfg.RPC.Auth.JWTSecret = ""
+	a, _ = NewAuthenticator(cfg)
+	if r, err := a.Authenticate(""); err != nil || r != config.RoleReadOnly {
+		t.Fatal("request without credential should get the anonymous role", r, err)
+	}
+	if _, err := a.Authenticate(token); err != ErrInvalidCredential {
+		t.Fatal("JWT should be rejected without secret", err)
+	}
+}
+
+func TestAuthenticator_Allowed(t *testing.T) {
+	a, err := NewAuthenticator(testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		role    string
+		method  string
+		allowed bool
+	}{
+		{config.RoleReadOnly, "ledger_accountInfo", true},
+		{config.RoleReadOnly, "ledger_process", false},
+		{config.RoleReadOnly, "ledger_generateSendBlock", false},
+		{config.RoleReadOnly, "wallet_getBalances", false},
+		{config.RoleReadOnly, "debug_blockCaches", false},
+		{config.RoleReadOnly, "pov_getWork", false},
+		{config.RoleWallet, "ledger_process", true},
+		{config.RoleWallet, "wallet_getBalances", true},
+		{config.RoleWallet, "pov_submitWork", false},
+		{config.RoleWallet, "config_update", false},
+		{config.RoleMiner, "pov_getWork", true},
+		{config.RoleMiner, "POV_SUBMITWORK", true},
+		{config.RoleMiner, "account_create", false},
+		{config.RoleAdmin, "debug_blockCaches", true},
+		{config.RoleAdmin, "config_update", true},
+		{config.RoleReadOnly, "net_bannedPeers", true},
+		{config.RoleReadOnly, "net_banPeer", false},
+		{config.RoleWallet, "net_unbanPeer", false},
+		{config.RoleMiner, "net_banPeer", false},
+		{config.RoleAdmin, "net_banPeer", true},
+		{config.RoleAdmin, "net_unbanPeer", true},
+		{customRole, "ledger_accountInfo", true},
+		{customRole, "ledger_process", false},
+		{customRole, "pov_getLatestHeader", true},
+		{customRole, "pov_getHeaderByHeight", false},
+		{"undefined", "ledger_accountInfo", false},
+	}
+	for _, tt := range tests {
+		if got := a.Allowed(tt.role, tt.method); got != tt.allowed {
+			t.Fatalf("%s %s: %t, want %t", tt.role, tt.method, got, tt.allowed)
+		}
+	}
+}
+
+func TestGRPCMethod(t *testing.T) {
+	tests := map[string]string{
+		"/proto.LedgerAPI/AccountInfo":           "ledger_accountinfo",
+		"/proto.NEP5PledgeAPI/GetPledgeInfo":     "pledge_getpledgeinfo",
+		"/proto.BlackHoleAPI/GetSendBlock":       "destroy_getsendblock",
+		"/proto.PublicKeyDistributionAPI/GetAll": "dpki_getall",
+		"/proto.PovAPI/GetWork":                  "pov_getwork",
+	}
+	for fullMethod, want := range tests {
+		if got := GRPCMethod(fullMethod); got != want {
+			t.Fatalf("%s: %s, want %s", fullMethod, got, want)
+		}
+	}
+}
+
+type testService struct{}
+
+func (s *testService) Info() string {
+	return "info"
+}
+
+func (s *testService) GenerateSendBlock() string {
+	return "block"
+}
+
+func newTestServer(t *testing.T) *rpc.Server {
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("ledger", new(testService)); err != nil {
+		t.Fatal(err)
+	}
+	return srv
+}
+
+func httpCall(t *testing.T, url string, key string, body string) (int, http.Header, string) {
+	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	buf := new(bytes.Buffer)
+	_, _ = buf.ReadFrom(resp.Body)
+	return resp.StatusCode, resp.Header, buf.String()
+}
+
+func TestGuard_HTTPHandler(t *testing.T) {
+	g, err := NewGuard(testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newTestServer(t)
+	defer srv.Stop()
+	ts := httptest.NewServer(g.HTTPHandler(srv))
+	defer ts.Close()
+
+	call := func(key string, body string) (int, string) {
+		code, _, resp := httpCall(t, ts.URL, key, body)
+		return code, resp
+	}
+
+	info := `{"jsonrpc":"2.0","id":1,"method":"ledger_info","params":[]}`
+	generate := `{"jsonrpc":"2.0","id":2,"method":"ledger_generateSendBlock","params":[]}`
+
+	if code, _ := call("", info); code != http.StatusUnauthorized {
+		t.Fatal("request without credential should be rejected", code)
+	}
+	if code, _ := call(unknownToken, info); code != http.StatusUnauthorized {
+		t.Fatal("request with invalid credential should be rejected", code)
+	}
+	if code, body := call(readOnlyKey, info); code != http.StatusOK || !strings.Contains(body, `"result":"info"`) {
+		t.Fatal("read only call should be allowed", code, body)
+	}
+	if code, body := call(readOnlyKey, generate); code != http.StatusForbidden || !strings.Contains(body, `"id":2`) {
+		t.Fatal("wallet call should be denied", code, body)
+	}
+	if code, _ := call(readOnlyKey, "["+info+","+generate+"]"); code != http.StatusForbidden {
+		t.Fatal("batch with denied call should be denied", code)
+	}
+	if code, body := call(walletKey, "["+info+","+generate+"]"); code != http.StatusOK || !strings.Contains(body, `"result":"block"`) {
+		t.Fatal("batch should be allowed", code, body)
+	}
+}
+
+func TestGuard_WebsocketHandler(t *testing.T) {
+	g, err := NewGuard(testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newTestServer(t)
+	defer srv.Stop()
+	ts := httptest.NewServer(g.WebsocketHandler(srv, []string{"*"}))
+	defer ts.Close()
+	endpoint := "ws" + strings.TrimPrefix(ts.URL, "http")
+
+	if c, err := rpc.DialWebsocket(context.Background(), endpoint, ""); err == nil {
+		c.Close()
+		t.Fatal("connection without credential should be rejected")
+	}
+
+	c, err := rpc.DialWebsocket(context.Background(), endpoint+"?token="+readOnlyKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	var r string
+	if err := c.Call(&r, "ledger_info"); err != nil || r != "info" {
+		t.Fatal("read only call should be allowed", r, err)
+	}
+	err = c.Call(&r, "ledger_generateSendBlock")
+	if err == nil {
+		t.Fatal("wallet call should be denied")
+	}
+	if e, ok := err.(rpc.Error); !ok || e.ErrorCode() != errCodeForbidden {
+		d, _ := json.Marshal(err)
+		t.Fatal("invalid error", err, string(d))
+	}
+	// connection is still available after denied calls
+	if err := c.Call(&r, "ledger_info"); err != nil || r != "info" {
+		t.Fatal(r, err)
+	}
+}
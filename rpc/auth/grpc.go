@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC services whose names are different from the namespaces of JSON-RPC
+var grpcNamespaces = map[string]string{
+	"nep5pledge":            "pledge",
+	"blackhole":             "destroy",
+	"publickeydistribution": "dpki",
+}
+
+// GRPCMethod converts the full method of gRPC to the method of JSON-RPC,
+// e.g. `/proto.LedgerAPI/AccountInfo` to `ledger_accountinfo`
+func GRPCMethod(fullMethod string) string {
+	fullMethod = strings.ToLower(strings.TrimPrefix(fullMethod, "/"))
+	i := strings.LastIndex(fullMethod, "/")
+	if i < 0 {
+		return fullMethod
+	}
+	service, method := fullMethod[:i], fullMethod[i+1:]
+	if j := strings.LastIndex(service, "."); j >= 0 {
+		service = service[j+1:]
+	}
+	service = strings.TrimSuffix(service, "api")
+	if ns, ok := grpcNamespaces[service]; ok {
+		service = ns
+	}
+	return service + "_" + method
+}
+
+// grpcClientIP returns the ip of peer, requests forwarded by the local gateway use the ip of `x-forwarded-for`
+func grpcClientIP(ctx context.Context, md metadata.MD) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	ip := remoteIP(p.Addr.String())
+	if addr := net.ParseIP(ip); addr != nil && addr.IsLoopback() {
+		if vs := md.Get("x-forwarded-for"); len(vs) > 0 {
+			return strings.TrimSpace(strings.Split(vs[0], ",")[0])
+		}
+	}
+	return ip
+}
+
+func (g *Guard) checkGRPC(ctx context.Context, fullMethod string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var c string
+	if vs := md.Get("authorization"); len(vs) > 0 {
+		c = credential(vs[0])
+	}
+	role, client, err := g.identify(c, grpcClientIP(ctx, md))
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	err = g.check(role, client, GRPCMethod(fullMethod))
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*RateLimitedError); ok {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.Error(codes.PermissionDenied, err.Error())
+}
+
+// UnaryServerInterceptor guards unary calls, the credential is sent by the metadata `authorization`
+func (g *Guard) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := g.checkGRPC(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor guards streaming calls, the credential is sent by the metadata `authorization`
+func (g *Guard) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := g.checkGRPC(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// ServerOptions returns the options of gRPC server besides interceptors
+func (g *Guard) ServerOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if max := g.maxResponseSize(); max > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(max))
+	}
+	return opts
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"github.com/qlcchain/go-qlc/config"
+)
+
+// Guard authenticates, authorizes and rate limits requests of http/websocket/gRPC endpoints
+type Guard struct {
+	auth    *Authenticator
+	limiter *Limiter
+}
+
+// NewGuard creates Guard from the rpc auth and rate limit config, it returns nil if both are disabled
+func NewGuard(cfg *config.Config) (*Guard, error) {
+	a, err := NewAuthenticator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	l, err := NewLimiter(cfg.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil && l == nil {
+		return nil, nil
+	}
+	return &Guard{auth: a, limiter: l}, nil
+}
+
+// identify authenticates credential, and returns the role and the id of client for rate limit,
+// the credential is the id of client if it is valid, otherwise the ip
+func (g *Guard) identify(credential, ip string) (string, string, error) {
+	if g.auth == nil {
+		return "", ip, nil
+	}
+	role, err := g.auth.Authenticate(credential)
+	if err != nil {
+		return "", "", err
+	}
+	if credential != "" {
+		return role, "key:" + credential, nil
+	}
+	return role, ip, nil
+}
+
+// check authorizes and rate limits a call of method
+func (g *Guard) check(role, client, method string) error {
+	if g.auth != nil && !g.auth.Allowed(role, method) {
+		return ErrForbidden
+	}
+	if g.limiter != nil {
+		return g.limiter.Allow(client, method)
+	}
+	return nil
+}
+
+func (g *Guard) maxResponseSize() int {
+	if g.limiter == nil {
+		return 0
+	}
+	return g.limiter.maxResponseSize
+}
+
+func (g *Guard) maxSubscriptions() int {
+	if g.limiter == nil {
+		return 0
+	}
+	return g.limiter.maxSubscriptions
+}
@@ -0,0 +1,397 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// same as the limit of jsonrpc2
+	maxRequestContentLength = 10 << 20
+
+	errCodeUnauthorized     = -32001
+	errCodeForbidden        = -32003
+	errCodeLimitExceeded    = -32005
+	errCodeResponseTooLarge = -32006
+)
+
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
+
+type jsonrpcCall struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+type jsonError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type jsonErrResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonError       `json:"error"`
+}
+
+// rateLimitedData is the data of rate limited error, RetryAfter is in milliseconds
+type rateLimitedData struct {
+	Class      string `json:"class"`
+	RetryAfter int64  `json:"retryAfter"`
+	Quota      bool   `json:"quota,omitempty"`
+}
+
+func errResponse(id json.RawMessage, err error) *jsonErrResponse {
+	e := jsonError{Code: errCodeUnauthorized, Message: err.Error()}
+	if le, ok := err.(*RateLimitedError); ok {
+		e.Code = errCodeLimitExceeded
+		e.Data = &rateLimitedData{Class: le.Class, RetryAfter: le.RetryAfter.Milliseconds(), Quota: le.Quota}
+	}
+	switch err {
+	case ErrForbidden:
+		e.Code = errCodeForbidden
+	case ErrTooManySubscriptions:
+		e.Code = errCodeLimitExceeded
+	case ErrResponseTooLarge:
+		e.Code = errCodeResponseTooLarge
+	}
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &jsonErrResponse{Version: "2.0", ID: id, Error: e}
+}
+
+// parseCalls parses single or batch JSON-RPC messages, raw messages of batch are returned for filtering
+func parseCalls(body []byte) ([]json.RawMessage, []*jsonrpcCall, bool, error) {
+	body = bytes.TrimSpace(body)
+	raws := []json.RawMessage{body}
+	batch := len(body) > 0 && body[0] == '['
+	if batch {
+		raws = nil
+		if err := json.Unmarshal(body, &raws); err != nil {
+			return nil, nil, true, err
+		}
+	}
+	calls := make([]*jsonrpcCall, 0, len(raws))
+	for _, raw := range raws {
+		call := new(jsonrpcCall)
+		if err := json.Unmarshal(raw, call); err != nil {
+			return nil, nil, batch, err
+		}
+		calls = append(calls, call)
+	}
+	return raws, calls, batch, nil
+}
+
+// checkCalls returns the error of each call, calls without method are left to the rpc server
+func checkCalls(calls []*jsonrpcCall, check func(method string) error) ([]error, error) {
+	var first error
+	errs := make([]error, len(calls))
+	for i, c := range calls {
+		if c.Method == "" {
+			continue
+		}
+		if errs[i] = check(c.Method); errs[i] != nil && first == nil {
+			first = errs[i]
+		}
+	}
+	return errs, first
+}
+
+// errResponses returns the error responses of calls which have error, single call gets a single response
+func errResponses(calls []*jsonrpcCall, errs []error, batch bool) interface{} {
+	var resps []*jsonErrResponse
+	for i, c := range calls {
+		if errs[i] != nil {
+			resps = append(resps, errResponse(c.ID, errs[i]))
+		}
+	}
+	if len(resps) == 0 {
+		return nil
+	}
+	if !batch {
+		return resps[0]
+	}
+	return resps
+}
+
+func httpStatus(err error) int {
+	if _, ok := err.(*RateLimitedError); ok {
+		return http.StatusTooManyRequests
+	}
+	switch err {
+	case ErrForbidden:
+		return http.StatusForbidden
+	case ErrResponseTooLarge:
+		return http.StatusOK
+	default:
+		return http.StatusUnauthorized
+	}
+}
+
+func writeError(w http.ResponseWriter, err error, resp interface{}) {
+	if le, ok := err.(*RateLimitedError); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(le.RetryAfter.Seconds()))))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(err))
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// limitedWriter buffers the response, and discards it if the size exceeds the limit
+type limitedWriter struct {
+	http.ResponseWriter
+	buf      bytes.Buffer
+	status   int
+	limit    int
+	exceeded bool
+}
+
+func (w *limitedWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if !w.exceeded {
+		if w.buf.Len()+len(p) > w.limit {
+			w.exceeded = true
+			w.buf.Reset()
+		} else {
+			w.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+func (w *limitedWriter) flush() {
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	_, _ = w.ResponseWriter.Write(w.buf.Bytes())
+}
+
+func remoteIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// HTTPHandler guards the JSON-RPC requests over http before passing them to next,
+// a batch is rejected as a whole if any call of it is not allowed
+func (g *Guard) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// empty GET is the health check of jsonrpc2
+		if r.Method == http.MethodGet && r.ContentLength == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		role, client, err := g.identify(credential(r.Header.Get("Authorization")), remoteIP(r.RemoteAddr))
+		if err != nil {
+			writeError(w, err, errResponse(nil, err))
+			return
+		}
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestContentLength))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		// invalid JSON is left to the rpc server
+		_, calls, batch, err := parseCalls(body)
+		if err == nil {
+			errs, first := checkCalls(calls, func(method string) error {
+				return g.check(role, client, method)
+			})
+			if first != nil {
+				writeError(w, first, errResponses(calls, errs, batch))
+				return
+			}
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		max := g.maxResponseSize()
+		if max <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		lw := &limitedWriter{ResponseWriter: w, limit: max}
+		next.ServeHTTP(lw, r)
+		if !lw.exceeded {
+			lw.flush()
+			return
+		}
+		if len(calls) == 0 {
+			writeError(w, ErrResponseTooLarge, errResponse(nil, ErrResponseTooLarge))
+			return
+		}
+		errs := make([]error, len(calls))
+		for i := range errs {
+			errs[i] = ErrResponseTooLarge
+		}
+		writeError(w, ErrResponseTooLarge, errResponses(calls, errs, batch))
+	})
+}
+
+// WebsocketHandler serves JSON-RPC to websocket connections like rpc.Server.WebsocketHandler, connections are
+// authenticated during handshake by header `Authorization` or the query parameter `token`, calls which are not
+// allowed get error responses without closing the connection
+func (g *Guard) WebsocketHandler(srv *rpc.Server, allowedOrigins []string) http.Handler {
+	checkOrigin := originValidator(allowedOrigins)
+	return websocket.Server{
+		Handshake: func(cfg *websocket.Config, r *http.Request) error {
+			if err := checkOrigin(r); err != nil {
+				return err
+			}
+			_, _, err := g.identify(wsCredential(r), remoteIP(r.RemoteAddr))
+			return err
+		},
+		Handler: func(conn *websocket.Conn) {
+			r := conn.Request()
+			role, client, err := g.identify(wsCredential(r), remoteIP(r.RemoteAddr))
+			if err != nil {
+				_ = conn.Close()
+				return
+			}
+			conn.MaxPayloadBytes = maxRequestContentLength
+			s := &wsSession{guard: g, conn: conn, role: role, client: client}
+			srv.ServeCodec(rpc.NewCodec(conn, s.encode, s.decode), rpc.OptionMethodInvocation|rpc.OptionSubscriptions)
+		},
+	}
+}
+
+type wsSession struct {
+	guard  *Guard
+	conn   *websocket.Conn
+	role   string
+	client string
+	// subscriptions requested by the connection, decode is called by one goroutine of the codec
+	subscriptions int
+}
+
+func (s *wsSession) check(method string) error {
+	if err := s.guard.check(s.role, s.client, method); err != nil {
+		return err
+	}
+	switch {
+	case strings.HasSuffix(method, "_subscribe"):
+		if max := s.guard.maxSubscriptions(); max > 0 && s.subscriptions >= max {
+			return ErrTooManySubscriptions
+		}
+		s.subscriptions++
+	case strings.HasSuffix(method, "_unsubscribe"):
+		if s.subscriptions > 0 {
+			s.subscriptions--
+		}
+	}
+	return nil
+}
+
+// decode reads the next message, calls which are not allowed are answered with errors and removed from it
+func (s *wsSession) decode(v interface{}) error {
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(s.conn, &msg); err != nil {
+			return err
+		}
+		raws, calls, batch, err := parseCalls(msg)
+		if err != nil || len(calls) == 0 {
+			return json.Unmarshal(msg, v)
+		}
+		errs, first := checkCalls(calls, s.check)
+		if first == nil {
+			return json.Unmarshal(msg, v)
+		}
+		if err := websocket.JSON.Send(s.conn, errResponses(calls, errs, batch)); err != nil {
+			return err
+		}
+		var allowed []json.RawMessage
+		for i, raw := range raws {
+			if errs[i] == nil {
+				allowed = append(allowed, raw)
+			}
+		}
+		if len(allowed) > 0 {
+			data, _ := json.Marshal(allowed)
+			return json.Unmarshal(data, v)
+		}
+	}
+}
+
+// encode sends the response, responses exceeding the size limit are replaced with errors,
+// and notifications exceeding the limit are dropped
+func (s *wsSession) encode(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if max := s.guard.maxResponseSize(); max > 0 && len(data) > max {
+		_, calls, batch, err := parseCalls(data)
+		if err != nil {
+			return err
+		}
+		errs := make([]error, len(calls))
+		for i, c := range calls {
+			if len(c.ID) > 0 {
+				errs[i] = ErrResponseTooLarge
+			}
+		}
+		if resp := errResponses(calls, errs, batch); resp != nil {
+			return websocket.JSON.Send(s.conn, resp)
+		}
+		return nil
+	}
+	return websocket.Message.Send(s.conn, string(data))
+}
+
+func wsCredential(r *http.Request) string {
+	if c := credential(r.Header.Get("Authorization")); c != "" {
+		return c
+	}
+	return r.URL.Query().Get("token")
+}
+
+// originValidator verifies the origin of websocket like jsonrpc2, requests without origin are not browsers and
+// always accepted
+func originValidator(allowedOrigins []string) func(r *http.Request) error {
+	origins := make(map[string]bool)
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			return func(r *http.Request) error { return nil }
+		}
+		if origin != "" {
+			origins[strings.ToLower(origin)] = true
+		}
+	}
+	if len(origins) == 0 {
+		origins["http://localhost"] = true
+		if hostname, err := os.Hostname(); err == nil {
+			origins["http://"+strings.ToLower(hostname)] = true
+		}
+	}
+	return func(r *http.Request) error {
+		origin := strings.ToLower(r.Header.Get("Origin"))
+		if origin == "" || origins[origin] {
+			return nil
+		}
+		return errors.New("origin not allowed")
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"golang.org/x/time/rate"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+const (
+	defaultClass   = "default"
+	maxClientCount = 100000
+)
+
+var ErrResponseTooLarge = errors.New("response too large")
+
+// RateLimitedError is returned if a client exceeds the rate limit of the method class or its daily quota
+type RateLimitedError struct {
+	Class      string
+	RetryAfter time.Duration
+	Quota      bool
+}
+
+func (e *RateLimitedError) Error() string {
+	if e.Quota {
+		return fmt.Sprintf("daily quota exceeded, retry after %s", e.RetryAfter.Round(time.Second))
+	}
+	return fmt.Sprintf("rate limited (%s), retry after %s", e.Class, e.RetryAfter.Round(time.Millisecond))
+}
+
+type methodClass struct {
+	name    string
+	methods []string
+	limit   rate.Limit
+	burst   int
+}
+
+type clientLimiter struct {
+	buckets []*rate.Limiter
+	lock    sync.Mutex
+	day     int64
+	count   int
+}
+
+// Limiter limits requests of clients by a token bucket of each method class, and the daily quota
+type Limiter struct {
+	classes          []*methodClass
+	quota            int
+	maxSubscriptions int
+	maxResponseSize  int
+	clients          gcache.Cache
+}
+
+// NewLimiter creates Limiter from the rate limit config, it returns nil if rate limit is disabled
+func NewLimiter(cfg *config.RateLimitConfig) (*Limiter, error) {
+	if cfg == nil || !cfg.Enable {
+		return nil, nil
+	}
+	l := &Limiter{
+		quota:            cfg.DailyQuota,
+		maxSubscriptions: cfg.MaxSubscriptions,
+		maxResponseSize:  cfg.MaxResponseSize,
+	}
+	for _, c := range cfg.Classes {
+		if c == nil {
+			continue
+		}
+		if c.Rate <= 0 || c.Burst <= 0 {
+			return nil, fmt.Errorf("invalid rate limit of class %s", c.Name)
+		}
+		methods, err := lowerPatterns(c.Methods)
+		if err != nil {
+			return nil, fmt.Errorf("invalid methods of class %s: %s", c.Name, err)
+		}
+		l.classes = append(l.classes, &methodClass{name: c.Name, methods: methods, limit: rate.Limit(c.Rate), burst: c.Burst})
+	}
+	if cfg.Rate <= 0 || cfg.Burst <= 0 {
+		return nil, errors.New("invalid default rate limit")
+	}
+	l.classes = append(l.classes, &methodClass{name: defaultClass, limit: rate.Limit(cfg.Rate), burst: cfg.Burst})
+
+	l.clients = gcache.New(maxClientCount).LRU().LoaderFunc(func(key interface{}) (interface{}, error) {
+		c := &clientLimiter{buckets: make([]*rate.Limiter, len(l.classes))}
+		for i, class := range l.classes {
+			c.buckets[i] = rate.NewLimiter(class.limit, class.burst)
+		}
+		return c, nil
+	}).Build()
+	return l, nil
+}
+
+// class returns the index of the class of method, methods not in any class belong to the default class
+func (l *Limiter) class(method string) int {
+	method = strings.ToLower(method)
+	for i, c := range l.classes {
+		for _, p := range c.methods {
+			if ok, _ := path.Match(p, method); ok {
+				return i
+			}
+		}
+	}
+	return len(l.classes) - 1
+}
+
+// Allow takes a token of the method class from the bucket of client, it returns *RateLimitedError if the
+// bucket is empty or the daily quota is exceeded
+func (l *Limiter) Allow(client, method string) error {
+	v, err := l.clients.Get(client)
+	if err != nil {
+		return err
+	}
+	c := v.(*clientLimiter)
+	i := l.class(method)
+	now := time.Now()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if l.quota > 0 {
+		day := now.Unix() / 86400
+		if day != c.day {
+			c.day = day
+			c.count = 0
+		}
+		if c.count >= l.quota {
+			return &RateLimitedError{Class: l.classes[i].name, RetryAfter: time.Unix((day+1)*86400, 0).Sub(now), Quota: true}
+		}
+	}
+	r := c.buckets[i].ReserveN(now, 1)
+	if d := r.DelayFrom(now); d > 0 {
+		r.CancelAt(now)
+		return &RateLimitedError{Class: l.classes[i].name, RetryAfter: d}
+	}
+	c.count++
+	return nil
+}
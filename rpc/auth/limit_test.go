@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+func testRateLimitConfig() *config.RateLimitConfig {
+	return &config.RateLimitConfig{
+		Enable: true,
+		Rate:   1,
+		Burst:  3,
+		Classes: []*config.MethodClass{
+			{Name: "expensive", Methods: []string{"ledger_generate*"}, Rate: 0.1, Burst: 1},
+		},
+		MaxSubscriptions: 1,
+		MaxResponseSize:  1024,
+	}
+}
+
+func TestNewLimiter(t *testing.T) {
+	if l, err := NewLimiter(&config.RateLimitConfig{}); l != nil || err != nil {
+		t.Fatal("limiter should be nil if rate limit is disabled", err)
+	}
+	cfg := testRateLimitConfig()
+	cfg.Classes[0].Burst = 0
+	if _, err := NewLimiter(cfg); err == nil {
+		t.Fatal("burst of class should be positive")
+	}
+	cfg = testRateLimitConfig()
+	cfg.Rate = 0
+	if _, err := NewLimiter(cfg); err == nil {
+		t.Fatal("default rate should be positive")
+	}
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	l, err := NewLimiter(testRateLimitConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := l.Allow("client1", "ledger_info"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = l.Allow("client1", "ledger_info")
+	if le, ok := err.(*RateLimitedError); !ok || le.Class != defaultClass || le.RetryAfter <= 0 || le.Quota {
+		t.Fatal("default class should be limited", err)
+	}
+	// classes and clients have their own buckets
+	if err := l.Allow("client1", "ledger_generateSendBlock"); err != nil {
+		t.Fatal(err)
+	}
+	err = l.Allow("client1", "LEDGER_GENERATESENDBLOCK")
+	if le, ok := err.(*RateLimitedError); !ok || le.Class != "expensive" {
+		t.Fatal("expensive class should be limited", err)
+	}
+	if err := l.Allow("client2", "ledger_info"); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := testRateLimitConfig()
+	cfg.Rate = 1000
+	cfg.Burst = 1000
+	cfg.DailyQuota = 2
+	l, _ = NewLimiter(cfg)
+	for i := 0; i < 2; i++ {
+		if err := l.Allow("client1", "ledger_info"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = l.Allow("client1", "ledger_info")
+	if le, ok := err.(*RateLimitedError); !ok || !le.Quota || le.RetryAfter > 24*time.Hour {
+		t.Fatal("daily quota should be exceeded", err)
+	}
+}
+
+func (s *testService) Large() string {
+	return strings.Repeat("a", 2048)
+}
+
+func (s *testService) NewBlock(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	return notifier.CreateSubscription(), nil
+}
+
+func TestGuard_HTTPHandler_RateLimit(t *testing.T) {
+	cfg := testConfig()
+	cfg.RPC.Auth.Enable = false
+	cfg.RateLimit = testRateLimitConfig()
+	g, err := NewGuard(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newTestServer(t)
+	defer srv.Stop()
+	ts := httptest.NewServer(g.HTTPHandler(srv))
+	defer ts.Close()
+
+	info := `{"jsonrpc":"2.0","id":1,"method":"ledger_info","params":[]}`
+	large := `{"jsonrpc":"2.0","id":2,"method":"ledger_large","params":[]}`
+
+	if code, _, body := httpCall(t, ts.URL, "", large); code != http.StatusOK || !strings.Contains(body, "response too large") {
+		t.Fatal("large response should be replaced", code, body)
+	}
+	if code, _, body := httpCall(t, ts.URL, "", info); code != http.StatusOK || !strings.Contains(body, `"result":"info"`) {
+		t.Fatal(code, body)
+	}
+	httpCall(t, ts.URL, "", info)
+	code, header, body := httpCall(t, ts.URL, "", info)
+	if code != http.StatusTooManyRequests || header.Get("Retry-After") == "" || !strings.Contains(body, `"class":"default"`) {
+		t.Fatal("request should be rate limited", code, header, body)
+	}
+}
+
+func TestGuard_WebsocketHandler_RateLimit(t *testing.T) {
+	cfg := testConfig()
+	cfg.RateLimit = testRateLimitConfig()
+	cfg.RateLimit.Burst = 100
+	g, err := NewGuard(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newTestServer(t)
+	defer srv.Stop()
+	ts := httptest.NewServer(g.WebsocketHandler(srv, []string{"*"}))
+	defer ts.Close()
+
+	c, err := rpc.DialWebsocket(context.Background(), "ws"+strings.TrimPrefix(ts.URL, "http")+"?token="+walletKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ch1 := make(chan interface{})
+	sub, err := c.Subscribe(context.Background(), "ledger", ch1, "newBlock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch2 := make(chan interface{})
+	if _, err := c.Subscribe(context.Background(), "ledger", ch2, "newBlock"); err == nil {
+		t.Fatal("subscriptions should be limited")
+	}
+	sub.Unsubscribe()
+	if _, err := c.Subscribe(context.Background(), "ledger", ch2, "newBlock"); err != nil {
+		t.Fatal(err)
+	}
+
+	var r string
+	err = c.Call(&r, "ledger_large")
+	if e, ok := err.(rpc.Error); !ok || e.ErrorCode() != errCodeResponseTooLarge {
+		t.Fatal("large response should be replaced", err)
+	}
+	if err := c.Call(&r, "ledger_generateSendBlock"); err != nil {
+		t.Fatal(err)
+	}
+	err = c.Call(&r, "ledger_generateSendBlock")
+	if e, ok := err.(rpc.Error); !ok || e.ErrorCode() != errCodeLimitExceeded {
+		t.Fatal("request should be rate limited", err)
+	}
+}
+
+func TestGuard_UnaryServerInterceptor(t *testing.T) {
+	cfg := testConfig()
+	cfg.RateLimit = testRateLimitConfig()
+	g, err := NewGuard(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(key, method string) codes.Code {
+		md := metadata.Pairs()
+		if key != "" {
+			md.Set("authorization", "Bearer "+key)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1000}})
+		_, err := g.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+
+	if c := call("", "/proto.LedgerAPI/AccountInfo"); c != codes.Unauthenticated {
+		t.Fatal(c)
+	}
+	if c := call(readOnlyKey, "/proto.LedgerAPI/Process"); c != codes.PermissionDenied {
+		t.Fatal(c)
+	}
+	if c := call(walletKey, "/proto.LedgerAPI/GenerateSendBlock"); c != codes.OK {
+		t.Fatal(c)
+	}
+	if c := call(walletKey, "/proto.LedgerAPI/GenerateSendBlock"); c != codes.ResourceExhausted {
+		t.Fatal(c)
+	}
+}
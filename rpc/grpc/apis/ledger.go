@@ -74,7 +74,23 @@ func (l *LedgerAPI) AccountInfo(ctx context.Context, addr *pbtypes.Address) (*pb
 	if err != nil {
 		return nil, err
 	}
-	r, err := l.ledger.AccountInfo(address)
+	r, err := l.ledger.AccountInfo(address, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIAccount(r), nil
+}
+
+func (l *LedgerAPI) AccountInfoAsOf(ctx context.Context, para *pb.AccountAsOfReq) (*pb.APIAccount, error) {
+	address, err := toOriginAddressByValue(para.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	asOf, err := toAPIAsOf(para.GetHeight(), para.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	r, err := l.ledger.AccountInfo(address, asOf)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +114,23 @@ func (l *LedgerAPI) AccountRepresentative(ctx context.Context, addr *pbtypes.Add
 	if err != nil {
 		return nil, err
 	}
-	r, err := l.ledger.AccountRepresentative(address)
+	r, err := l.ledger.AccountRepresentative(address, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toAddress(r), nil
+}
+
+func (l *LedgerAPI) AccountRepresentativeAsOf(ctx context.Context, para *pb.AccountAsOfReq) (*pbtypes.Address, error) {
+	address, err := toOriginAddressByValue(para.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	asOf, err := toAPIAsOf(para.GetHeight(), para.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	r, err := l.ledger.AccountRepresentative(address, asOf)
 	if err != nil {
 		return nil, err
 	}
@@ -141,10 +173,30 @@ func (l *LedgerAPI) AccountsBalance(ctx context.Context, addresses *pbtypes.Addr
 	if err != nil {
 		return nil, err
 	}
-	r, err := l.ledger.AccountsBalance(addrs)
+	r, err := l.ledger.AccountsBalance(addrs, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountsBalanceRsp(r), nil
+}
+
+func (l *LedgerAPI) AccountsBalanceAsOf(ctx context.Context, para *pb.AccountsAsOfReq) (*pb.AccountsBalanceRsp, error) {
+	addrs, err := toOriginAddressesByValues(para.GetAddresses())
+	if err != nil {
+		return nil, err
+	}
+	asOf, err := toAPIAsOf(para.GetHeight(), para.GetHash())
 	if err != nil {
 		return nil, err
 	}
+	r, err := l.ledger.AccountsBalance(addrs, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountsBalanceRsp(r), nil
+}
+
+func toAccountsBalanceRsp(r map[types.Address]map[string]*api.APIAccountsBalance) *pb.AccountsBalanceRsp {
 	result := make(map[string]*pb.AccountsBalanceRspBalances)
 	for addr, info := range r {
 		fi := make(map[string]*pb.AccountsBalanceRsp_APIAccountsBalance)
@@ -170,7 +222,7 @@ func (l *LedgerAPI) AccountsBalance(ctx context.Context, addresses *pbtypes.Addr
 		aff := &pb.AccountsBalanceRspBalances{Balances: fi}
 		result[toAddressValue(addr)] = aff
 	}
-	return &pb.AccountsBalanceRsp{AccountsBalances: result}, nil
+	return &pb.AccountsBalanceRsp{AccountsBalances: result}
 }
 
 func (l *LedgerAPI) AccountsFrontiers(ctx context.Context, addresses *pbtypes.Addresses) (*pb.AccountsFrontiersRsp, error) {
@@ -200,16 +252,48 @@ func (l *LedgerAPI) AccountsPending(ctx context.Context, ap *pb.AccountsPendingR
 		return nil, err
 	}
 	n := int(ap.GetCount())
-	r, err := l.ledger.AccountsPending(addrs, n)
+	r, err := l.ledger.AccountsPending(addrs, n, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountsPendingRsp(r), nil
+}
+
+func (l *LedgerAPI) AccountsPendingAsOf(ctx context.Context, para *pb.AccountsPendingAsOfReq) (*pb.AccountsPendingRsp, error) {
+	addrs, err := toOriginAddressesByValues(para.GetAddresses())
+	if err != nil {
+		return nil, err
+	}
+	asOf, err := toAPIAsOf(para.GetHeight(), para.GetHash())
 	if err != nil {
 		return nil, err
 	}
+	r, err := l.ledger.AccountsPending(addrs, int(para.GetCount()), asOf)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountsPendingRsp(r), nil
+}
+
+func toAccountsPendingRsp(r map[types.Address][]*api.APIPending) *pb.AccountsPendingRsp {
 	aps := &pb.AccountsPendingRsp{}
 	aps.AccountsPendings = make(map[string]*pb.APIPendings)
 	for addr, pendings := range r {
 		aps.AccountsPendings[toAddressValue(addr)] = toAPIPendings(pendings)
 	}
-	return aps, nil
+	return aps
+}
+
+func toAPIAsOf(height uint64, hash string) (*api.APIAsOf, error) {
+	asOf := &api.APIAsOf{Height: height}
+	if hash != "" {
+		h, err := toOriginHashByValue(hash)
+		if err != nil {
+			return nil, err
+		}
+		asOf.Hash = h
+	}
+	return asOf, nil
 }
 
 func (l *LedgerAPI) BlockAccount(ctx context.Context, hash *pbtypes.Hash) (*pbtypes.Address, error) {
@@ -299,6 +383,21 @@ func (l *LedgerAPI) Blocks(ctx context.Context, param *pb.Offset) (*pb.APIBlocks
 	return toAPIBlocks(r), nil
 }
 
+func (l *LedgerAPI) QueryBlocks(ctx context.Context, para *pb.QueryBlocksReq) (*pb.QueryBlocksRsp, error) {
+	q, err := toAPIBlockQuery(para)
+	if err != nil {
+		return nil, err
+	}
+	r, err := l.ledger.QueryBlocks(q)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.QueryBlocksRsp{
+		Blocks: toAPIBlocks(r.Blocks).GetBlocks(),
+		Cursor: r.Cursor,
+	}, nil
+}
+
 func (l *LedgerAPI) BlocksCount(context.Context, *empty.Empty) (*pb.BlocksCountRsp, error) {
 	r, err := l.ledger.BlocksCount()
 	if err != nil {
@@ -985,3 +1084,45 @@ func toTokenInfos(tokens []*types.TokenInfo) *pbtypes.TokenInfos {
 	}
 	return &pbtypes.TokenInfos{TokenInfos: ts}
 }
+
+func toAPIBlockQuery(para *pb.QueryBlocksReq) (*api.APIBlockQuery, error) {
+	q := &api.APIBlockQuery{
+		TokenName: para.GetTokenName(),
+		Types:     para.GetTypes(),
+		StartTime: para.GetStartTime(),
+		EndTime:   para.GetEndTime(),
+		Method:    para.GetMethod(),
+		Cursor:    para.GetCursor(),
+		Count:     int(para.GetCount()),
+	}
+	if para.GetAddress() != "" {
+		address, err := toOriginAddressByValue(para.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		q.Address = &address
+	}
+	if para.GetToken() != "" {
+		token, err := toOriginHashByValue(para.GetToken())
+		if err != nil {
+			return nil, err
+		}
+		q.Token = &token
+	}
+	if para.GetLink() != "" {
+		link, err := toOriginHashByValue(para.GetLink())
+		if err != nil {
+			return nil, err
+		}
+		q.Link = &link
+	}
+	if para.GetMinAmount() > 0 {
+		amount := toOriginBalanceByValue(para.GetMinAmount())
+		q.MinAmount = &amount
+	}
+	if para.GetMaxAmount() > 0 {
+		amount := toOriginBalanceByValue(para.GetMaxAmount())
+		q.MaxAmount = &amount
+	}
+	return q, nil
+}
@@ -171,6 +171,27 @@ func TestLedgerAPI_AccountInfo(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_AccountInfoAsOf(t *testing.T) {
+	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	r, err := ledgerApi.AccountInfoAsOf(context.Background(), &pb.AccountAsOfReq{
+		Address: account1.Address().String(),
+		Height:  0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetAddress() != account1.Address().String() {
+		t.Fatal()
+	}
+	if _, err := ledgerApi.AccountInfoAsOf(context.Background(), &pb.AccountAsOfReq{
+		Address: account1.Address().String(),
+		Hash:    mock.Hash().String(),
+	}); err == nil {
+		t.Fatal("unknown pov block should fail")
+	}
+}
+
 func TestLedgerAPI_ConfirmedAccountInfo(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -512,6 +533,35 @@ func TestLedgerAPI_Blocks(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_QueryBlocks(t *testing.T) {
+	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	r, err := ledgerApi.QueryBlocks(context.Background(), &pb.QueryBlocksReq{
+		Count: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.GetBlocks()) != 10 || r.GetCursor() == "" {
+		t.Fatalf("invalid len %d", len(r.GetBlocks()))
+	}
+	r, err = ledgerApi.QueryBlocks(context.Background(), &pb.QueryBlocksReq{
+		Count:  10,
+		Cursor: r.GetCursor(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.GetBlocks()) != 2 || r.GetCursor() != "" {
+		t.Fatalf("invalid len %d", len(r.GetBlocks()))
+	}
+	if _, err := ledgerApi.QueryBlocks(context.Background(), &pb.QueryBlocksReq{
+		Address: "invalid",
+	}); err == nil {
+		t.Fatal("address should be checked")
+	}
+}
+
 func TestLedgerAPI_Chain(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -821,6 +821,15 @@ func toPovMinerDayStat(s *types.PovMinerDayStat) *pbtypes.PovMinerDayStat {
 }
 
 func toPovDiffDayStat(s *types.PovDiffDayStat) *pbtypes.PovDiffDayStat {
+	as := make(map[string]*pbtypes.PovDiffAlgoStatItem)
+	for k, v := range s.AlgoStats {
+		as[k] = &pbtypes.PovDiffAlgoStatItem{
+			BlockNum:     v.BlockNum,
+			AvgDiffRatio: v.AvgDiffRatio,
+			MaxDiffRatio: v.MaxDiffRatio,
+			MinDiffRatio: v.MinDiffRatio,
+		}
+	}
 	return &pbtypes.PovDiffDayStat{
 		DayIndex:     s.DayIndex,
 		AvgDiffRatio: s.AvgDiffRatio,
@@ -828,6 +837,7 @@ func toPovDiffDayStat(s *types.PovDiffDayStat) *pbtypes.PovDiffDayStat {
 		MinDiffRatio: s.MinDiffRatio,
 		MaxBlockTime: s.MaxBlockTime,
 		MinBlockTime: s.MinBlockTime,
+		AlgoStats:    as,
 	}
 }
 
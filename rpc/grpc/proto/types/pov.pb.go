@@ -1805,12 +1805,13 @@ type PovDiffDayStat struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DayIndex     uint32 `protobuf:"varint,1,opt,name=dayIndex,proto3" json:"dayIndex,omitempty"`
-	AvgDiffRatio uint64 `protobuf:"varint,2,opt,name=avgDiffRatio,proto3" json:"avgDiffRatio,omitempty"`
-	MaxDiffRatio uint64 `protobuf:"varint,3,opt,name=maxDiffRatio,proto3" json:"maxDiffRatio,omitempty"`
-	MinDiffRatio uint64 `protobuf:"varint,4,opt,name=minDiffRatio,proto3" json:"minDiffRatio,omitempty"`
-	MaxBlockTime uint32 `protobuf:"varint,5,opt,name=maxBlockTime,proto3" json:"maxBlockTime,omitempty"`
-	MinBlockTime uint32 `protobuf:"varint,6,opt,name=minBlockTime,proto3" json:"minBlockTime,omitempty"`
+	DayIndex     uint32                          `protobuf:"varint,1,opt,name=dayIndex,proto3" json:"dayIndex,omitempty"`
+	AvgDiffRatio uint64                          `protobuf:"varint,2,opt,name=avgDiffRatio,proto3" json:"avgDiffRatio,omitempty"`
+	MaxDiffRatio uint64                          `protobuf:"varint,3,opt,name=maxDiffRatio,proto3" json:"maxDiffRatio,omitempty"`
+	MinDiffRatio uint64                          `protobuf:"varint,4,opt,name=minDiffRatio,proto3" json:"minDiffRatio,omitempty"`
+	MaxBlockTime uint32                          `protobuf:"varint,5,opt,name=maxBlockTime,proto3" json:"maxBlockTime,omitempty"`
+	MinBlockTime uint32                          `protobuf:"varint,6,opt,name=minBlockTime,proto3" json:"minBlockTime,omitempty"`
+	AlgoStats    map[string]*PovDiffAlgoStatItem `protobuf:"bytes,7,rep,name=algoStats,proto3" json:"algoStats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *PovDiffDayStat) Reset() {
@@ -1887,6 +1888,84 @@ func (x *PovDiffDayStat) GetMinBlockTime() uint32 {
 	return 0
 }
 
+func (x *PovDiffDayStat) GetAlgoStats() map[string]*PovDiffAlgoStatItem {
+	if x != nil {
+		return x.AlgoStats
+	}
+	return nil
+}
+
+type PovDiffAlgoStatItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNum     uint32 `protobuf:"varint,1,opt,name=blockNum,proto3" json:"blockNum,omitempty"`
+	AvgDiffRatio uint64 `protobuf:"varint,2,opt,name=avgDiffRatio,proto3" json:"avgDiffRatio,omitempty"`
+	MaxDiffRatio uint64 `protobuf:"varint,3,opt,name=maxDiffRatio,proto3" json:"maxDiffRatio,omitempty"`
+	MinDiffRatio uint64 `protobuf:"varint,4,opt,name=minDiffRatio,proto3" json:"minDiffRatio,omitempty"`
+}
+
+func (x *PovDiffAlgoStatItem) Reset() {
+	*x = PovDiffAlgoStatItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_pov_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PovDiffAlgoStatItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PovDiffAlgoStatItem) ProtoMessage() {}
+
+func (x *PovDiffAlgoStatItem) ProtoReflect() protoreflect.Message {
+	mi := &file_types_pov_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PovDiffAlgoStatItem.ProtoReflect.Descriptor instead.
+func (*PovDiffAlgoStatItem) Descriptor() ([]byte, []int) {
+	return file_types_pov_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PovDiffAlgoStatItem) GetBlockNum() uint32 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *PovDiffAlgoStatItem) GetAvgDiffRatio() uint64 {
+	if x != nil {
+		return x.AvgDiffRatio
+	}
+	return 0
+}
+
+func (x *PovDiffAlgoStatItem) GetMaxDiffRatio() uint64 {
+	if x != nil {
+		return x.MaxDiffRatio
+	}
+	return 0
+}
+
+func (x *PovDiffAlgoStatItem) GetMinDiffRatio() uint64 {
+	if x != nil {
+		return x.MinDiffRatio
+	}
+	return 0
+}
+
 var File_types_pov_proto protoreflect.FileDescriptor
 
 var file_types_pov_proto_rawDesc = []byte{
@@ -2126,7 +2205,7 @@ var file_types_pov_proto_rawDesc = []byte{
 	0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x76, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53,
 	0x74, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xe0, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x76, 0x44, 0x69, 0x66, 0x66, 0x44, 0x61,
+	0x38, 0x01, 0x22, 0xfe, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x76, 0x44, 0x69, 0x66, 0x66, 0x44, 0x61,
 	0x79, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x79, 0x49, 0x6e, 0x64, 0x65,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x61, 0x79, 0x49, 0x6e, 0x64, 0x65,
 	0x78, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x44, 0x69, 0x66, 0x66, 0x52, 0x61, 0x74, 0x69,
@@ -2140,11 +2219,31 @@ var file_types_pov_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
 	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
 	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x6c, 0x63, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x67, 0x6f, 0x2d,
-	0x71, 0x6c, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x76, 0x44, 0x69, 0x66, 0x66, 0x44, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x2e,
+	0x41, 0x6c, 0x67, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x58, 0x0a, 0x0e, 0x41, 0x6c, 0x67,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x76, 0x44, 0x69, 0x66, 0x66, 0x41, 0x6c, 0x67, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x76, 0x44, 0x69, 0x66, 0x66, 0x41,
+	0x6c, 0x67, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61,
+	0x76, 0x67, 0x44, 0x69, 0x66, 0x66, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x44, 0x69, 0x66, 0x66, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x66, 0x66, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x71, 0x6c, 0x63, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x71, 0x6c,
+	0x63, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2159,39 +2258,41 @@ func file_types_pov_proto_rawDescGZIP() []byte {
 	return file_types_pov_proto_rawDescData
 }
 
-var file_types_pov_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_types_pov_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_types_pov_proto_goTypes = []interface{}{
-	(*PovVerifierState)(nil), // 0: types.PovVerifierState
-	(*PovPublishState)(nil),  // 1: types.PovPublishState
-	(*PovRepState)(nil),      // 2: types.PovRepState
-	(*PovRepStates)(nil),     // 3: types.PovRepStates
-	(*PovHeader)(nil),        // 4: types.PovHeader
-	(*PovBaseHeader)(nil),    // 5: types.PovBaseHeader
-	(*PovAuxHeader)(nil),     // 6: types.PovAuxHeader
-	(*PovBtcTx)(nil),         // 7: types.PovBtcTx
-	(*PovBtcTxIn)(nil),       // 8: types.PovBtcTxIn
-	(*PovBtcOutPoint)(nil),   // 9: types.PovBtcOutPoint
-	(*PovBtcTxOut)(nil),      // 10: types.PovBtcTxOut
-	(*PovBtcHeader)(nil),     // 11: types.PovBtcHeader
-	(*PovCoinBaseTx)(nil),    // 12: types.PovCoinBaseTx
-	(*PovCoinBaseTxIn)(nil),  // 13: types.PovCoinBaseTxIn
-	(*PovCoinBaseTxOut)(nil), // 14: types.PovCoinBaseTxOut
-	(*PovBlock)(nil),         // 15: types.PovBlock
-	(*PovBody)(nil),          // 16: types.PovBody
-	(*PovTransaction)(nil),   // 17: types.PovTransaction
-	(*PovAccountState)(nil),  // 18: types.PovAccountState
-	(*PovTokenState)(nil),    // 19: types.PovTokenState
-	(*PovContractState)(nil), // 20: types.PovContractState
-	(*PovTxLookup)(nil),      // 21: types.PovTxLookup
-	(*PovTD)(nil),            // 22: types.PovTD
-	(*PovMinerStatItem)(nil), // 23: types.PovMinerStatItem
-	(*PovMinerDayStat)(nil),  // 24: types.PovMinerDayStat
-	(*PovDiffDayStat)(nil),   // 25: types.PovDiffDayStat
-	nil,                      // 26: types.PovVerifierState.ActiveHeightEntry
-	nil,                      // 27: types.PovMinerDayStat.MinerStatsEntry
+	(*PovVerifierState)(nil),    // 0: types.PovVerifierState
+	(*PovPublishState)(nil),     // 1: types.PovPublishState
+	(*PovRepState)(nil),         // 2: types.PovRepState
+	(*PovRepStates)(nil),        // 3: types.PovRepStates
+	(*PovHeader)(nil),           // 4: types.PovHeader
+	(*PovBaseHeader)(nil),       // 5: types.PovBaseHeader
+	(*PovAuxHeader)(nil),        // 6: types.PovAuxHeader
+	(*PovBtcTx)(nil),            // 7: types.PovBtcTx
+	(*PovBtcTxIn)(nil),          // 8: types.PovBtcTxIn
+	(*PovBtcOutPoint)(nil),      // 9: types.PovBtcOutPoint
+	(*PovBtcTxOut)(nil),         // 10: types.PovBtcTxOut
+	(*PovBtcHeader)(nil),        // 11: types.PovBtcHeader
+	(*PovCoinBaseTx)(nil),       // 12: types.PovCoinBaseTx
+	(*PovCoinBaseTxIn)(nil),     // 13: types.PovCoinBaseTxIn
+	(*PovCoinBaseTxOut)(nil),    // 14: types.PovCoinBaseTxOut
+	(*PovBlock)(nil),            // 15: types.PovBlock
+	(*PovBody)(nil),             // 16: types.PovBody
+	(*PovTransaction)(nil),      // 17: types.PovTransaction
+	(*PovAccountState)(nil),     // 18: types.PovAccountState
+	(*PovTokenState)(nil),       // 19: types.PovTokenState
+	(*PovContractState)(nil),    // 20: types.PovContractState
+	(*PovTxLookup)(nil),         // 21: types.PovTxLookup
+	(*PovTD)(nil),               // 22: types.PovTD
+	(*PovMinerStatItem)(nil),    // 23: types.PovMinerStatItem
+	(*PovMinerDayStat)(nil),     // 24: types.PovMinerDayStat
+	(*PovDiffDayStat)(nil),      // 25: types.PovDiffDayStat
+	(*PovDiffAlgoStatItem)(nil), // 26: types.PovDiffAlgoStatItem
+	nil,                         // 27: types.PovVerifierState.ActiveHeightEntry
+	nil,                         // 28: types.PovMinerDayStat.MinerStatsEntry
+	nil,                         // 29: types.PovDiffDayStat.AlgoStatsEntry
 }
 var file_types_pov_proto_depIdxs = []int32{
-	26, // 0: types.PovVerifierState.activeHeight:type_name -> types.PovVerifierState.ActiveHeightEntry
+	27, // 0: types.PovVerifierState.activeHeight:type_name -> types.PovVerifierState.ActiveHeightEntry
 	2,  // 1: types.PovRepStates.states:type_name -> types.PovRepState
 	5,  // 2: types.PovHeader.basHdr:type_name -> types.PovBaseHeader
 	6,  // 3: types.PovHeader.auxHdr:type_name -> types.PovAuxHeader
@@ -2207,13 +2308,15 @@ var file_types_pov_proto_depIdxs = []int32{
 	16, // 13: types.PovBlock.Body:type_name -> types.PovBody
 	17, // 14: types.PovBody.txs:type_name -> types.PovTransaction
 	19, // 15: types.PovAccountState.tokenStates:type_name -> types.PovTokenState
-	27, // 16: types.PovMinerDayStat.minerStats:type_name -> types.PovMinerDayStat.MinerStatsEntry
-	23, // 17: types.PovMinerDayStat.MinerStatsEntry.value:type_name -> types.PovMinerStatItem
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	28, // 16: types.PovMinerDayStat.minerStats:type_name -> types.PovMinerDayStat.MinerStatsEntry
+	29, // 17: types.PovDiffDayStat.algoStats:type_name -> types.PovDiffDayStat.AlgoStatsEntry
+	23, // 18: types.PovMinerDayStat.MinerStatsEntry.value:type_name -> types.PovMinerStatItem
+	26, // 19: types.PovDiffDayStat.AlgoStatsEntry.value:type_name -> types.PovDiffAlgoStatItem
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_types_pov_proto_init() }
@@ -2534,6 +2637,18 @@ func file_types_pov_proto_init() {
 				return nil
 			}
 		}
+		file_types_pov_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PovDiffAlgoStatItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2541,7 +2656,7 @@ func file_types_pov_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_pov_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
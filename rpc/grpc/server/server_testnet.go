@@ -19,6 +19,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/auth"
 	"github.com/qlcchain/go-qlc/rpc/grpc/apis"
 	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
 )
@@ -41,6 +42,11 @@ func Start(cfgFile string, ctx context.Context) (*GRPCServer, error) {
 	l := ledger.NewLedger(cfgFile)
 	eb := cc.EventBus()
 
+	guard, err := auth.NewGuard(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	network, address, err := scheme(cfg.RPC.GRPCConfig.ListenAddress)
 	if err != nil {
 		return nil, err
@@ -60,8 +66,17 @@ func Start(cfgFile string, ctx context.Context) (*GRPCServer, error) {
 		logger:  log.NewLogger("grpc"),
 	}
 
-	grpcServer := grpc.NewServer(grpc.StreamInterceptor(qrpc.StreamServerInterceptor),
-		grpc.UnaryInterceptor(qrpc.UnaryServerInterceptor))
+	streamInterceptors := []grpc.StreamServerInterceptor{qrpc.StreamServerInterceptor}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{qrpc.UnaryServerInterceptor}
+	var opts []grpc.ServerOption
+	if guard != nil {
+		streamInterceptors = append(streamInterceptors, guard.StreamServerInterceptor)
+		unaryInterceptors = append(unaryInterceptors, guard.UnaryServerInterceptor)
+		opts = append(opts, guard.ServerOptions()...)
+		qrpc.logger.Info("grpc authentication and rate limit enabled")
+	}
+	opts = append(opts, grpc.ChainStreamInterceptor(streamInterceptors...), grpc.ChainUnaryInterceptor(unaryInterceptors...))
+	grpcServer := grpc.NewServer(opts...)
 
 	qrpc.rpc = grpcServer
 	qrpc.registerApi()
@@ -18,6 +18,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/auth"
 	grpcServer "github.com/qlcchain/go-qlc/rpc/grpc/server"
 	"github.com/qlcchain/go-qlc/wallet"
 )
@@ -32,9 +33,17 @@ type RPC struct {
 	httpWhitelist []string
 	httpListener  net.Listener
 	httpHandler   *rpc.Server
+	httpServe     *swapHandler
 
 	wsListener net.Listener
 	wsHandler  *rpc.Server
+	wsServe    *swapHandler
+
+	// apis of http and websocket endpoints by module, reused by reloads
+	apiCache map[string]rpc.API
+
+	cfgManager *config.CfgManager
+	cfgSubID   int
 
 	config             *config.Config
 	DashboardTargetURL string
@@ -51,23 +60,27 @@ type RPC struct {
 	logger  *zap.SugaredLogger
 	cc      *chainctx.ChainContext
 	grpc    *grpcServer.GRPCServer
+	guard   *auth.Guard
 }
 
 func NewRPC(cfgFile string) (*RPC, error) {
 	cc := chainctx.NewChainContext(cfgFile)
 	cfg, _ := cc.Config()
+	cm, _ := cc.ConfigManager()
 	ctx, cancel := context.WithCancel(context.Background())
 
 	r := RPC{
-		ledger:  ledger.NewLedger(cfgFile),
-		wallet:  wallet.NewWalletStore(cfgFile),
-		eb:      cc.EventBus(),
-		config:  cfg,
-		cfgFile: cfgFile,
-		ctx:     ctx,
-		cancel:  cancel,
-		logger:  log.NewLogger("rpc"),
-		cc:      cc,
+		cfgManager: cm,
+		ledger:     ledger.NewLedger(cfgFile),
+		wallet:     wallet.NewWalletStore(cfgFile),
+		eb:         cc.EventBus(),
+		config:     cfg,
+		cfgFile:    cfgFile,
+		ctx:        ctx,
+		cancel:     cancel,
+		logger:     log.NewLogger("rpc"),
+		cc:         cc,
+		apiCache:   make(map[string]rpc.API),
 	}
 	return &r, nil
 }
@@ -204,6 +217,9 @@ func (r *RPC) stopInProcess() {
 }
 
 func (r *RPC) StopRPC() {
+	if r.cfgManager != nil {
+		r.cfgManager.Unsubscribe(r.cfgSubID)
+	}
 	r.cancel()
 	r.stopInProcess()
 	if r.config.RPC.Enable && r.config.RPC.IPCEnabled {
@@ -218,20 +234,62 @@ func (r *RPC) StopRPC() {
 	if r.config.RPC.Enable && r.config.RPC.GRPCConfig.Enable {
 		r.grpc.Stop()
 	}
+	r.lock.Lock()
+	if r.httpServe != nil {
+		r.httpServe.stopRetired()
+	}
+	if r.wsServe != nil {
+		r.wsServe.stopRetired()
+	}
+	r.lock.Unlock()
+}
+
+// lightModules are the modules which serve without the DAG ledger and contract states,
+// a PoV light client keeps only the headers and fetches account states from full peers
+var lightModules = map[string]bool{
+	"account": true,
+	"net":     true,
+	"util":    true,
+	"pov":     true,
+	"config":  true,
+	"metrics": true,
+	"chain":   true,
+}
+
+// publicModules returns the configured public modules, in light mode the modules which
+// would answer from the empty local ledger are left out
+func publicModules(cfg *config.Config) []string {
+	if cfg.PoV == nil || !cfg.PoV.PovEnabled || !cfg.PoV.LightMode {
+		return cfg.RPC.PublicModules
+	}
+	var modules []string
+	for _, m := range cfg.RPC.PublicModules {
+		if lightModules[m] {
+			modules = append(modules, m)
+		}
+	}
+	return modules
 }
 
 func (r *RPC) StartRPC() error {
 	// Init rpc log
 	//rpcapi.Init(node.config.DataDir, node.config.LogLevel, node.config.TestTokenHexPrivKey, node.config.TestTokenTti)
 
+	// authentication and rate limit of http and websocket, ipc and in-process endpoints are trusted
+	guard, err := auth.NewGuard(r.config)
+	if err != nil {
+		return fmt.Errorf("rpc auth config error: %s", err)
+	}
+	r.guard = guard
+
 	// Start the various API endpoints, terminating all in case of errors
-	if err := r.startInProcess(r.GetInProcessApis(r.config.RPC.PublicModules)); err != nil {
+	if err := r.startInProcess(r.GetInProcessApis(publicModules(r.config))); err != nil {
 		return err
 	}
 
 	//Start rpc
 	if r.config.RPC.Enable && r.config.RPC.IPCEnabled {
-		api := r.GetIpcApis(r.config.RPC.PublicModules)
+		api := r.GetIpcApis(publicModules(r.config))
 		if err := r.startIPC(api); err != nil {
 			r.stopInProcess()
 			return err
@@ -239,7 +297,7 @@ func (r *RPC) StartRPC() error {
 	}
 
 	if r.config.RPC.Enable && r.config.RPC.HTTPEnabled {
-		apis := r.GetHttpApis(r.config.RPC.PublicModules)
+		apis := r.cachedApis(publicModules(r.config))
 		if err := r.startHTTP(r.config.RPC.HTTPEndpoint, apis, nil, r.config.RPC.HTTPCors, r.config.RPC.HttpVirtualHosts, rpc.HTTPTimeouts{}); err != nil {
 			r.logger.Info(err)
 			r.stopInProcess()
@@ -249,7 +307,7 @@ func (r *RPC) StartRPC() error {
 	}
 
 	if r.config.RPC.Enable && r.config.RPC.WSEnabled {
-		apis := r.GetWSApis(r.config.RPC.PublicModules)
+		apis := r.cachedApis(publicModules(r.config))
 		if err := r.startWS(r.config.RPC.WSEndpoint, apis, nil, r.config.RPC.HTTPCors, false); err != nil {
 			r.logger.Info(err)
 			r.stopInProcess()
@@ -266,11 +324,133 @@ func (r *RPC) StartRPC() error {
 		}
 		r.grpc = grpc
 	}
+
+	if r.cfgManager != nil {
+		r.cfgSubID = r.cfgManager.Subscribe(func(old, cur *config.Config) {
+			if err := r.reload(cur); err != nil {
+				r.logger.Errorf("reload rpc config: %s", err)
+			}
+		}, config.SectionRPC)
+	}
 	return nil
 }
 
-// StartHTTPEndpoint starts the HTTP RpcCall endpoint, configured with cors/vhosts/modules
-func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts) (net.Listener, *rpc.Server, error) {
+// cachedApis returns the apis of modules for http and websocket endpoints, the api of a module is
+// created once, apis like ledger start subscriptions and goroutines which live as long as the node
+func (r *RPC) cachedApis(modules []string) []rpc.API {
+	var apis []rpc.API
+	for _, m := range modules {
+		api, ok := r.apiCache[m]
+		if !ok {
+			api = r.getApi(m)
+			r.apiCache[m] = api
+		}
+		apis = append(apis, api)
+	}
+	return apis
+}
+
+// reload replaces the handlers of http and websocket endpoints by handlers built with the current
+// cors, vhosts and public modules without closing the listeners, so that websocket connections and
+// their subscriptions are kept. The apis are reused by the new handlers. IPC and in-process endpoints are trusted and not affected.
+func (r *RPC) reload(cfg *config.Config) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.httpServe != nil {
+		handler, h, err := r.newHTTPHandler(r.cachedApis(publicModules(cfg)), nil, cfg.RPC.HTTPCors,
+			cfg.RPC.HttpVirtualHosts, rpc.HTTPTimeouts{})
+		if err != nil {
+			return err
+		}
+		r.httpServe.set(h, handler)
+		r.httpHandler = handler
+		r.logger.Info("HTTP endpoint reloaded, cors:", strings.Join(cfg.RPC.HTTPCors, ","),
+			", vhosts:", strings.Join(cfg.RPC.HttpVirtualHosts, ","))
+	}
+	if r.wsServe != nil {
+		handler, h, err := r.newWSHandler(r.cachedApis(publicModules(cfg)), nil, cfg.RPC.HTTPCors, false)
+		if err != nil {
+			return err
+		}
+		r.wsServe.set(h, handler)
+		r.wsHandler = handler
+		r.logger.Info("WebSocket endpoint reloaded")
+	}
+	return nil
+}
+
+// swapHandler serves by a http.Handler which can be replaced while serving. A replaced handler keeps
+// serving the requests and websocket connections it has accepted, its rpc server is stopped once they are finished.
+type swapHandler struct {
+	lock    sync.Mutex
+	current *servingHandler
+	retired map[*servingHandler]struct{}
+}
+
+type servingHandler struct {
+	handler http.Handler
+	server  *rpc.Server
+	active  int
+}
+
+func newSwapHandler(h http.Handler, server *rpc.Server) *swapHandler {
+	s := &swapHandler{retired: make(map[*servingHandler]struct{})}
+	s.set(h, server)
+	return s
+}
+
+func (s *swapHandler) set(h http.Handler, server *rpc.Server) {
+	s.lock.Lock()
+	old := s.current
+	s.current = &servingHandler{handler: h, server: server}
+	idle := old != nil && old.active == 0
+	if old != nil && !idle {
+		s.retired[old] = struct{}{}
+	}
+	s.lock.Unlock()
+
+	if idle {
+		old.server.Stop()
+	}
+}
+
+func (s *swapHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.lock.Lock()
+	sh := s.current
+	sh.active++
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		sh.active--
+		_, retired := s.retired[sh]
+		drained := retired && sh.active == 0
+		if drained {
+			delete(s.retired, sh)
+		}
+		s.lock.Unlock()
+
+		if drained {
+			sh.server.Stop()
+		}
+	}()
+	sh.handler.ServeHTTP(w, req)
+}
+
+// stopRetired stops the replaced handlers which are still serving, the current one is stopped with the endpoint
+func (s *swapHandler) stopRetired() {
+	s.lock.Lock()
+	retired := s.retired
+	s.retired = make(map[*servingHandler]struct{})
+	s.lock.Unlock()
+
+	for sh := range retired {
+		sh.server.Stop()
+	}
+}
+
+func newRPCServer(apis []rpc.API, modules []string, exposeAll bool) (*rpc.Server, error) {
 	// Generate the whitelist based on the allowed modules
 	whitelist := make(map[string]bool)
 	for _, module := range modules {
@@ -278,19 +458,49 @@ func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []strin
 	}
 	// Register all the APIs exposed by the services
 	handler := rpc.NewServer()
-
 	for _, api := range apis {
-		if whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
+		if exposeAll || whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
 			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
-				return nil, nil, err
+				return nil, err
 			}
 		}
 	}
+	return handler, nil
+}
+
+// newHTTPHandler returns the rpc server of apis and the http handler which serves it with cors and vhosts
+func (r *RPC) newHTTPHandler(apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts) (*rpc.Server, http.Handler, error) {
+	handler, err := newRPCServer(apis, modules, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	var h http.Handler = handler
+	if r.guard != nil {
+		h = r.guard.HTTPHandler(handler)
+	}
+	return handler, rpc.NewHTTPServer(cors, vhosts, timeouts, h).Handler, nil
+}
+
+// newWSHandler returns the rpc server of apis and the websocket handler which serves it with origins
+func (r *RPC) newWSHandler(apis []rpc.API, modules []string, wsOrigins []string, exposeAll bool) (*rpc.Server, http.Handler, error) {
+	handler, err := newRPCServer(apis, modules, exposeAll)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.guard != nil {
+		return handler, r.guard.WebsocketHandler(handler, wsOrigins), nil
+	}
+	return handler, rpc.NewWSServer(wsOrigins, handler).Handler, nil
+}
+
+// StartHTTPEndpoint starts the HTTP RpcCall endpoint, configured with cors/vhosts/modules
+func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts) (net.Listener, *rpc.Server, error) {
+	handler, h, err := r.newHTTPHandler(apis, modules, cors, vhosts, timeouts)
+	if err != nil {
+		return nil, nil, err
+	}
 	// All APIs registered, start the HTTP listener
-	var (
-		listener net.Listener
-		err      error
-	)
+	var listener net.Listener
 	network, address, err := scheme(endpoint)
 	if err != nil {
 		return nil, nil, err
@@ -299,9 +509,10 @@ func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []strin
 		return nil, nil, err
 	}
 
-	hServer := new(http.Server)
+	r.httpServe = newSwapHandler(h, handler)
+	hServer := rpc.NewHTTPServer(cors, vhosts, timeouts, nil)
+	hServer.Handler = r.httpServe
 	go func(hServer *http.Server) {
-		hServer = rpc.NewHTTPServer(cors, vhosts, timeouts, handler)
 		hServer.Serve(listener)
 		select {
 		case <-r.ctx.Done():
@@ -314,26 +525,12 @@ func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []strin
 
 // StartWSEndpoint starts a websocket endpoint
 func (r *RPC) StartWSEndpoint(endpoint string, apis []rpc.API, modules []string, wsOrigins []string, exposeAll bool) (net.Listener, *rpc.Server, error) {
-	// Generate the whitelist based on the allowed modules
-	whitelist := make(map[string]bool)
-	for _, module := range modules {
-		whitelist[module] = true
-	}
-	// Register all the APIs exposed by the services
-	handler := rpc.NewServer()
-	for _, api := range apis {
-		if exposeAll || whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
-			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
-				return nil, nil, err
-			}
-			//r.logger.Debug("WebSocket registered ", " service ", api.Service, " namespace ", api.Namespace)
-		}
+	handler, h, err := r.newWSHandler(apis, modules, wsOrigins, exposeAll)
+	if err != nil {
+		return nil, nil, err
 	}
 	// All APIs registered, start the HTTP listener
-	var (
-		listener net.Listener
-		err      error
-	)
+	var listener net.Listener
 	network, address, err := scheme(endpoint)
 	if err != nil {
 		return nil, nil, err
@@ -343,10 +540,9 @@ func (r *RPC) StartWSEndpoint(endpoint string, apis []rpc.API, modules []string,
 		return nil, nil, err
 	}
 
-	//go rpc.NewWSServer(wsOrigins, handler).Serve(listener)
-	hServer := new(http.Server)
+	r.wsServe = newSwapHandler(h, handler)
+	hServer := &http.Server{Handler: r.wsServe}
 	go func(hServer *http.Server) {
-		hServer = rpc.NewWSServer(wsOrigins, handler)
 		hServer.Serve(listener)
 		select {
 		case <-r.ctx.Done():
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	jsonrpc "github.com/qlcchain/jsonrpc2"
@@ -198,3 +199,92 @@ func TestRPC_WebSocket(t *testing.T) {
 //	}
 //	return str
 //}
+
+func TestRPC_Reload(t *testing.T) {
+	teardownTestCase, rpc := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	_, httpAddress, _ := scheme(rpc.config.RPC.HTTPEndpoint)
+	_, wsAddress, _ := scheme(rpc.config.RPC.WSEndpoint)
+	client, err := jsonrpc.Dial(fmt.Sprintf("http://%s", httpAddress))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	wsClient, err := jsonrpc.Dial(fmt.Sprintf("ws://%s", wsAddress))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wsClient.Close()
+
+	var resp interface{}
+	if err := client.Call(&resp, "util_rawToBalance", "100000000", "QLC", nil); err == nil {
+		t.Fatal("util should not be public")
+	}
+
+	ledgerApi := rpc.apiCache["ledger"].Service
+	if _, err := rpc.cfgManager.UpdateParams([]string{"rpc.publicModules=ledger,util"}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := rpc.cfgManager.Reload(false); !ok || err != nil {
+		t.Fatal(ok, err)
+	}
+
+	if err := client.Call(&resp, "util_rawToBalance", "100000000", "QLC", nil); err != nil {
+		t.Fatal(err)
+	}
+	var valid bool
+	if err := client.Call(&valid, "account_validate", mock.Address()); err == nil {
+		t.Fatal("account should not be public")
+	}
+	// apis are not created again by a reload
+	if len(rpc.apiCache) != 3 || rpc.apiCache["ledger"].Service != ledgerApi {
+		t.Fatal("apis should be reused", rpc.apiCache)
+	}
+
+	// established websocket connection is kept
+	blk := new(types.StateBlock)
+	blk.Token = config.ChainToken()
+	var hash types.Hash
+	if err := wsClient.Call(&hash, "ledger_blockHash", blk); err != nil {
+		t.Fatal(err)
+	}
+
+	// the replaced http handler has no request in flight and is stopped at once,
+	// the replaced websocket handler is stopped when its connection is closed
+	if n := retiredCount(rpc.httpServe); n != 0 {
+		t.Fatal("retired http handlers", n)
+	}
+	if n := retiredCount(rpc.wsServe); n != 1 {
+		t.Fatal("retired websocket handlers", n)
+	}
+	wsClient.Close()
+	for i := 0; retiredCount(rpc.wsServe) != 0; i++ {
+		if i == 50 {
+			t.Fatal("retired websocket handler is not stopped")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestPublicModules(t *testing.T) {
+	cfg, err := config.DefaultConfig(filepath.Join(config.QlcTestDataDir(), "rpc", uuid.New().String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.RPC.PublicModules = []string{"ledger", "account", "pov", "contract"}
+	if m := publicModules(cfg); len(m) != 4 {
+		t.Fatal(m)
+	}
+	cfg.PoV.PovEnabled = true
+	cfg.PoV.LightMode = true
+	if m := publicModules(cfg); len(m) != 2 || m[0] != "account" || m[1] != "pov" {
+		t.Fatal(m)
+	}
+}
+
+func retiredCount(s *swapHandler) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.retired)
+}
@@ -9,6 +9,8 @@ package trie
 
 import (
 	"bytes"
+	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
@@ -209,6 +211,61 @@ func (trie *Trie) Hash() *types.Hash {
 	return trie.Root.Hash()
 }
 
+// Verify recomputes the hash of every node reachable from the root, it fails if a node
+// or a referenced value is missing or does not match the stored hash
+func (trie *Trie) Verify() error {
+	if trie.Root == nil {
+		return errors.New("trie root not found")
+	}
+	_, err := trie.verifyNode(trie.Root)
+	return err
+}
+
+func (trie *Trie) verifyNode(node *TrieNode) (*types.Hash, error) {
+	if node == nil || node.NodeType() == UnknownNode {
+		return nil, errors.New("trie node not found")
+	}
+
+	n := node.Clone(false)
+	switch node.NodeType() {
+	case FullNode:
+		for key, child := range node.children {
+			h, err := trie.verifyNode(child)
+			if err != nil {
+				return nil, err
+			}
+			n.children[key] = &TrieNode{hash: h}
+		}
+		if node.child != nil {
+			h, err := trie.verifyNode(node.child)
+			if err != nil {
+				return nil, err
+			}
+			n.child = &TrieNode{hash: h}
+		}
+	case ShortNode:
+		h, err := trie.verifyNode(node.child)
+		if err != nil {
+			return nil, err
+		}
+		n.child = &TrieNode{hash: h}
+	case HashNode:
+		value, err := trie.getRefValue(node.value)
+		if err != nil || len(value) == 0 {
+			return nil, fmt.Errorf("trie value %s not found", hex.EncodeToString(node.value))
+		}
+		if h := types.HashData(value); !bytes.Equal(h[:], node.value) {
+			return nil, fmt.Errorf("trie value %s mismatch", hex.EncodeToString(node.value))
+		}
+	}
+
+	h := n.Hash()
+	if node.hash != nil && *node.hash != *h {
+		return nil, fmt.Errorf("trie node %s mismatch", node.hash)
+	}
+	return h, nil
+}
+
 func (trie *Trie) Clone() *Trie {
 	newTrie := &Trie{
 		db:    trie.db,
@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/monitor"
+)
+
+const gcBatchSize = 10000
+
+var (
+	gcRunsCounter      = metrics.GetOrRegisterCounter("trie.gc.runs", monitor.SystemRegistry)
+	gcRemovedCounter   = metrics.GetOrRegisterCounter("trie.gc.removedNodes", monitor.SystemRegistry)
+	gcReclaimedCounter = metrics.GetOrRegisterCounter("trie.gc.reclaimedBytes", monitor.SystemRegistry)
+	gcReachableGauge   = metrics.GetOrRegisterGauge("trie.gc.reachableNodes", monitor.SystemRegistry)
+	gcDurationTimer    = metrics.GetOrRegisterTimer("trie.gc.duration", monitor.SystemRegistry)
+)
+
+// gcLock pauses trie commits during a collection round
+var gcLock sync.RWMutex
+
+// GCLock returns the lock shared by trie writers and the collector. Writers hold it for reading
+// until the nodes they commit and the roots referencing them are stored, a collection round holds
+// it for writing from the first Mark until Sweep returns, so a commit can not reuse a node which
+// is about to be removed.
+func GCLock() *sync.RWMutex {
+	return &gcLock
+}
+
+// GCResult describes one round of trie garbage collection
+type GCResult struct {
+	Roots          int           `json:"roots"`
+	Reachable      int           `json:"reachable"`
+	Missing        int           `json:"missing"`
+	Scanned        uint64        `json:"scanned"`
+	Garbage        uint64        `json:"garbage"`
+	Removed        uint64        `json:"removed"`
+	ReclaimedBytes uint64        `json:"reclaimedBytes"`
+	Duration       time.Duration `json:"duration"`
+}
+
+// Collector is a mark-and-sweep garbage collector for trie nodes and referenced values
+// stored under KeyPrefixTrie. Every node reachable from a marked root survives a sweep.
+//
+// When confirm is set, a key is only removed after it was found unreachable in two
+// consecutive rounds, so that nodes reused by a trie committed during a round survive.
+type Collector struct {
+	db      storage.Store
+	confirm bool
+	marked  map[types.Hash]struct{}
+	garbage map[types.Hash]struct{}
+	roots   int
+	missing int
+	start   time.Time
+	logger  *zap.SugaredLogger
+}
+
+func NewCollector(db storage.Store, confirm bool) *Collector {
+	return &Collector{
+		db:      db,
+		confirm: confirm,
+		marked:  make(map[types.Hash]struct{}),
+		garbage: make(map[types.Hash]struct{}),
+		logger:  log.NewLogger("trie_gc"),
+	}
+}
+
+type gcNode struct {
+	hash types.Hash
+	key  []byte
+}
+
+// Mark marks all nodes reachable from root, fn is called with the full key and value of every
+// newly reached leaf, nodes already marked in this round are not visited again
+func (c *Collector) Mark(root types.Hash, fn func(key, value []byte) error) error {
+	if root.IsZero() {
+		return nil
+	}
+	if c.start.IsZero() {
+		c.start = time.Now()
+	}
+	c.roots++
+
+	stack := []gcNode{{hash: root}}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := c.marked[n.hash]; ok {
+			continue
+		}
+
+		val, err := c.db.Get(encodeKey(n.hash[:]))
+		if err != nil {
+			if err == storage.KeyNotFound {
+				c.missing++
+				continue
+			}
+			return err
+		}
+		node := new(TrieNode)
+		if err := node.Deserialize(val); err != nil {
+			return fmt.Errorf("trie node %s: %s", n.hash, err)
+		}
+		c.marked[n.hash] = struct{}{}
+
+		switch node.NodeType() {
+		case FullNode:
+			for k, child := range node.children {
+				stack = append(stack, gcNode{hash: *child.Hash(), key: appendKey(n.key, k)})
+			}
+			if node.child != nil {
+				stack = append(stack, gcNode{hash: *node.child.Hash(), key: n.key})
+			}
+		case ShortNode:
+			stack = append(stack, gcNode{hash: *node.child.Hash(), key: appendKey(n.key, node.key...)})
+		case HashNode:
+			ref, err := types.BytesToHash(node.value)
+			if err != nil {
+				return fmt.Errorf("trie hash node %s: %s", n.hash, err)
+			}
+			c.marked[ref] = struct{}{}
+			if fn != nil {
+				value, err := c.db.Get(encodeKey(ref[:]))
+				if err != nil {
+					if err == storage.KeyNotFound {
+						c.missing++
+						continue
+					}
+					return fmt.Errorf("trie value %s: %s", ref, err)
+				}
+				if err := fn(n.key, value); err != nil {
+					return err
+				}
+			}
+		case ValueNode:
+			if fn != nil {
+				if err := fn(n.key, node.value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Sweep removes all unmarked keys under KeyPrefixTrie and resets the marks for the next round
+func (c *Collector) Sweep() (*GCResult, error) {
+	if c.start.IsZero() {
+		c.start = time.Now()
+	}
+	result := &GCResult{Roots: c.roots, Reachable: len(c.marked), Missing: c.missing}
+	defer func() {
+		c.marked = make(map[types.Hash]struct{})
+		c.roots = 0
+		c.missing = 0
+		c.start = time.Time{}
+	}()
+
+	garbage := make(map[types.Hash]struct{})
+	removes := make(map[types.Hash]uint64)
+	prefix := []byte{byte(storage.KeyPrefixTrie)}
+	if err := c.db.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		result.Scanned++
+		if len(key) != 1+types.HashSize {
+			return nil
+		}
+		hash, err := types.BytesToHash(key[1:])
+		if err != nil {
+			return err
+		}
+		if _, ok := c.marked[hash]; ok {
+			return nil
+		}
+		result.Garbage++
+		if _, ok := c.garbage[hash]; ok || !c.confirm {
+			removes[hash] = uint64(len(key) + len(val))
+		} else {
+			garbage[hash] = struct{}{}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	batch := c.db.Batch(false)
+	count := 0
+	for hash, size := range removes {
+		if err := batch.Delete(encodeKey(hash[:])); err != nil {
+			batch.Discard()
+			return nil, err
+		}
+		result.Removed++
+		result.ReclaimedBytes += size
+		count++
+		if count >= gcBatchSize {
+			if err := c.db.PutBatch(batch); err != nil {
+				return nil, err
+			}
+			batch = c.db.Batch(false)
+			count = 0
+		}
+	}
+	if err := c.db.PutBatch(batch); err != nil {
+		return nil, err
+	}
+	c.garbage = garbage
+	if result.Removed > 0 {
+		// removed nodes must not be treated as saved by later commits
+		GetGlobalTriePool().Clear()
+	}
+
+	result.Duration = time.Since(c.start)
+	gcRunsCounter.Inc(1)
+	gcRemovedCounter.Inc(int64(result.Removed))
+	gcReclaimedCounter.Inc(int64(result.ReclaimedBytes))
+	gcReachableGauge.Update(int64(result.Reachable))
+	gcDurationTimer.Update(result.Duration)
+	c.logger.Infof("trie gc: %d roots, %d reachable, %d garbage, %d removed, %d bytes reclaimed",
+		result.Roots, result.Reachable, result.Garbage, result.Removed, result.ReclaimedBytes)
+	return result, nil
+}
+
+func appendKey(prefix []byte, key ...byte) []byte {
+	k := make([]byte, 0, len(prefix)+len(key))
+	k = append(k, prefix...)
+	return append(k, key...)
+}
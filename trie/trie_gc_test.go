@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCollector_Sweep(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	value := bytes.Repeat([]byte("value"), 20)
+	for i := 0; i < 100; i++ {
+		trie.SetValue([]byte(fmt.Sprintf("key%d", i)), append(value, byte(i)))
+	}
+	callback, err := trie.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback()
+	old := *trie.Hash()
+
+	for i := 0; i < 10; i++ {
+		trie.SetValue([]byte(fmt.Sprintf("key%d", i)), append(value, byte(i), byte(i)))
+	}
+	if callback, err = trie.Save(); err != nil {
+		t.Fatal(err)
+	}
+	callback()
+	root := *trie.Hash()
+
+	c := NewCollector(trie.db, false)
+	leaves := 0
+	if err := c.Mark(root, func(key, value []byte) error {
+		leaves++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if leaves != 100 {
+		t.Fatal("invalid leaves", leaves)
+	}
+	r, err := c.Sweep()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Roots != 1 || r.Missing != 0 || r.Removed == 0 || r.Removed != r.Garbage || r.ReclaimedBytes == 0 {
+		t.Fatal(r)
+	}
+
+	if err := NewTrie(trie.db, &root, NewSimpleTrieNodePool()).Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewTrie(trie.db, &old, NewSimpleTrieNodePool()).Verify(); err == nil {
+		t.Fatal("old trie should be collected")
+	}
+
+	// nothing left to collect
+	if err := c.Mark(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := c.Sweep(); err != nil || r.Removed != 0 {
+		t.Fatal(r, err)
+	}
+}
+
+func TestCollector_Confirm(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	value := bytes.Repeat([]byte("value"), 20)
+	trie.SetValue([]byte("key1"), value)
+	callback, err := trie.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback()
+	old := *trie.Hash()
+
+	trie.SetValue([]byte("key2"), value)
+	if callback, err = trie.Save(); err != nil {
+		t.Fatal(err)
+	}
+	callback()
+	root := *trie.Hash()
+
+	c := NewCollector(trie.db, true)
+	if err := c.Mark(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	r, err := c.Sweep()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Garbage == 0 || r.Removed != 0 {
+		t.Fatal(r)
+	}
+	if err := NewTrie(trie.db, &old, NewSimpleTrieNodePool()).Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Mark(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	if r, err = c.Sweep(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Removed != r.Garbage || r.Removed == 0 {
+		t.Fatal(r)
+	}
+	if err := NewTrie(trie.db, &root, NewSimpleTrieNodePool()).Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollector_Reuse(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	value := bytes.Repeat([]byte("value"), 20)
+	trie.SetValue([]byte("key1"), value)
+	callback, err := trie.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback()
+	old := *trie.Hash()
+
+	trie.SetValue([]byte("key1"), append(value, 1))
+	if callback, err = trie.Save(); err != nil {
+		t.Fatal(err)
+	}
+	callback()
+	root := *trie.Hash()
+
+	c := NewCollector(trie.db, true)
+	if err := c.Mark(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := c.Sweep(); err != nil || r.Garbage == 0 || r.Removed != 0 {
+		t.Fatal(r, err)
+	}
+
+	// a trie reusing the garbage nodes of old is committed between mark and sweep
+	GCLock().Lock()
+	if err := c.Mark(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		reuse := NewTrie(trie.db, nil, NewSimpleTrieNodePool())
+		reuse.SetValue([]byte("key1"), value)
+		GCLock().RLock()
+		defer GCLock().RUnlock()
+		callback, err := reuse.Save()
+		if err == nil {
+			callback()
+		}
+		done <- err
+	}()
+	select {
+	case <-done:
+		t.Fatal("commit should wait for the collection round")
+	case <-time.After(100 * time.Millisecond):
+	}
+	r, err := c.Sweep()
+	GCLock().Unlock()
+	if err != nil || r.Removed == 0 {
+		t.Fatal(r, err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewTrie(trie.db, &old, NewSimpleTrieNodePool()).Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewTrie(trie.db, &root, NewSimpleTrieNodePool()).Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+var ErrInvalidProof = errors.New("invalid trie proof")
+
+// Prove returns the serialized nodes on the path from the root to key, if the leaf is
+// a hash node the referenced value is appended. When key does not exist, the proof ends
+// at the node where the path diverges and proves its absence.
+func (trie *Trie) Prove(key []byte) ([][]byte, error) {
+	if trie.Root == nil {
+		return nil, errors.New("trie root not found")
+	}
+
+	var proof [][]byte
+	node := trie.Root
+	for node != nil {
+		data, err := node.Serialize()
+		if err != nil {
+			return nil, fmt.Errorf("serialize trie node failed, error is %s", err)
+		}
+		proof = append(proof, data)
+
+		if len(key) == 0 {
+			switch node.NodeType() {
+			case HashNode:
+				value, err := trie.getRefValue(node.value)
+				if err != nil || len(value) == 0 {
+					return nil, fmt.Errorf("trie value %s not found", node.Hash())
+				}
+				return append(proof, value), nil
+			case FullNode:
+				node = node.child
+				continue
+			default:
+				return proof, nil
+			}
+		}
+
+		switch node.NodeType() {
+		case FullNode:
+			node = node.children[key[0]]
+			key = key[1:]
+		case ShortNode:
+			if !bytes.HasPrefix(key, node.key) {
+				return proof, nil
+			}
+			key = key[len(node.key):]
+			node = node.child
+		default:
+			return proof, nil
+		}
+	}
+	return proof, nil
+}
+
+// VerifyProof checks proof against root and returns the value of key,
+// a nil value without error means the proof shows key does not exist.
+// The absence of a key which extends an existing key ends at a leaf and can not be proved.
+// Node hashes do not cover the node type of leaves, so a 32 bytes value may also be the
+// hash of a referenced value, callers should decode the value as the type they expect.
+func VerifyProof(root types.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	expected := root
+	for i := 0; i < len(proof); i++ {
+		node := new(TrieNode)
+		if err := node.Deserialize(proof[i]); err != nil {
+			return nil, fmt.Errorf("%s: node %d, %s", ErrInvalidProof, i, err)
+		}
+		// never trust the hash carried in the proof
+		node.hash = nil
+		if h := node.Hash(); *h != expected {
+			return nil, fmt.Errorf("%s: node %d hash mismatch", ErrInvalidProof, i)
+		}
+
+		var next *TrieNode
+		if len(key) == 0 {
+			switch node.NodeType() {
+			case HashNode:
+				if i+1 >= len(proof) {
+					return nil, fmt.Errorf("%s: missing value", ErrInvalidProof)
+				}
+				value := proof[i+1]
+				if h := types.HashData(value); !bytes.Equal(h[:], node.value) {
+					return nil, fmt.Errorf("%s: value hash mismatch", ErrInvalidProof)
+				}
+				return value, nil
+			case ValueNode:
+				return node.value, nil
+			case FullNode:
+				next = node.child
+			}
+		} else {
+			switch node.NodeType() {
+			case FullNode:
+				next = node.children[key[0]]
+				key = key[1:]
+			case ShortNode:
+				if bytes.HasPrefix(key, node.key) {
+					next = node.child
+					key = key[len(node.key):]
+				}
+			default:
+				// a leaf hashes as its value only, so it can be forged from the preimage of any node,
+				// only a missing child or a prefix mismatch proves the absence of key
+				return nil, fmt.Errorf("%s: leaf at node %d does not prove absence", ErrInvalidProof, i)
+			}
+		}
+
+		if next == nil {
+			if i+1 != len(proof) {
+				return nil, fmt.Errorf("%s: unexpected nodes after %d", ErrInvalidProof, i)
+			}
+			return nil, nil
+		}
+		expected = *next.Hash()
+	}
+	return nil, fmt.Errorf("%s: proof is incomplete", ErrInvalidProof)
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+func TestTrie_Prove(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	if _, err := trie.Prove([]byte("key")); err == nil {
+		t.Fatal("empty trie should not be proved")
+	}
+
+	values := make(map[string][]byte)
+	for i := 0; i < 50; i++ {
+		key := fmt.Sprintf("key%d", i)
+		value := []byte(fmt.Sprintf("v%d", i))
+		if i%2 == 0 {
+			value = bytes.Repeat(value, 20)
+		}
+		values[key] = value
+		trie.SetValue([]byte(key), value)
+	}
+	values["key"] = []byte("prefix")
+	trie.SetValue([]byte("key"), values["key"])
+	callback, err := trie.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback()
+	root := *trie.Hash()
+
+	trie = NewTrie(trie.db, &root, NewSimpleTrieNodePool())
+	for key, value := range values {
+		proof, err := trie.Prove([]byte(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := VerifyProof(root, []byte(key), proof)
+		if err != nil {
+			t.Fatal(key, err)
+		}
+		if !bytes.Equal(v, value) {
+			t.Fatal(key, string(v), string(value))
+		}
+	}
+
+	for _, key := range []string{"ke", "keyz", "key4x", "abc", ""} {
+		proof, err := trie.Prove([]byte(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, err := VerifyProof(root, []byte(key), proof); err != nil || v != nil {
+			t.Fatal(key, v, err)
+		}
+	}
+	// the path of key100 ends at the leaf of key10, which can not prove absence
+	proof, err := trie.Prove([]byte("key100"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyProof(root, []byte("key100"), proof); err == nil {
+		t.Fatal("leaf should not prove absence")
+	}
+
+	proof, err = trie.Prove([]byte("key10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyProof(types.ZeroHash, []byte("key10"), proof); err == nil {
+		t.Fatal("proof should not match other root")
+	}
+	if _, err := VerifyProof(root, []byte("key10"), proof[:len(proof)-1]); err == nil {
+		t.Fatal("incomplete proof should fail")
+	}
+	proof[len(proof)-1] = []byte("fake")
+	if _, err := VerifyProof(root, []byte("key10"), proof); err == nil {
+		t.Fatal("fake value should fail")
+	}
+	proof, _ = trie.Prove([]byte("key11"))
+	if _, err := VerifyProof(root, []byte("key12"), proof); err == nil {
+		t.Fatal("proof of other key should fail")
+	}
+
+	// a value node hashes as its value, so the preimage of the root forms a leaf with the root hash
+	var preimage []byte
+	switch trie.Root.NodeType() {
+	case FullNode:
+		preimage = []byte{FullNode}
+		if trie.Root.child != nil {
+			preimage = append(preimage, trie.Root.child.Hash()[:]...)
+		}
+		for _, c := range sortChildren(trie.Root.children) {
+			preimage = append(preimage, c.Key)
+			preimage = append(preimage, c.Value.Hash()[:]...)
+		}
+	case ShortNode:
+		preimage = append([]byte{ShortNode}, trie.Root.key...)
+		preimage = append(preimage, trie.Root.child.Hash()[:]...)
+	}
+	forged, err := NewValueNode(preimage).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := NewValueNode(preimage).Hash(); *h != root {
+		t.Fatal("forged node should match the root hash")
+	}
+	if v, err := VerifyProof(root, []byte("key11"), [][]byte{forged}); err == nil {
+		t.Fatal("forged absence proof should fail", v)
+	}
+}
@@ -776,3 +776,40 @@ func TestTrie_Clone(t *testing.T) {
 		t.Fatalf("%v,%v", h1, h2)
 	}
 }
+
+func TestTrie_Verify(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	if err := trie.Verify(); err == nil {
+		t.Fatal("empty trie should fail")
+	}
+
+	for i := 0; i < 100; i++ {
+		trie.SetValue(mock.Hash().Bytes(), []byte(strconv.Itoa(i)))
+	}
+	// value longer than 32 bytes is saved as a hash node
+	trie.SetValue([]byte("long"), bytes.Repeat([]byte{1}, 64))
+	fn, err := trie.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn()
+
+	loaded := NewTrie(trie.db, trie.Hash(), nil)
+	if err := loaded.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if *loaded.Hash() != *trie.Hash() {
+		t.Fatal("hash mismatch")
+	}
+
+	// remove a leaf value, verify should detect the missing reference
+	valueHash := types.HashData(bytes.Repeat([]byte{1}, 64))
+	if err := trie.db.Delete(encodeKey(valueHash[:])); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewTrie(trie.db, trie.Hash(), nil).Verify(); err == nil {
+		t.Fatal("missing value should fail")
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
@@ -119,6 +121,45 @@ const (
     "inputs": [
         { "name": "asset", "type": "string" }
     ]
+  },{
+    "type": "function",
+    "name": "UpdateRateCard",
+    "inputs": [
+        { "name": "contractAddress", "type": "address" },
+        { "name": "rateCard", "type": "string" }
+    ]
+  },{
+    "type": "function",
+    "name": "OpenDispute",
+    "inputs": [
+        { "name": "contractAddress", "type": "address" },
+        { "name": "cdrs", "type": "string" },
+        { "name": "reason", "type": "string" }
+    ]
+  },{
+    "type": "function",
+    "name": "AddDisputeEvidence",
+    "inputs": [
+        { "name": "contractAddress", "type": "address" },
+        { "name": "disputeId", "type": "hash" },
+        { "name": "evidence", "type": "string" }
+    ]
+  },{
+    "type": "function",
+    "name": "ProposeDisputeResolution",
+    "inputs": [
+        { "name": "contractAddress", "type": "address" },
+        { "name": "disputeId", "type": "hash" },
+        { "name": "resolution", "type": "string" },
+        { "name": "splitRate", "type": "uint64" }
+    ]
+  },{
+    "type": "function",
+    "name": "SignDisputeResolution",
+    "inputs": [
+        { "name": "contractAddress", "type": "address" },
+        { "name": "disputeId", "type": "hash" }
+    ]
   }
 ]
 `
@@ -133,12 +174,20 @@ const (
 	MethodNameRemoveNextStop    = "RemoveNextStop"
 	MethodNameUpdateNextStop    = "UpdateNextStop"
 	MethodNameRegisterAsset     = "RegisterAsset"
+	MethodNameUpdateRateCard    = "UpdateRateCard"
+
+	MethodNameOpenDispute              = "OpenDispute"
+	MethodNameAddDisputeEvidence       = "AddDisputeEvidence"
+	MethodNameProposeDisputeResolution = "ProposeDisputeResolution"
+	MethodNameSignDisputeResolution    = "SignDisputeResolution"
 )
 
 const (
 	ContractFlag byte = iota
 	AssetFlag
 	AddressMappingFlag
+	DisputeFlag
+	DisputeMappingFlag
 )
 
 var (
@@ -149,6 +198,11 @@ var (
 	contractPrefix   = append(contractaddress.SettlementAddress[:], ContractFlag)
 	assetKeyPrefix   = append(contractaddress.SettlementAddress[:], AssetFlag)
 	mappingPrefix    = append(contractaddress.SettlementAddress[:], AddressMappingFlag)
+
+	// disputes are saved by settlement contract address and dispute id, disputed CDRs are mapped to the dispute id
+	disputeKeySize       = keySize + types.HashSize + 1
+	disputePrefix        = append(contractaddress.SettlementAddress[:], DisputeFlag)
+	disputeMappingPrefix = append(contractaddress.SettlementAddress[:], DisputeMappingFlag)
 )
 
 func SaveContractParam(ctx *vmstore.VMContext, addr *types.Address, bts []byte) error {
@@ -481,8 +535,31 @@ func generateSummaryReport(store ledger.Store, addr *types.Address, fn func(stat
 	partyA := c.PartyA.Address
 	partyB := c.PartyB.Address
 
+	disputes, err := getDisputedCDRs(store, addr)
+	if err != nil {
+		return nil, err
+	}
+
 	addrs := []*types.Address{&partyA, &partyB}
 	for _, status := range records {
+		if hash, err := status.ToHash(); err == nil {
+			if d, ok := disputes[hash]; ok {
+				if sender, err := fn(status); err == nil {
+					result.Disputes.UpdateCounter(d.Status)
+					if state, ok := d.State(c, status); ok {
+						result.UpdateState(sender, "partyA", true, state)
+						result.UpdateState(sender, "partyB", true, state)
+						result.UpdateUsage(status, d.party(c), state)
+					}
+				}
+				continue
+			}
+		}
+
+		if _, err := fn(status); err == nil {
+			result.UpdateUsage(status, nil, status.Status == SettlementStatusSuccess)
+		}
+
 		isMatching := status.IsMatching(addrs)
 		//party A
 		if s1, b1, err := status.State(&partyA, fn); err == nil {
@@ -579,16 +656,52 @@ func generateInvoices(store ledger.Store, addr *types.Address, fn func(*CDRStatu
 		logger.Error(err)
 	}
 
-	cache := make(map[string]int)
-	if cdrs, err := GetCDRStatusByDate(store, &contractAddr, start, end); err == nil {
-		for _, cdr := range cdrs {
-			if cdr.Status == SettlementStatusSuccess {
+	disputes, err := getDisputedCDRs(store, &contractAddr)
+	if err != nil {
+		logger.Error(err)
+	}
+
+	var billable []*billableCDR
+	// CDRs of unresolved disputes are not charged but flagged in the invoices
+	disputed := make(map[chargeKey]uint64)
+	// CDRs of the billing period before start are counted in tiers and commitments but not charged again
+	from := start
+	if start != 0 && end != 0 {
+		from = billingPeriodOf(start).start
+	}
+	var cdrs []*CDRStatus
+	all, err := GetCDRStatusByDate(store, &contractAddr, from, end)
+	if err == nil {
+		for _, cdr := range all {
+			counted := !cdr.IsInCycle(start, end)
+			if !counted {
+				cdrs = append(cdrs, cdr)
+			}
+			hash, _ := cdr.ToHash()
+			state := cdr.Status == SettlementStatusSuccess
+			resolved := true
+			var ratio *big.Rat
+			var party *types.Address
+			if d, ok := disputes[hash]; ok {
+				state, resolved = d.State(c, cdr)
+				ratio = d.ratio()
+				party = d.party(c)
+			}
+			if !resolved {
+				if counted {
+					continue
+				}
 				if sender, err := fn(cdr); err == nil {
-					if _, ok := cache[sender]; ok {
-						cache[sender]++
-					} else {
-						cache[sender] = 1
-					}
+					kind, _ := cdr.ExtractUsage(nil)
+					disputed[chargeKey{customer: sender, kind: kind}]++
+				}
+			} else if state {
+				if sender, err := fn(cdr); err == nil {
+					dt, _, _, _ := cdr.ExtractID()
+					mcc, mnc := cdr.ExtractDestination()
+					kind, usage := cdr.ExtractUsage(party)
+					billable = append(billable, &billableCDR{customer: sender, dt: dt, mcc: mcc, mnc: mnc, hash: hash,
+						ratio: ratio, kind: kind, usage: usage, counted: counted})
 				}
 			}
 		}
@@ -596,29 +709,89 @@ func generateInvoices(store ledger.Store, addr *types.Address, fn func(*CDRStatu
 		logger.Error(err)
 	}
 
+	cards := c.GetRateCards()
+	charges, totals, err := chargeCDRs(cards, billable)
+	if err != nil {
+		logger.Errorf("%s: %s", contractAddr.String(), err)
+	}
+
 	// TODO: how to match service???
 	service := c.Services[0]
-	for k, v := range cache {
-		if v > 0 {
+	// rate cards of the contract are in the same currency
+	currency := cards[len(cards)-1].Currency
+	closed := end
+	if closed == 0 {
+		closed = time.Now().Unix()
+	}
+	shortfalls := commitmentShortfalls(cards, totals, c.StartDate, c.EndDate, start, closed)
+	addDisputed(charges, disputed)
+	for k, v := range charges {
+		if v.count > 0 || v.disputed > 0 {
+			sum, _ := v.amount.Float64()
+			var unitPrice float64
+			if v.quantity.Sign() > 0 {
+				unitPrice, _ = new(big.Rat).Quo(v.amount, v.quantity).Float64()
+			}
 			invoice := &InvoiceRecord{
-				Address:                  contractAddr,
-				StartDate:                c.StartDate,
-				EndDate:                  c.EndDate,
-				Customer:                 k,
-				CustomerSr:               "",
-				Country:                  "",
-				Operator:                 c.PartyB.Name,
-				ServiceId:                service.ServiceId,
-				MCC:                      service.Mcc,
-				MNC:                      service.Mnc,
-				Currency:                 service.Currency,
-				UnitPrice:                service.UnitPrice,
-				SumOfBillableSMSCustomer: uint64(v),
-				SumOfTOTPrice:            service.UnitPrice * float64(v),
+				Address:           contractAddr,
+				StartDate:         c.StartDate,
+				EndDate:           c.EndDate,
+				Customer:          k.customer,
+				CustomerSr:        "",
+				Country:           "",
+				Operator:          c.PartyB.Name,
+				ServiceId:         service.ServiceId,
+				MCC:               service.Mcc,
+				MNC:               service.Mnc,
+				Currency:          currency,
+				UnitPrice:         unitPrice,
+				Kind:              k.kind,
+				SumOfBillableCDRs: v.count,
+				Unit:              k.unit,
+				Quantity:          formatDecimal(v.quantity),
+				SumOfTOTPrice:     sum,
+				Charge:            formatDecimal(v.amount),
+				RateCardVersions:  v.versions,
+				SumOfDisputedSMS:  v.disputed,
+			}
+			if k.kind == CDRKindSMS {
+				invoice.SumOfBillableSMSCustomer = v.count
 			}
 			result = append(result, invoice)
 		}
 	}
+	// the shortfall records are in the billing periods clipped to the contract
+	for _, shortfall := range shortfalls {
+		sum, _ := shortfall.amount.Float64()
+		periodStart, periodEnd := shortfall.period.start, shortfall.period.end-1
+		if periodStart < c.StartDate {
+			periodStart = c.StartDate
+		}
+		if periodEnd > c.EndDate {
+			periodEnd = c.EndDate
+		}
+		result = append(result, &InvoiceRecord{
+			Address:       contractAddr,
+			StartDate:     periodStart,
+			EndDate:       periodEnd,
+			Customer:      InvoiceCustomerCommitment,
+			Operator:      c.PartyB.Name,
+			ServiceId:     service.ServiceId,
+			MCC:           service.Mcc,
+			MNC:           service.Mnc,
+			Currency:      shortfall.card.Currency,
+			SumOfTOTPrice: sum,
+			Charge:        formatDecimal(shortfall.amount),
+		})
+	}
+
+	var report *SLAReport
+	if asset, err := getSLAAsset(store, c, start, end); err == nil {
+		report = newSLAReport(contractAddr, asset, cdrs, start, end)
+	} else if err != ErrNoSLAAsset {
+		logger.Error(err)
+	}
+	applyCompensations(report, result)
 
 	if len(result) > 0 {
 		sort.Slice(result, func(i, j int) bool {
@@ -59,11 +59,17 @@ type Compensation struct {
 	Rate float32 `msg:"r" json:"rate"`
 }
 
+// SLAVersion is the version of SLAs whose latencies are in seconds, latencies of SLAs registered
+// before versioning(version 0) are in nanoseconds
+const SLAVersion = 1
+
+// SLA of an asset, Value and the brackets of Compensations are delivered rate(0~1) or latency(seconds)
 type SLA struct {
 	SLAType       SLAType         `msg:"t" json:"type"`
 	Priority      uint            `msg:"p" json:"priority"`
 	Value         float32         `msg:"v" json:"value"`
 	Compensations []*Compensation `msg:"c" json:"compensations,omitempty"`
+	Version       uint            `msg:"ver,omitempty" json:"version,omitempty"`
 }
 
 func (z *SLA) Serialize() ([]byte, error) {
@@ -85,6 +91,7 @@ func NewDeliveredRate(rate float32, c []*Compensation) *SLA {
 		Priority:      1,
 		Value:         rate,
 		Compensations: c,
+		Version:       SLAVersion,
 	}
 }
 
@@ -92,8 +99,9 @@ func NewLatency(latency time.Duration, c []*Compensation) *SLA {
 	return &SLA{
 		SLAType:       SLATypeLatency,
 		Priority:      0,
-		Value:         float32(latency),
+		Value:         float32(latency.Seconds()),
 		Compensations: c,
+		Version:       SLAVersion,
 	}
 }
 
@@ -927,6 +927,12 @@ func (z *SLA) DecodeMsg(dc *msgp.Reader) (err error) {
 					}
 				}
 			}
+		case "ver":
+			z.Version, err = dc.ReadUint()
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -940,9 +946,23 @@ func (z *SLA) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *SLA) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 4
+	// omitempty: check for empty values
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	if z.Version == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+	if zb0001Len == 0 {
+		return
+	}
 	// write "t"
-	err = en.Append(0x84, 0xa1, 0x74)
+	err = en.Append(0xa1, 0x74)
 	if err != nil {
 		return
 	}
@@ -1021,15 +1041,38 @@ func (z *SLA) EncodeMsg(en *msgp.Writer) (err error) {
 			}
 		}
 	}
+	if (zb0001Mask & 0x10) == 0 { // if not empty
+		// write "ver"
+		err = en.Append(0xa3, 0x76, 0x65, 0x72)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint(z.Version)
+		if err != nil {
+			err = msgp.WrapError(err, "Version")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *SLA) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 4
+	// omitempty: check for empty values
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	if z.Version == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len == 0 {
+		return
+	}
 	// string "t"
-	o = append(o, 0x84, 0xa1, 0x74)
+	o = append(o, 0xa1, 0x74)
 	o = msgp.AppendInt(o, int(z.SLAType))
 	// string "p"
 	o = append(o, 0xa1, 0x70)
@@ -1056,6 +1099,11 @@ func (z *SLA) MarshalMsg(b []byte) (o []byte, err error) {
 			o = msgp.AppendFloat32(o, z.Compensations[za0001].Rate)
 		}
 	}
+	if (zb0001Mask & 0x10) == 0 { // if not empty
+		// string "ver"
+		o = append(o, 0xa3, 0x76, 0x65, 0x72)
+		o = msgp.AppendUint(o, z.Version)
+	}
 	return
 }
 
@@ -1164,6 +1212,12 @@ func (z *SLA) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					}
 				}
 			}
+		case "ver":
+			z.Version, bts, err = msgp.ReadUintBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -1186,6 +1240,7 @@ func (z *SLA) Msgsize() (s int) {
 			s += 1 + 2 + msgp.Float32Size + 2 + msgp.Float32Size + 2 + msgp.Float32Size
 		}
 	}
+	s += 4 + msgp.UintSize
 	return
 }
 
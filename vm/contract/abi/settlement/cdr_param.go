@@ -8,12 +8,25 @@
 package settlement
 
 import (
+	"errors"
+	"fmt"
+
 	"gopkg.in/validator.v2"
 
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
 )
 
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+SMS
+Voice
+Data
+)
+*/
+type CDRKind int
+
 //go:generate go-enum -f=$GOFILE --marshal --names
 /*
 ENUM(
@@ -48,6 +61,12 @@ type CDRParam struct {
 	DlrStatus     DLRStatus     `msg:"ds" json:"dlrStatus"`
 	PreStop       string        `msg:"ps" json:"preStop"`
 	NextStop      string        `msg:"ns" json:"nextStop"`
+	DstMcc        uint64        `msg:"dmcc" json:"dstMcc"`
+	DstMnc        uint64        `msg:"dmnc" json:"dstMnc"`
+	// CDRs without kind are SMS, SmsDt is the start time of calls and data sessions
+	Kind     CDRKind `msg:"k,omitempty" json:"kind"`
+	Duration uint64  `msg:"du,omitempty" json:"duration,omitempty"` // seconds of the call or the data session
+	Volume   uint64  `msg:"vo,omitempty" json:"volume,omitempty"`   // bytes of the data session
 }
 
 func (z *CDRParam) String() string {
@@ -55,6 +74,14 @@ func (z *CDRParam) String() string {
 }
 
 func (z *CDRParam) Status() bool {
+	switch z.Kind {
+	case CDRKindVoice:
+		// only answered calls are billable
+		return z.SendingStatus == SendingStatusSent && z.Duration > 0
+	case CDRKindData:
+		return z.SendingStatus == SendingStatusSent && z.Volume > 0
+	}
+
 	switch z.DlrStatus {
 	case DLRStatusDelivered:
 		return true
@@ -77,9 +104,34 @@ func (z *CDRParam) Verify() error {
 	if errs := validator.Validate(z); errs != nil {
 		return errs
 	}
+	switch z.Kind {
+	case CDRKindSMS:
+		if z.Duration != 0 || z.Volume != 0 {
+			return errors.New("SMS CDR should not have duration or volume")
+		}
+	case CDRKindVoice:
+		if z.Volume != 0 {
+			return errors.New("voice CDR should not have volume")
+		}
+	case CDRKindData:
+	default:
+		return fmt.Errorf("invalid CDR kind %d", z.Kind)
+	}
 	return nil
 }
 
+// Usage returns the billed usage of the CDR, it is the duration of voice calls and the volume of data sessions
+func (z *CDRParam) Usage() uint64 {
+	switch z.Kind {
+	case CDRKindVoice:
+		return z.Duration
+	case CDRKindData:
+		return z.Volume
+	default:
+		return 1
+	}
+}
+
 func (z *CDRParam) ToHash() (types.Hash, error) {
 	return types.HashBytes(util.BE_Uint64ToBytes(z.Index), []byte(z.Sender), []byte(z.Destination))
 }
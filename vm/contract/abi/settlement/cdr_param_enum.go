@@ -8,6 +8,74 @@ import (
 	"strings"
 )
 
+const (
+	// CDRKindSMS is a CDRKind of type SMS
+	CDRKindSMS CDRKind = iota
+	// CDRKindVoice is a CDRKind of type Voice
+	CDRKindVoice
+	// CDRKindData is a CDRKind of type Data
+	CDRKindData
+)
+
+const _CDRKindName = "SMSVoiceData"
+
+var _CDRKindNames = []string{
+	_CDRKindName[0:3],
+	_CDRKindName[3:8],
+	_CDRKindName[8:12],
+}
+
+// CDRKindNames returns a list of possible string values of CDRKind.
+func CDRKindNames() []string {
+	tmp := make([]string, len(_CDRKindNames))
+	copy(tmp, _CDRKindNames)
+	return tmp
+}
+
+var _CDRKindMap = map[CDRKind]string{
+	0: _CDRKindName[0:3],
+	1: _CDRKindName[3:8],
+	2: _CDRKindName[8:12],
+}
+
+// String implements the Stringer interface.
+func (x CDRKind) String() string {
+	if str, ok := _CDRKindMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("CDRKind(%d)", x)
+}
+
+var _CDRKindValue = map[string]CDRKind{
+	_CDRKindName[0:3]:  0,
+	_CDRKindName[3:8]:  1,
+	_CDRKindName[8:12]: 2,
+}
+
+// ParseCDRKind attempts to convert a string to a CDRKind
+func ParseCDRKind(name string) (CDRKind, error) {
+	if x, ok := _CDRKindValue[name]; ok {
+		return x, nil
+	}
+	return CDRKind(0), fmt.Errorf("%s is not a valid CDRKind, try [%s]", name, strings.Join(_CDRKindNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x CDRKind) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *CDRKind) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseCDRKind(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
 const (
 	// DLRStatusDelivered is a DLRStatus of type Delivered
 	DLRStatusDelivered DLRStatus = iota
@@ -291,3 +291,52 @@ func TestSendingStatus_UnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCDRKind(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    CDRKind
+		wantErr bool
+	}{
+		{
+			name:    "Voice",
+			want:    CDRKindVoice,
+			wantErr: false,
+		}, {
+			name:    "voice",
+			want:    CDRKindSMS,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCDRKind(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCDRKind() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseCDRKind() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDRKind_MarshalText(t *testing.T) {
+	if got := CDRKindNames(); !reflect.DeepEqual(got, []string{"SMS", "Voice", "Data"}) {
+		t.Errorf("CDRKindNames() = %v", got)
+	}
+	for _, x := range []CDRKind{CDRKindSMS, CDRKindVoice, CDRKindData} {
+		text, err := x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var k CDRKind
+		if err := k.UnmarshalText(text); err != nil || k != x {
+			t.Fatalf("UnmarshalText() got = %v, want %v, %v", k, x, err)
+		}
+	}
+	if s := CDRKind(3).String(); s != "CDRKind(3)" {
+		t.Errorf("String() got = %s", s)
+	}
+}
@@ -6,6 +6,58 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// DecodeMsg implements msgp.Decodable
+func (z *CDRKind) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = CDRKind(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z CDRKind) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z CDRKind) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CDRKind) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = CDRKind(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z CDRKind) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *CDRParam) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -92,6 +144,40 @@ func (z *CDRParam) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "NextStop")
 				return
 			}
+		case "dmcc":
+			z.DstMcc, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "DstMcc")
+				return
+			}
+		case "dmnc":
+			z.DstMnc, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "DstMnc")
+				return
+			}
+		case "k":
+			{
+				var zb0004 int
+				zb0004, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Kind")
+					return
+				}
+				z.Kind = CDRKind(zb0004)
+			}
+		case "du":
+			z.Duration, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Duration")
+				return
+			}
+		case "vo":
+			z.Volume, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Volume")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -105,9 +191,31 @@ func (z *CDRParam) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *CDRParam) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 10
+	// omitempty: check for empty values
+	zb0001Len := uint32(15)
+	var zb0001Mask uint16 /* 15 bits */
+	if z.Kind == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	if z.Duration == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2000
+	}
+	if z.Volume == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+	if zb0001Len == 0 {
+		return
+	}
 	// write "i"
-	err = en.Append(0x8a, 0xa1, 0x69)
+	err = en.Append(0xa1, 0x69)
 	if err != nil {
 		return
 	}
@@ -206,15 +314,90 @@ func (z *CDRParam) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "NextStop")
 		return
 	}
+	// write "dmcc"
+	err = en.Append(0xa4, 0x64, 0x6d, 0x63, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.DstMcc)
+	if err != nil {
+		err = msgp.WrapError(err, "DstMcc")
+		return
+	}
+	// write "dmnc"
+	err = en.Append(0xa4, 0x64, 0x6d, 0x6e, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.DstMnc)
+	if err != nil {
+		err = msgp.WrapError(err, "DstMnc")
+		return
+	}
+	if (zb0001Mask & 0x1000) == 0 { // if not empty
+		// write "k"
+		err = en.Append(0xa1, 0x6b)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(int(z.Kind))
+		if err != nil {
+			err = msgp.WrapError(err, "Kind")
+			return
+		}
+	}
+	if (zb0001Mask & 0x2000) == 0 { // if not empty
+		// write "du"
+		err = en.Append(0xa2, 0x64, 0x75)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.Duration)
+		if err != nil {
+			err = msgp.WrapError(err, "Duration")
+			return
+		}
+	}
+	if (zb0001Mask & 0x4000) == 0 { // if not empty
+		// write "vo"
+		err = en.Append(0xa2, 0x76, 0x6f)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.Volume)
+		if err != nil {
+			err = msgp.WrapError(err, "Volume")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *CDRParam) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 10
+	// omitempty: check for empty values
+	zb0001Len := uint32(15)
+	var zb0001Mask uint16 /* 15 bits */
+	if z.Kind == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	if z.Duration == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2000
+	}
+	if z.Volume == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len == 0 {
+		return
+	}
 	// string "i"
-	o = append(o, 0x8a, 0xa1, 0x69)
+	o = append(o, 0xa1, 0x69)
 	o = msgp.AppendUint64(o, z.Index)
 	// string "dt"
 	o = append(o, 0xa2, 0x64, 0x74)
@@ -243,6 +426,27 @@ func (z *CDRParam) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "ns"
 	o = append(o, 0xa2, 0x6e, 0x73)
 	o = msgp.AppendString(o, z.NextStop)
+	// string "dmcc"
+	o = append(o, 0xa4, 0x64, 0x6d, 0x63, 0x63)
+	o = msgp.AppendUint64(o, z.DstMcc)
+	// string "dmnc"
+	o = append(o, 0xa4, 0x64, 0x6d, 0x6e, 0x63)
+	o = msgp.AppendUint64(o, z.DstMnc)
+	if (zb0001Mask & 0x1000) == 0 { // if not empty
+		// string "k"
+		o = append(o, 0xa1, 0x6b)
+		o = msgp.AppendInt(o, int(z.Kind))
+	}
+	if (zb0001Mask & 0x2000) == 0 { // if not empty
+		// string "du"
+		o = append(o, 0xa2, 0x64, 0x75)
+		o = msgp.AppendUint64(o, z.Duration)
+	}
+	if (zb0001Mask & 0x4000) == 0 { // if not empty
+		// string "vo"
+		o = append(o, 0xa2, 0x76, 0x6f)
+		o = msgp.AppendUint64(o, z.Volume)
+	}
 	return
 }
 
@@ -332,6 +536,40 @@ func (z *CDRParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "NextStop")
 				return
 			}
+		case "dmcc":
+			z.DstMcc, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DstMcc")
+				return
+			}
+		case "dmnc":
+			z.DstMnc, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DstMnc")
+				return
+			}
+		case "k":
+			{
+				var zb0004 int
+				zb0004, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Kind")
+					return
+				}
+				z.Kind = CDRKind(zb0004)
+			}
+		case "du":
+			z.Duration, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Duration")
+				return
+			}
+		case "vo":
+			z.Volume, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Volume")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -346,7 +584,7 @@ func (z *CDRParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *CDRParam) Msgsize() (s int) {
-	s = 1 + 2 + msgp.Uint64Size + 3 + msgp.Int64Size + 3 + msgp.StringPrefixSize + len(z.Account) + 3 + msgp.StringPrefixSize + len(z.Sender) + 2 + msgp.StringPrefixSize + len(z.Customer) + 2 + msgp.StringPrefixSize + len(z.Destination) + 2 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.PreStop) + 3 + msgp.StringPrefixSize + len(z.NextStop)
+	s = 1 + 2 + msgp.Uint64Size + 3 + msgp.Int64Size + 3 + msgp.StringPrefixSize + len(z.Account) + 3 + msgp.StringPrefixSize + len(z.Sender) + 2 + msgp.StringPrefixSize + len(z.Customer) + 2 + msgp.StringPrefixSize + len(z.Destination) + 2 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.PreStop) + 3 + msgp.StringPrefixSize + len(z.NextStop) + 5 + msgp.Uint64Size + 5 + msgp.Uint64Size + 2 + msgp.IntSize + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size
 	return
 }
 
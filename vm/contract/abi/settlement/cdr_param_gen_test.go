@@ -74,7 +74,7 @@ func TestEncodeDecodeCDRParam(t *testing.T) {
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Logf("WARNING: Msgsize() for %v is inaccurate", v)
+		t.Log("WARNING: TestEncodeDecodeCDRParam Msgsize() is inaccurate")
 	}
 
 	vn := CDRParam{}
@@ -187,7 +187,7 @@ func TestEncodeDecodeCDRParamList(t *testing.T) {
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Logf("WARNING: Msgsize() for %v is inaccurate", v)
+		t.Log("WARNING: TestEncodeDecodeCDRParamList Msgsize() is inaccurate")
 	}
 
 	vn := CDRParamList{}
@@ -669,3 +669,53 @@ func TestCDRParamList_Verify(t *testing.T) {
 		})
 	}
 }
+
+func TestCDRParam_Kind(t *testing.T) {
+	voice := cdrParam
+	voice.Kind = CDRKindVoice
+	voice.Duration = 61
+	if err := voice.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if !voice.Status() || voice.Usage() != 61 {
+		t.Fatal(voice.Status(), voice.Usage())
+	}
+	voice.Duration = 0
+	if voice.Status() {
+		t.Fatal("unanswered call should not be billable")
+	}
+	voice.Volume = 100
+	if err := voice.Verify(); err == nil {
+		t.Fatal("voice CDR should not have volume")
+	}
+
+	data := cdrParam
+	data.Kind = CDRKindData
+	data.Duration = 300
+	data.Volume = 2048
+	if err := data.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if !data.Status() || data.Usage() != 2048 {
+		t.Fatal(data.Status(), data.Usage())
+	}
+	data.SendingStatus = SendingStatusError
+	if data.Status() {
+		t.Fatal("failed data session should not be billable")
+	}
+
+	sms := cdrParam
+	if sms.Usage() != 1 {
+		t.Fatal(sms.Usage())
+	}
+	sms.Duration = 1
+	if err := sms.Verify(); err == nil {
+		t.Fatal("SMS CDR should not have duration")
+	}
+
+	sms = cdrParam
+	sms.Kind = CDRKind(10)
+	if err := sms.Verify(); err == nil {
+		t.Fatal("invalid kind should be reported")
+	}
+}
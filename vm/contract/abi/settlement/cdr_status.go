@@ -10,6 +10,7 @@ package settlement
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
@@ -27,6 +28,40 @@ var (
 	}
 )
 
+// matchingRule is the tolerance of the usage reported by the parties, which is the larger one of the absolute
+// value and the per mille of the larger usage
+type matchingRule struct {
+	abs      uint64
+	permille uint64
+}
+
+// matchingRules of the CDR kinds with usage, SMS are matched by their statuses only
+var matchingRules = map[CDRKind]matchingRule{
+	CDRKindVoice: {abs: 1, permille: 10},    // 1 second or 1%
+	CDRKindData:  {abs: 1024, permille: 10}, // 1 KB or 1%
+}
+
+// matchUsage checks whether the CDRs of the parties are the same kind and their usage is within the tolerance
+func matchUsage(p1, p2 *CDRParam) bool {
+	if p1.Kind != p2.Kind {
+		return false
+	}
+	rule, ok := matchingRules[p1.Kind]
+	if !ok {
+		return true
+	}
+	u1, u2 := p1.Usage(), p2.Usage()
+	diff, max := u1-u2, u1
+	if u2 > u1 {
+		diff, max = u2-u1, u2
+	}
+	tolerance := max / 1000 * rule.permille
+	if tolerance < rule.abs {
+		tolerance = rule.abs
+	}
+	return diff <= tolerance
+}
+
 //go:generate go-enum -f=$GOFILE --marshal --names
 /*
 ENUM(
@@ -36,6 +71,7 @@ success
 failure
 missing
 duplicate
+mismatch
 )
 */
 type SettlementStatus int
@@ -189,6 +225,43 @@ func (z *CDRStatus) ExtractAccount() (dt int64, account, destination string, err
 	return 0, "", "", errors.New("can not find any CDR param")
 }
 
+// ExtractDestination fetch the mcc/mnc of the destination reported by any party, they are 0 if not reported
+func (z *CDRStatus) ExtractDestination() (mcc, mnc uint64) {
+	for _, params := range z.Params {
+		for _, param := range params {
+			if param.DstMcc != 0 || param.DstMnc != 0 {
+				return param.DstMcc, param.DstMnc
+			}
+		}
+	}
+	return 0, 0
+}
+
+// ExtractUsage fetch the kind and the billed usage of the CDR, it is the usage reported by addr if it is not nil,
+// otherwise the smaller one reported by the parties
+func (z *CDRStatus) ExtractUsage(addr *types.Address) (kind CDRKind, usage uint64) {
+	if addr != nil {
+		if params, ok := z.Params[addr.String()]; ok && len(params) > 0 {
+			return params[0].Kind, params[0].Usage()
+		}
+	}
+
+	parties := make([]string, 0, len(z.Params))
+	for k := range z.Params {
+		parties = append(parties, k)
+	}
+	sort.Strings(parties)
+	found := false
+	for _, party := range parties {
+		if params := z.Params[party]; len(params) > 0 {
+			if u := params[0].Usage(); !found || u < usage {
+				kind, usage, found = params[0].Kind, u, true
+			}
+		}
+	}
+	return
+}
+
 // DoSettlement process settlement
 // @param cdr  cdr data
 func (z *CDRStatus) DoSettlement(cdr SettlementCDR) (err error) {
@@ -230,6 +303,14 @@ func (z *CDRStatus) DoSettlement(cdr SettlementCDR) (err error) {
 		}
 		if !b {
 			z.Status = SettlementStatusFailure
+		} else {
+			var reported []*CDRParam
+			for _, params := range z.Params {
+				reported = append(reported, &params[0])
+			}
+			if !matchUsage(reported[0], reported[1]) {
+				z.Status = SettlementStatusMismatch
+			}
 		}
 	case size > 2:
 		err = fmt.Errorf("invalid params size %d", size)
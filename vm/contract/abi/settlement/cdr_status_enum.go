@@ -21,9 +21,11 @@ const (
 	SettlementStatusMissing
 	// SettlementStatusDuplicate is a SettlementStatus of type Duplicate
 	SettlementStatusDuplicate
+	// SettlementStatusMismatch is a SettlementStatus of type Mismatch
+	SettlementStatusMismatch
 )
 
-const _SettlementStatusName = "unknownstage1successfailuremissingduplicate"
+const _SettlementStatusName = "unknownstage1successfailuremissingduplicatemismatch"
 
 var _SettlementStatusNames = []string{
 	_SettlementStatusName[0:7],
@@ -32,6 +34,7 @@ var _SettlementStatusNames = []string{
 	_SettlementStatusName[20:27],
 	_SettlementStatusName[27:34],
 	_SettlementStatusName[34:43],
+	_SettlementStatusName[43:51],
 }
 
 // SettlementStatusNames returns a list of possible string values of SettlementStatus.
@@ -48,6 +51,7 @@ var _SettlementStatusMap = map[SettlementStatus]string{
 	3: _SettlementStatusName[20:27],
 	4: _SettlementStatusName[27:34],
 	5: _SettlementStatusName[34:43],
+	6: _SettlementStatusName[43:51],
 }
 
 // String implements the Stringer interface.
@@ -65,6 +69,7 @@ var _SettlementStatusValue = map[string]SettlementStatus{
 	_SettlementStatusName[20:27]: 3,
 	_SettlementStatusName[27:34]: 4,
 	_SettlementStatusName[34:43]: 5,
+	_SettlementStatusName[43:51]: 6,
 }
 
 // ParseSettlementStatus attempts to convert a string to a SettlementStatus
@@ -59,7 +59,7 @@ func TestSettlementStatusNames(t *testing.T) {
 	}{
 		{
 			name: "ok",
-			want: []string{"unknown", "stage1", "success", "failure", "missing", "duplicate"},
+			want: []string{"unknown", "stage1", "success", "failure", "missing", "duplicate", "mismatch"},
 		},
 	}
 	for _, tt := range tests {
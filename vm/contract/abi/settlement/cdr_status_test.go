@@ -813,3 +813,62 @@ func TestCDRStatus_IsMatching(t *testing.T) {
 		})
 	}
 }
+
+func TestCDRStatus_DoSettlementUsage(t *testing.T) {
+	voice := cdrParam
+	voice.Kind = CDRKindVoice
+
+	tests := []struct {
+		name   string
+		p1, p2 func(p *CDRParam)
+		status SettlementStatus
+	}{
+		{name: "voice in tolerance", p1: func(p *CDRParam) { p.Duration = 120 }, p2: func(p *CDRParam) { p.Duration = 121 }, status: SettlementStatusSuccess},
+		{name: "voice in permille", p1: func(p *CDRParam) { p.Duration = 3000 }, p2: func(p *CDRParam) { p.Duration = 2970 }, status: SettlementStatusSuccess},
+		{name: "voice mismatch", p1: func(p *CDRParam) { p.Duration = 120 }, p2: func(p *CDRParam) { p.Duration = 125 }, status: SettlementStatusMismatch},
+		{name: "data in tolerance", p1: func(p *CDRParam) { p.Kind, p.Volume = CDRKindData, 1000000 }, p2: func(p *CDRParam) { p.Kind, p.Volume = CDRKindData, 1005000 }, status: SettlementStatusSuccess},
+		{name: "data mismatch", p1: func(p *CDRParam) { p.Kind, p.Volume = CDRKindData, 1000000 }, p2: func(p *CDRParam) { p.Kind, p.Volume = CDRKindData, 1020000 }, status: SettlementStatusMismatch},
+		{name: "kind mismatch", p1: func(p *CDRParam) { p.Duration = 60 }, p2: func(p *CDRParam) { p.Kind, p.Duration, p.Volume = CDRKindData, 60, 1024 }, status: SettlementStatusMismatch},
+		{name: "unanswered", p1: func(p *CDRParam) { p.Duration = 0 }, p2: func(p *CDRParam) { p.Duration = 60 }, status: SettlementStatusFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1, p2 := voice, voice
+			tt.p1(&p1)
+			tt.p2(&p2)
+			z := &CDRStatus{}
+			if err := z.DoSettlement(SettlementCDR{CDRParam: p1, From: mock.Address()}); err != nil {
+				t.Fatal(err)
+			}
+			if err := z.DoSettlement(SettlementCDR{CDRParam: p2, From: mock.Address()}); err != nil {
+				t.Fatal(err)
+			}
+			if z.Status != tt.status {
+				t.Fatalf("exp: %s, act: %s", tt.status, z.Status)
+			}
+		})
+	}
+}
+
+func TestCDRStatus_ExtractUsage(t *testing.T) {
+	a1 := mock.Address()
+	a2 := mock.Address()
+	p1, p2 := cdrParam, cdrParam
+	p1.Kind, p1.Duration = CDRKindVoice, 61
+	p2.Kind, p2.Duration = CDRKindVoice, 60
+	z := &CDRStatus{Params: map[string][]CDRParam{a1.String(): {p1}, a2.String(): {p2}}}
+
+	if kind, usage := z.ExtractUsage(&a1); kind != CDRKindVoice || usage != 61 {
+		t.Fatal(kind, usage)
+	}
+	if kind, usage := z.ExtractUsage(nil); kind != CDRKindVoice || usage != 60 {
+		t.Fatal(kind, usage)
+	}
+	a3 := mock.Address()
+	if _, usage := z.ExtractUsage(&a3); usage != 60 {
+		t.Fatal(usage)
+	}
+	if kind, usage := (&CDRStatus{}).ExtractUsage(nil); kind != CDRKindSMS || usage != 0 {
+		t.Fatal(kind, usage)
+	}
+}
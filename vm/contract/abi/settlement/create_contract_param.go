@@ -62,6 +62,7 @@ type CreateContractParam struct {
 	SignDate  int64             `msg:"t1" json:"signDate"`
 	StartDate int64             `msg:"t3" json:"startDate"`
 	EndDate   int64             `msg:"t4" json:"endDate"`
+	RateCards []*RateCard       `msg:"rc" json:"rateCards,omitempty"`
 	//SignatureA *types.Signature  `msg:"sa,extension" json:"signatureA"`
 }
 
@@ -104,6 +105,10 @@ func (z *CreateContractParam) Verify() (bool, error) {
 		return false, fmt.Errorf("invalid end date, should bigger than %d, got: %d", z.StartDate, z.EndDate)
 	}
 
+	if err := verifyRateCards(z.RateCards, z.StartDate, z.EndDate); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -169,6 +174,13 @@ func (z *CreateContractParam) Address() (types.Address, error) {
 		}
 	}
 	result = append(result, util.BE_Int2Bytes(z.SignDate)...)
+	for _, c := range z.RateCards {
+		if data, err := c.ToABI(); err != nil {
+			return types.ZeroAddress, err
+		} else {
+			result = append(result, data...)
+		}
+	}
 
 	hash := types.HashData(result)
 
@@ -359,6 +359,37 @@ func (z *CreateContractParam) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "EndDate")
 				return
 			}
+		case "rc":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "RateCards")
+				return
+			}
+			if cap(z.RateCards) >= int(zb0003) {
+				z.RateCards = (z.RateCards)[:zb0003]
+			} else {
+				z.RateCards = make([]*RateCard, zb0003)
+			}
+			for za0002 := range z.RateCards {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "RateCards", za0002)
+						return
+					}
+					z.RateCards[za0002] = nil
+				} else {
+					if z.RateCards[za0002] == nil {
+						z.RateCards[za0002] = new(RateCard)
+					}
+					err = z.RateCards[za0002].DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "RateCards", za0002)
+						return
+					}
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -372,9 +403,9 @@ func (z *CreateContractParam) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *CreateContractParam) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 7
+	// map header, size 8
 	// write "pa"
-	err = en.Append(0x87, 0xa2, 0x70, 0x61)
+	err = en.Append(0x88, 0xa2, 0x70, 0x61)
 	if err != nil {
 		return
 	}
@@ -450,15 +481,39 @@ func (z *CreateContractParam) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "EndDate")
 		return
 	}
+	// write "rc"
+	err = en.Append(0xa2, 0x72, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.RateCards)))
+	if err != nil {
+		err = msgp.WrapError(err, "RateCards")
+		return
+	}
+	for za0002 := range z.RateCards {
+		if z.RateCards[za0002] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.RateCards[za0002].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "RateCards", za0002)
+				return
+			}
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *CreateContractParam) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 7
+	// map header, size 8
 	// string "pa"
-	o = append(o, 0x87, 0xa2, 0x70, 0x61)
+	o = append(o, 0x88, 0xa2, 0x70, 0x61)
 	o, err = z.PartyA.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "PartyA")
@@ -497,6 +552,20 @@ func (z *CreateContractParam) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "t4"
 	o = append(o, 0xa2, 0x74, 0x34)
 	o = msgp.AppendInt64(o, z.EndDate)
+	// string "rc"
+	o = append(o, 0xa2, 0x72, 0x63)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.RateCards)))
+	for za0002 := range z.RateCards {
+		if z.RateCards[za0002] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.RateCards[za0002].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "RateCards", za0002)
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -573,6 +642,36 @@ func (z *CreateContractParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "EndDate")
 				return
 			}
+		case "rc":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RateCards")
+				return
+			}
+			if cap(z.RateCards) >= int(zb0003) {
+				z.RateCards = (z.RateCards)[:zb0003]
+			} else {
+				z.RateCards = make([]*RateCard, zb0003)
+			}
+			for za0002 := range z.RateCards {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.RateCards[za0002] = nil
+				} else {
+					if z.RateCards[za0002] == nil {
+						z.RateCards[za0002] = new(RateCard)
+					}
+					bts, err = z.RateCards[za0002].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "RateCards", za0002)
+						return
+					}
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -591,6 +690,13 @@ func (z *CreateContractParam) Msgsize() (s int) {
 	for za0001 := range z.Services {
 		s += z.Services[za0001].Msgsize()
 	}
-	s += 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size
+	s += 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.ArrayHeaderSize
+	for za0002 := range z.RateCards {
+		if z.RateCards[za0002] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.RateCards[za0002].Msgsize()
+		}
+	}
 	return
 }
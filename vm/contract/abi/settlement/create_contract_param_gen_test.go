@@ -74,7 +74,7 @@ func TestEncodeDecodeContractService(t *testing.T) {
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Logf("WARNING: Msgsize() for %v is inaccurate", v)
+		t.Log("WARNING: TestEncodeDecodeContractService Msgsize() is inaccurate")
 	}
 
 	vn := ContractService{}
@@ -187,7 +187,7 @@ func TestEncodeDecodeCreateContractParam(t *testing.T) {
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Logf("WARNING: Msgsize() for %v is inaccurate", v)
+		t.Log("WARNING: TestEncodeDecodeCreateContractParam Msgsize() is inaccurate")
 	}
 
 	vn := CreateContractParam{}
@@ -0,0 +1,372 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+// maxDisputeCDRs is the max count of CDRs which can be disputed in a batch
+const maxDisputeCDRs = 1000
+
+var ErrCDRDisputed = errors.New("CDR is already disputed")
+
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+Open
+Proposed
+Resolved
+)
+*/
+type DisputeStatus int
+
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+None
+AcceptPartyA
+AcceptPartyB
+Split
+)
+*/
+type DisputeResolution int
+
+//go:generate msgp
+type Evidence struct {
+	From        types.Address `msg:"f" json:"from"`
+	Description string        `msg:"d" json:"description" validate:"nonzero"`
+	Hash        types.Hash    `msg:"h" json:"hash"` // hash of the attached document, such as the original CDR files or emails
+	Date        int64         `msg:"t" json:"date"`
+}
+
+//go:generate msgp
+type Dispute struct {
+	ContractAddress types.Address     `msg:"ca" json:"contractAddress"`
+	ID              types.Hash        `msg:"id" json:"id"`
+	CDRs            []types.Hash      `msg:"cdrs" json:"cdrs"`
+	Opener          types.Address     `msg:"o" json:"opener"`
+	Reason          string            `msg:"r" json:"reason"`
+	Status          DisputeStatus     `msg:"s" json:"status"`
+	Evidences       []*Evidence       `msg:"e" json:"evidences,omitempty"`
+	Resolution      DisputeResolution `msg:"rs" json:"resolution"`
+	SplitRate       uint64            `msg:"sr" json:"splitRate,omitempty"` // percent of the disputed CDRs charged by split resolution
+	Proposer        types.Address     `msg:"p" json:"proposer"`
+	SignedA         bool              `msg:"sa" json:"signedA"`
+	SignedB         bool              `msg:"sb" json:"signedB"`
+	OpenDate        int64             `msg:"t1" json:"openDate"`
+	ResolveDate     int64             `msg:"t2" json:"resolveDate,omitempty"`
+}
+
+func (z *Dispute) ToABI() ([]byte, error) {
+	return z.MarshalMsg(nil)
+}
+
+func (z *Dispute) FromABI(data []byte) error {
+	_, err := z.UnmarshalMsg(data)
+	return err
+}
+
+func (z *Dispute) String() string {
+	return util.ToIndentString(z)
+}
+
+// AddEvidence attaches the evidence to the dispute before it is resolved
+func (z *Dispute) AddEvidence(evidence *Evidence) error {
+	if z.Status == DisputeStatusResolved {
+		return fmt.Errorf("dispute %s is already resolved", z.ID.String())
+	}
+	z.Evidences = append(z.Evidences, evidence)
+	return nil
+}
+
+// Propose replaces the current proposal of the resolution, which is signed by the proposer
+func (z *Dispute) Propose(c *ContractParam, proposer types.Address, resolution DisputeResolution, splitRate uint64) error {
+	if z.Status == DisputeStatusResolved {
+		return fmt.Errorf("dispute %s is already resolved", z.ID.String())
+	}
+	z.Status = DisputeStatusProposed
+	z.Resolution = resolution
+	z.SplitRate = splitRate
+	z.Proposer = proposer
+	z.SignedA = proposer == c.PartyA.Address
+	z.SignedB = proposer == c.PartyB.Address
+	return nil
+}
+
+// Sign signs off the proposal, the dispute is resolved when both parties have signed
+func (z *Dispute) Sign(c *ContractParam, addr types.Address, now int64) error {
+	if z.Status != DisputeStatusProposed {
+		return fmt.Errorf("invalid dispute status, %s", z.Status.String())
+	}
+	switch addr {
+	case c.PartyA.Address:
+		if z.SignedA {
+			return fmt.Errorf("%s has already signed", addr.String())
+		}
+		z.SignedA = true
+	case c.PartyB.Address:
+		if z.SignedB {
+			return fmt.Errorf("%s has already signed", addr.String())
+		}
+		z.SignedB = true
+	default:
+		return fmt.Errorf("permission denied, %s is not the contractor", addr.String())
+	}
+	if z.SignedA && z.SignedB {
+		z.Status = DisputeStatusResolved
+		z.ResolveDate = now
+	}
+	return nil
+}
+
+// IsResolved checks the dispute is signed off by both parties
+func (z *Dispute) IsResolved() bool {
+	return z.Status == DisputeStatusResolved
+}
+
+// State returns the state of the disputed CDR by the resolution, ok is false if it is not resolved
+func (z *Dispute) State(c *ContractParam, status *CDRStatus) (state, ok bool) {
+	if !z.IsResolved() {
+		return false, false
+	}
+	if z.Resolution == DisputeResolutionSplit {
+		return true, true
+	}
+	addr := z.party(c)
+	if addr == nil {
+		return false, false
+	}
+	if params, ok := status.Params[addr.String()]; ok && len(params) == 1 {
+		return params[0].Status(), true
+	}
+	return false, true
+}
+
+// party returns the party whose CDR is accepted by the resolution, nil if none
+func (z *Dispute) party(c *ContractParam) *types.Address {
+	switch z.Resolution {
+	case DisputeResolutionAcceptPartyA:
+		return &c.PartyA.Address
+	case DisputeResolutionAcceptPartyB:
+		return &c.PartyB.Address
+	default:
+		return nil
+	}
+}
+
+// ratio returns the charged ratio of the disputed CDRs, nil means the full price
+func (z *Dispute) ratio() *big.Rat {
+	if z.Resolution == DisputeResolutionSplit {
+		return big.NewRat(int64(z.SplitRate), 100)
+	}
+	return nil
+}
+
+//go:generate msgp
+type DisputeParam struct {
+	ContractAddress types.Address     `msg:"ca" json:"contractAddress"`
+	DisputeID       types.Hash        `msg:"id" json:"disputeId"`
+	CDRs            []types.Hash      `msg:"cdrs" json:"cdrs,omitempty"`
+	Reason          string            `msg:"r" json:"reason,omitempty"`
+	Evidence        *Evidence         `msg:"e" json:"evidence,omitempty"`
+	Resolution      DisputeResolution `msg:"rs" json:"resolution"`
+	SplitRate       uint64            `msg:"sr" json:"splitRate,omitempty"`
+}
+
+func (z *DisputeParam) ToABI(methodName string) ([]byte, error) {
+	id := SettlementABI.Methods[methodName].Id()
+	if data, err := z.MarshalMsg(nil); err != nil {
+		return nil, err
+	} else {
+		id = append(id, data...)
+		return id, nil
+	}
+}
+
+func (z *DisputeParam) FromABI(methodName string, data []byte) error {
+	if method, err := SettlementABI.MethodById(data[:4]); err == nil && method.Name == methodName {
+		_, err := z.UnmarshalMsg(data[4:])
+		return err
+	} else {
+		return fmt.Errorf("could not locate named method: %s", methodName)
+	}
+}
+
+func (z *DisputeParam) Verify(methodName string) error {
+	if z.ContractAddress.IsZero() {
+		return errors.New("invalid contract address")
+	}
+	if methodName != MethodNameOpenDispute && z.DisputeID.IsZero() {
+		return errors.New("invalid dispute id")
+	}
+
+	switch methodName {
+	case MethodNameOpenDispute:
+		if len(z.CDRs) == 0 || len(z.CDRs) > maxDisputeCDRs {
+			return fmt.Errorf("invalid CDRs size, should be in [1, %d], got %d", maxDisputeCDRs, len(z.CDRs))
+		}
+		cdrs := make(map[types.Hash]struct{}, len(z.CDRs))
+		for _, h := range z.CDRs {
+			if _, ok := cdrs[h]; ok || h.IsZero() {
+				return fmt.Errorf("invalid CDR %s", h.String())
+			}
+			cdrs[h] = struct{}{}
+		}
+		if z.Reason == "" {
+			return errors.New("empty reason")
+		}
+	case MethodNameAddDisputeEvidence:
+		if z.Evidence == nil || z.Evidence.Description == "" {
+			return errors.New("empty evidence")
+		}
+	case MethodNameProposeDisputeResolution:
+		switch z.Resolution {
+		case DisputeResolutionAcceptPartyA, DisputeResolutionAcceptPartyB:
+			if z.SplitRate != 0 {
+				return fmt.Errorf("split rate is only for %s", DisputeResolutionSplit.String())
+			}
+		case DisputeResolutionSplit:
+			if z.SplitRate == 0 || z.SplitRate >= 100 {
+				return fmt.Errorf("invalid split rate, should be in (0, 100), got %d", z.SplitRate)
+			}
+		default:
+			return fmt.Errorf("invalid resolution, %s", z.Resolution.String())
+		}
+	case MethodNameSignDisputeResolution:
+	default:
+		return fmt.Errorf("invalid dispute method %s", methodName)
+	}
+	return nil
+}
+
+// ToDispute opens the dispute of the CDRs, the id is unique since a CDR can only be disputed once
+func (z *DisputeParam) ToDispute(opener types.Address, now int64) *Dispute {
+	var data []byte
+	data = append(data, z.ContractAddress[:]...)
+	for _, h := range z.CDRs {
+		data = append(data, h[:]...)
+	}
+	id := types.HashData(data)
+	return &Dispute{
+		ContractAddress: z.ContractAddress,
+		ID:              id,
+		CDRs:            z.CDRs,
+		Opener:          opener,
+		Reason:          z.Reason,
+		Status:          DisputeStatusOpen,
+		Resolution:      DisputeResolutionNone,
+		OpenDate:        now,
+	}
+}
+
+func disputeKey(addr *types.Address, hash *types.Hash) []byte {
+	var key []byte
+	key = append(key, addr[:]...)
+	key = append(key, hash[:]...)
+	return key
+}
+
+// SaveDispute saves the dispute and the mappings of the disputed CDRs
+func SaveDispute(ctx *vmstore.VMContext, dispute *Dispute) error {
+	data, err := dispute.ToABI()
+	if err != nil {
+		return err
+	}
+	if err := ctx.SetStorage(disputePrefix, disputeKey(&dispute.ContractAddress, &dispute.ID), data); err != nil {
+		return err
+	}
+	for _, h := range dispute.CDRs {
+		if err := ctx.SetStorage(disputeMappingPrefix, disputeKey(&dispute.ContractAddress, &h), dispute.ID[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDispute
+// @param addr settlement contract address
+// @param id dispute id
+func GetDispute(ctx *vmstore.VMContext, addr *types.Address, id types.Hash) (*Dispute, error) {
+	storage, err := ctx.GetStorage(disputePrefix, disputeKey(addr, &id))
+	if err != nil {
+		return nil, err
+	}
+	dispute := &Dispute{}
+	if err := dispute.FromABI(storage); err != nil {
+		return nil, err
+	}
+	return dispute, nil
+}
+
+// GetDisputeByCDR returns the dispute of the CDR of the settlement contract
+func GetDisputeByCDR(ctx *vmstore.VMContext, addr *types.Address, hash types.Hash) (*Dispute, error) {
+	storage, err := ctx.GetStorage(disputeMappingPrefix, disputeKey(addr, &hash))
+	if err != nil {
+		return nil, err
+	}
+	id, err := types.BytesToHash(storage)
+	if err != nil {
+		return nil, err
+	}
+	return GetDispute(ctx, addr, id)
+}
+
+// GetDisputes get all disputes of the settlement contract
+// @param addr settlement contract address
+func GetDisputes(store ledger.Store, addr *types.Address) ([]*Dispute, error) {
+	logger := log.NewLogger("GetDisputes")
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	var result []*Dispute
+	iterator := store.NewVMIterator(&contractaddress.SettlementAddress)
+	var prefix []byte
+	prefix = append(prefix, disputePrefix...)
+	prefix = append(prefix, addr[:]...)
+	if err := iterator.Next(prefix, func(key []byte, value []byte) error {
+		if len(key) == disputeKeySize && len(value) > 0 {
+			dispute := &Dispute{}
+			if err := dispute.FromABI(value); err != nil {
+				logger.Error(err)
+			} else {
+				result = append(result, dispute)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getDisputedCDRs returns the disputes of the settlement contract by CDR hash
+func getDisputedCDRs(store ledger.Store, addr *types.Address) (map[types.Hash]*Dispute, error) {
+	disputes, err := GetDisputes(store, addr)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[types.Hash]*Dispute)
+	for _, d := range disputes {
+		for _, h := range d.CDRs {
+			result[h] = d
+		}
+	}
+	return result, nil
+}
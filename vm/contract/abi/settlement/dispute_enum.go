@@ -0,0 +1,150 @@
+// Code generated by go-enum
+// DO NOT EDIT!
+
+package settlement
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DisputeStatusOpen is a DisputeStatus of type Open
+	DisputeStatusOpen DisputeStatus = iota
+	// DisputeStatusProposed is a DisputeStatus of type Proposed
+	DisputeStatusProposed
+	// DisputeStatusResolved is a DisputeStatus of type Resolved
+	DisputeStatusResolved
+)
+
+const _DisputeStatusName = "OpenProposedResolved"
+
+var _DisputeStatusNames = []string{
+	_DisputeStatusName[0:4],
+	_DisputeStatusName[4:12],
+	_DisputeStatusName[12:20],
+}
+
+// DisputeStatusNames returns a list of possible string values of DisputeStatus.
+func DisputeStatusNames() []string {
+	tmp := make([]string, len(_DisputeStatusNames))
+	copy(tmp, _DisputeStatusNames)
+	return tmp
+}
+
+var _DisputeStatusMap = map[DisputeStatus]string{
+	0: _DisputeStatusName[0:4],
+	1: _DisputeStatusName[4:12],
+	2: _DisputeStatusName[12:20],
+}
+
+// String implements the Stringer interface.
+func (x DisputeStatus) String() string {
+	if str, ok := _DisputeStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("DisputeStatus(%d)", x)
+}
+
+var _DisputeStatusValue = map[string]DisputeStatus{
+	_DisputeStatusName[0:4]:   0,
+	_DisputeStatusName[4:12]:  1,
+	_DisputeStatusName[12:20]: 2,
+}
+
+// ParseDisputeStatus attempts to convert a string to a DisputeStatus
+func ParseDisputeStatus(name string) (DisputeStatus, error) {
+	if x, ok := _DisputeStatusValue[name]; ok {
+		return x, nil
+	}
+	return DisputeStatus(0), fmt.Errorf("%s is not a valid DisputeStatus, try [%s]", name, strings.Join(_DisputeStatusNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x DisputeStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *DisputeStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseDisputeStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+const (
+	// DisputeResolutionNone is a DisputeResolution of type None
+	DisputeResolutionNone DisputeResolution = iota
+	// DisputeResolutionAcceptPartyA is a DisputeResolution of type AcceptPartyA
+	DisputeResolutionAcceptPartyA
+	// DisputeResolutionAcceptPartyB is a DisputeResolution of type AcceptPartyB
+	DisputeResolutionAcceptPartyB
+	// DisputeResolutionSplit is a DisputeResolution of type Split
+	DisputeResolutionSplit
+)
+
+const _DisputeResolutionName = "NoneAcceptPartyAAcceptPartyBSplit"
+
+var _DisputeResolutionNames = []string{
+	_DisputeResolutionName[0:4],
+	_DisputeResolutionName[4:16],
+	_DisputeResolutionName[16:28],
+	_DisputeResolutionName[28:33],
+}
+
+// DisputeResolutionNames returns a list of possible string values of DisputeResolution.
+func DisputeResolutionNames() []string {
+	tmp := make([]string, len(_DisputeResolutionNames))
+	copy(tmp, _DisputeResolutionNames)
+	return tmp
+}
+
+var _DisputeResolutionMap = map[DisputeResolution]string{
+	0: _DisputeResolutionName[0:4],
+	1: _DisputeResolutionName[4:16],
+	2: _DisputeResolutionName[16:28],
+	3: _DisputeResolutionName[28:33],
+}
+
+// String implements the Stringer interface.
+func (x DisputeResolution) String() string {
+	if str, ok := _DisputeResolutionMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("DisputeResolution(%d)", x)
+}
+
+var _DisputeResolutionValue = map[string]DisputeResolution{
+	_DisputeResolutionName[0:4]:   0,
+	_DisputeResolutionName[4:16]:  1,
+	_DisputeResolutionName[16:28]: 2,
+	_DisputeResolutionName[28:33]: 3,
+}
+
+// ParseDisputeResolution attempts to convert a string to a DisputeResolution
+func ParseDisputeResolution(name string) (DisputeResolution, error) {
+	if x, ok := _DisputeResolutionValue[name]; ok {
+		return x, nil
+	}
+	return DisputeResolution(0), fmt.Errorf("%s is not a valid DisputeResolution, try [%s]", name, strings.Join(_DisputeResolutionNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x DisputeResolution) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *DisputeResolution) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseDisputeResolution(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
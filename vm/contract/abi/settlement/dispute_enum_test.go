@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDisputeStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    DisputeStatus
+		wantErr bool
+	}{
+		{
+			name:    "Proposed",
+			want:    DisputeStatusProposed,
+			wantErr: false,
+		}, {
+			name:    "invalid",
+			want:    DisputeStatusOpen,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDisputeStatus(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDisputeStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDisputeStatus() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisputeStatusNames(t *testing.T) {
+	want := []string{"Open", "Proposed", "Resolved"}
+	if got := DisputeStatusNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("DisputeStatusNames() = %v, want %v", got, want)
+	}
+}
+
+func TestDisputeResolution_MarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		x    DisputeResolution
+		want string
+	}{
+		{
+			name: "AcceptPartyA",
+			x:    DisputeResolutionAcceptPartyA,
+			want: "AcceptPartyA",
+		}, {
+			name: "Split",
+			x:    DisputeResolutionSplit,
+			want: "Split",
+		}, {
+			name: "invalid",
+			x:    4,
+			want: "DisputeResolution(4)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.x.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+			var x DisputeResolution
+			if err := x.UnmarshalText(got); (err != nil) != (tt.name == "invalid") {
+				t.Errorf("UnmarshalText() error = %v", err)
+			}
+		})
+	}
+}
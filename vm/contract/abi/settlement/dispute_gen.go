@@ -0,0 +1,1241 @@
+package settlement
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *Dispute) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			err = z.ContractAddress.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "id":
+			err = z.ID.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "cdrs":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "CDRs")
+				return
+			}
+			if cap(z.CDRs) >= int(zb0002) {
+				z.CDRs = (z.CDRs)[:zb0002]
+			} else {
+				z.CDRs = make([]types.Hash, zb0002)
+			}
+			for za0001 := range z.CDRs {
+				err = z.CDRs[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "CDRs", za0001)
+					return
+				}
+			}
+		case "o":
+			err = z.Opener.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Opener")
+				return
+			}
+		case "r":
+			z.Reason, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		case "s":
+			{
+				var zb0003 int
+				zb0003, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Status")
+					return
+				}
+				z.Status = DisputeStatus(zb0003)
+			}
+		case "e":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Evidences")
+				return
+			}
+			if cap(z.Evidences) >= int(zb0004) {
+				z.Evidences = (z.Evidences)[:zb0004]
+			} else {
+				z.Evidences = make([]*Evidence, zb0004)
+			}
+			for za0002 := range z.Evidences {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "Evidences", za0002)
+						return
+					}
+					z.Evidences[za0002] = nil
+				} else {
+					if z.Evidences[za0002] == nil {
+						z.Evidences[za0002] = new(Evidence)
+					}
+					err = z.Evidences[za0002].DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "Evidences", za0002)
+						return
+					}
+				}
+			}
+		case "rs":
+			{
+				var zb0005 int
+				zb0005, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Resolution")
+					return
+				}
+				z.Resolution = DisputeResolution(zb0005)
+			}
+		case "sr":
+			z.SplitRate, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "SplitRate")
+				return
+			}
+		case "p":
+			err = z.Proposer.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Proposer")
+				return
+			}
+		case "sa":
+			z.SignedA, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "SignedA")
+				return
+			}
+		case "sb":
+			z.SignedB, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "SignedB")
+				return
+			}
+		case "t1":
+			z.OpenDate, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "OpenDate")
+				return
+			}
+		case "t2":
+			z.ResolveDate, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "ResolveDate")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Dispute) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 14
+	// write "ca"
+	err = en.Append(0x8e, 0xa2, 0x63, 0x61)
+	if err != nil {
+		return
+	}
+	err = z.ContractAddress.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// write "id"
+	err = en.Append(0xa2, 0x69, 0x64)
+	if err != nil {
+		return
+	}
+	err = z.ID.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "ID")
+		return
+	}
+	// write "cdrs"
+	err = en.Append(0xa4, 0x63, 0x64, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.CDRs)))
+	if err != nil {
+		err = msgp.WrapError(err, "CDRs")
+		return
+	}
+	for za0001 := range z.CDRs {
+		err = z.CDRs[za0001].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "CDRs", za0001)
+			return
+		}
+	}
+	// write "o"
+	err = en.Append(0xa1, 0x6f)
+	if err != nil {
+		return
+	}
+	err = z.Opener.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Opener")
+		return
+	}
+	// write "r"
+	err = en.Append(0xa1, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Reason)
+	if err != nil {
+		err = msgp.WrapError(err, "Reason")
+		return
+	}
+	// write "s"
+	err = en.Append(0xa1, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(int(z.Status))
+	if err != nil {
+		err = msgp.WrapError(err, "Status")
+		return
+	}
+	// write "e"
+	err = en.Append(0xa1, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Evidences)))
+	if err != nil {
+		err = msgp.WrapError(err, "Evidences")
+		return
+	}
+	for za0002 := range z.Evidences {
+		if z.Evidences[za0002] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.Evidences[za0002].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "Evidences", za0002)
+				return
+			}
+		}
+	}
+	// write "rs"
+	err = en.Append(0xa2, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(int(z.Resolution))
+	if err != nil {
+		err = msgp.WrapError(err, "Resolution")
+		return
+	}
+	// write "sr"
+	err = en.Append(0xa2, 0x73, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.SplitRate)
+	if err != nil {
+		err = msgp.WrapError(err, "SplitRate")
+		return
+	}
+	// write "p"
+	err = en.Append(0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = z.Proposer.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Proposer")
+		return
+	}
+	// write "sa"
+	err = en.Append(0xa2, 0x73, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.SignedA)
+	if err != nil {
+		err = msgp.WrapError(err, "SignedA")
+		return
+	}
+	// write "sb"
+	err = en.Append(0xa2, 0x73, 0x62)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.SignedB)
+	if err != nil {
+		err = msgp.WrapError(err, "SignedB")
+		return
+	}
+	// write "t1"
+	err = en.Append(0xa2, 0x74, 0x31)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.OpenDate)
+	if err != nil {
+		err = msgp.WrapError(err, "OpenDate")
+		return
+	}
+	// write "t2"
+	err = en.Append(0xa2, 0x74, 0x32)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.ResolveDate)
+	if err != nil {
+		err = msgp.WrapError(err, "ResolveDate")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Dispute) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 14
+	// string "ca"
+	o = append(o, 0x8e, 0xa2, 0x63, 0x61)
+	o, err = z.ContractAddress.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// string "id"
+	o = append(o, 0xa2, 0x69, 0x64)
+	o, err = z.ID.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ID")
+		return
+	}
+	// string "cdrs"
+	o = append(o, 0xa4, 0x63, 0x64, 0x72, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.CDRs)))
+	for za0001 := range z.CDRs {
+		o, err = z.CDRs[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "CDRs", za0001)
+			return
+		}
+	}
+	// string "o"
+	o = append(o, 0xa1, 0x6f)
+	o, err = z.Opener.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Opener")
+		return
+	}
+	// string "r"
+	o = append(o, 0xa1, 0x72)
+	o = msgp.AppendString(o, z.Reason)
+	// string "s"
+	o = append(o, 0xa1, 0x73)
+	o = msgp.AppendInt(o, int(z.Status))
+	// string "e"
+	o = append(o, 0xa1, 0x65)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Evidences)))
+	for za0002 := range z.Evidences {
+		if z.Evidences[za0002] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Evidences[za0002].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Evidences", za0002)
+				return
+			}
+		}
+	}
+	// string "rs"
+	o = append(o, 0xa2, 0x72, 0x73)
+	o = msgp.AppendInt(o, int(z.Resolution))
+	// string "sr"
+	o = append(o, 0xa2, 0x73, 0x72)
+	o = msgp.AppendUint64(o, z.SplitRate)
+	// string "p"
+	o = append(o, 0xa1, 0x70)
+	o, err = z.Proposer.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Proposer")
+		return
+	}
+	// string "sa"
+	o = append(o, 0xa2, 0x73, 0x61)
+	o = msgp.AppendBool(o, z.SignedA)
+	// string "sb"
+	o = append(o, 0xa2, 0x73, 0x62)
+	o = msgp.AppendBool(o, z.SignedB)
+	// string "t1"
+	o = append(o, 0xa2, 0x74, 0x31)
+	o = msgp.AppendInt64(o, z.OpenDate)
+	// string "t2"
+	o = append(o, 0xa2, 0x74, 0x32)
+	o = msgp.AppendInt64(o, z.ResolveDate)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Dispute) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			bts, err = z.ContractAddress.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "id":
+			bts, err = z.ID.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "cdrs":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CDRs")
+				return
+			}
+			if cap(z.CDRs) >= int(zb0002) {
+				z.CDRs = (z.CDRs)[:zb0002]
+			} else {
+				z.CDRs = make([]types.Hash, zb0002)
+			}
+			for za0001 := range z.CDRs {
+				bts, err = z.CDRs[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CDRs", za0001)
+					return
+				}
+			}
+		case "o":
+			bts, err = z.Opener.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Opener")
+				return
+			}
+		case "r":
+			z.Reason, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		case "s":
+			{
+				var zb0003 int
+				zb0003, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Status")
+					return
+				}
+				z.Status = DisputeStatus(zb0003)
+			}
+		case "e":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Evidences")
+				return
+			}
+			if cap(z.Evidences) >= int(zb0004) {
+				z.Evidences = (z.Evidences)[:zb0004]
+			} else {
+				z.Evidences = make([]*Evidence, zb0004)
+			}
+			for za0002 := range z.Evidences {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Evidences[za0002] = nil
+				} else {
+					if z.Evidences[za0002] == nil {
+						z.Evidences[za0002] = new(Evidence)
+					}
+					bts, err = z.Evidences[za0002].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Evidences", za0002)
+						return
+					}
+				}
+			}
+		case "rs":
+			{
+				var zb0005 int
+				zb0005, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Resolution")
+					return
+				}
+				z.Resolution = DisputeResolution(zb0005)
+			}
+		case "sr":
+			z.SplitRate, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SplitRate")
+				return
+			}
+		case "p":
+			bts, err = z.Proposer.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Proposer")
+				return
+			}
+		case "sa":
+			z.SignedA, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SignedA")
+				return
+			}
+		case "sb":
+			z.SignedB, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SignedB")
+				return
+			}
+		case "t1":
+			z.OpenDate, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OpenDate")
+				return
+			}
+		case "t2":
+			z.ResolveDate, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ResolveDate")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Dispute) Msgsize() (s int) {
+	s = 1 + 3 + z.ContractAddress.Msgsize() + 3 + z.ID.Msgsize() + 5 + msgp.ArrayHeaderSize
+	for za0001 := range z.CDRs {
+		s += z.CDRs[za0001].Msgsize()
+	}
+	s += 2 + z.Opener.Msgsize() + 2 + msgp.StringPrefixSize + len(z.Reason) + 2 + msgp.IntSize + 2 + msgp.ArrayHeaderSize
+	for za0002 := range z.Evidences {
+		if z.Evidences[za0002] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Evidences[za0002].Msgsize()
+		}
+	}
+	s += 3 + msgp.IntSize + 3 + msgp.Uint64Size + 2 + z.Proposer.Msgsize() + 3 + msgp.BoolSize + 3 + msgp.BoolSize + 3 + msgp.Int64Size + 3 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DisputeParam) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			err = z.ContractAddress.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "id":
+			err = z.DisputeID.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "DisputeID")
+				return
+			}
+		case "cdrs":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "CDRs")
+				return
+			}
+			if cap(z.CDRs) >= int(zb0002) {
+				z.CDRs = (z.CDRs)[:zb0002]
+			} else {
+				z.CDRs = make([]types.Hash, zb0002)
+			}
+			for za0001 := range z.CDRs {
+				err = z.CDRs[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "CDRs", za0001)
+					return
+				}
+			}
+		case "r":
+			z.Reason, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		case "e":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Evidence")
+					return
+				}
+				z.Evidence = nil
+			} else {
+				if z.Evidence == nil {
+					z.Evidence = new(Evidence)
+				}
+				err = z.Evidence.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Evidence")
+					return
+				}
+			}
+		case "rs":
+			{
+				var zb0003 int
+				zb0003, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Resolution")
+					return
+				}
+				z.Resolution = DisputeResolution(zb0003)
+			}
+		case "sr":
+			z.SplitRate, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "SplitRate")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DisputeParam) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 7
+	// write "ca"
+	err = en.Append(0x87, 0xa2, 0x63, 0x61)
+	if err != nil {
+		return
+	}
+	err = z.ContractAddress.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// write "id"
+	err = en.Append(0xa2, 0x69, 0x64)
+	if err != nil {
+		return
+	}
+	err = z.DisputeID.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "DisputeID")
+		return
+	}
+	// write "cdrs"
+	err = en.Append(0xa4, 0x63, 0x64, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.CDRs)))
+	if err != nil {
+		err = msgp.WrapError(err, "CDRs")
+		return
+	}
+	for za0001 := range z.CDRs {
+		err = z.CDRs[za0001].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "CDRs", za0001)
+			return
+		}
+	}
+	// write "r"
+	err = en.Append(0xa1, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Reason)
+	if err != nil {
+		err = msgp.WrapError(err, "Reason")
+		return
+	}
+	// write "e"
+	err = en.Append(0xa1, 0x65)
+	if err != nil {
+		return
+	}
+	if z.Evidence == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Evidence.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Evidence")
+			return
+		}
+	}
+	// write "rs"
+	err = en.Append(0xa2, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(int(z.Resolution))
+	if err != nil {
+		err = msgp.WrapError(err, "Resolution")
+		return
+	}
+	// write "sr"
+	err = en.Append(0xa2, 0x73, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.SplitRate)
+	if err != nil {
+		err = msgp.WrapError(err, "SplitRate")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DisputeParam) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "ca"
+	o = append(o, 0x87, 0xa2, 0x63, 0x61)
+	o, err = z.ContractAddress.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// string "id"
+	o = append(o, 0xa2, 0x69, 0x64)
+	o, err = z.DisputeID.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "DisputeID")
+		return
+	}
+	// string "cdrs"
+	o = append(o, 0xa4, 0x63, 0x64, 0x72, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.CDRs)))
+	for za0001 := range z.CDRs {
+		o, err = z.CDRs[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "CDRs", za0001)
+			return
+		}
+	}
+	// string "r"
+	o = append(o, 0xa1, 0x72)
+	o = msgp.AppendString(o, z.Reason)
+	// string "e"
+	o = append(o, 0xa1, 0x65)
+	if z.Evidence == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Evidence.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Evidence")
+			return
+		}
+	}
+	// string "rs"
+	o = append(o, 0xa2, 0x72, 0x73)
+	o = msgp.AppendInt(o, int(z.Resolution))
+	// string "sr"
+	o = append(o, 0xa2, 0x73, 0x72)
+	o = msgp.AppendUint64(o, z.SplitRate)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DisputeParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			bts, err = z.ContractAddress.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "id":
+			bts, err = z.DisputeID.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DisputeID")
+				return
+			}
+		case "cdrs":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CDRs")
+				return
+			}
+			if cap(z.CDRs) >= int(zb0002) {
+				z.CDRs = (z.CDRs)[:zb0002]
+			} else {
+				z.CDRs = make([]types.Hash, zb0002)
+			}
+			for za0001 := range z.CDRs {
+				bts, err = z.CDRs[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CDRs", za0001)
+					return
+				}
+			}
+		case "r":
+			z.Reason, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		case "e":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Evidence = nil
+			} else {
+				if z.Evidence == nil {
+					z.Evidence = new(Evidence)
+				}
+				bts, err = z.Evidence.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Evidence")
+					return
+				}
+			}
+		case "rs":
+			{
+				var zb0003 int
+				zb0003, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Resolution")
+					return
+				}
+				z.Resolution = DisputeResolution(zb0003)
+			}
+		case "sr":
+			z.SplitRate, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SplitRate")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DisputeParam) Msgsize() (s int) {
+	s = 1 + 3 + z.ContractAddress.Msgsize() + 3 + z.DisputeID.Msgsize() + 5 + msgp.ArrayHeaderSize
+	for za0001 := range z.CDRs {
+		s += z.CDRs[za0001].Msgsize()
+	}
+	s += 2 + msgp.StringPrefixSize + len(z.Reason) + 2
+	if z.Evidence == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Evidence.Msgsize()
+	}
+	s += 3 + msgp.IntSize + 3 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DisputeResolution) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DisputeResolution(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DisputeResolution) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DisputeResolution) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DisputeResolution) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DisputeResolution(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DisputeResolution) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DisputeStatus) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DisputeStatus(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DisputeStatus) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DisputeStatus) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DisputeStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DisputeStatus(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DisputeStatus) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Evidence) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "f":
+			err = z.From.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "From")
+				return
+			}
+		case "d":
+			z.Description, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Description")
+				return
+			}
+		case "h":
+			err = z.Hash.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Hash")
+				return
+			}
+		case "t":
+			z.Date, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Date")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Evidence) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "f"
+	err = en.Append(0x84, 0xa1, 0x66)
+	if err != nil {
+		return
+	}
+	err = z.From.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "From")
+		return
+	}
+	// write "d"
+	err = en.Append(0xa1, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Description)
+	if err != nil {
+		err = msgp.WrapError(err, "Description")
+		return
+	}
+	// write "h"
+	err = en.Append(0xa1, 0x68)
+	if err != nil {
+		return
+	}
+	err = z.Hash.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Hash")
+		return
+	}
+	// write "t"
+	err = en.Append(0xa1, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Date)
+	if err != nil {
+		err = msgp.WrapError(err, "Date")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Evidence) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "f"
+	o = append(o, 0x84, 0xa1, 0x66)
+	o, err = z.From.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "From")
+		return
+	}
+	// string "d"
+	o = append(o, 0xa1, 0x64)
+	o = msgp.AppendString(o, z.Description)
+	// string "h"
+	o = append(o, 0xa1, 0x68)
+	o, err = z.Hash.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Hash")
+		return
+	}
+	// string "t"
+	o = append(o, 0xa1, 0x74)
+	o = msgp.AppendInt64(o, z.Date)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Evidence) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "f":
+			bts, err = z.From.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "From")
+				return
+			}
+		case "d":
+			z.Description, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Description")
+				return
+			}
+		case "h":
+			bts, err = z.Hash.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Hash")
+				return
+			}
+		case "t":
+			z.Date, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Date")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Evidence) Msgsize() (s int) {
+	s = 1 + 2 + z.From.Msgsize() + 2 + msgp.StringPrefixSize + len(z.Description) + 2 + z.Hash.Msgsize() + 2 + msgp.Int64Size
+	return
+}
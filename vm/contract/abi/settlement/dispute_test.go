@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestDisputeParam_Verify(t *testing.T) {
+	ca := mock.Address()
+	h := mock.Hash()
+	tests := []struct {
+		name       string
+		methodName string
+		param      *DisputeParam
+		wantErr    bool
+	}{
+		{"open", MethodNameOpenDispute, &DisputeParam{ContractAddress: ca, CDRs: []types.Hash{h}, Reason: "mismatch"}, false},
+		{"open without reason", MethodNameOpenDispute, &DisputeParam{ContractAddress: ca, CDRs: []types.Hash{h}}, true},
+		{"open duplicate CDRs", MethodNameOpenDispute, &DisputeParam{ContractAddress: ca, CDRs: []types.Hash{h, h}, Reason: "mismatch"}, true},
+		{"open without contract", MethodNameOpenDispute, &DisputeParam{CDRs: []types.Hash{h}, Reason: "mismatch"}, true},
+		{"evidence", MethodNameAddDisputeEvidence, &DisputeParam{ContractAddress: ca, DisputeID: h, Evidence: &Evidence{Description: "email"}}, false},
+		{"empty evidence", MethodNameAddDisputeEvidence, &DisputeParam{ContractAddress: ca, DisputeID: h}, true},
+		{"accept", MethodNameProposeDisputeResolution, &DisputeParam{ContractAddress: ca, DisputeID: h, Resolution: DisputeResolutionAcceptPartyB}, false},
+		{"accept with split rate", MethodNameProposeDisputeResolution, &DisputeParam{ContractAddress: ca, DisputeID: h, Resolution: DisputeResolutionAcceptPartyB, SplitRate: 50}, true},
+		{"split", MethodNameProposeDisputeResolution, &DisputeParam{ContractAddress: ca, DisputeID: h, Resolution: DisputeResolutionSplit, SplitRate: 30}, false},
+		{"invalid split rate", MethodNameProposeDisputeResolution, &DisputeParam{ContractAddress: ca, DisputeID: h, Resolution: DisputeResolutionSplit, SplitRate: 100}, true},
+		{"none", MethodNameProposeDisputeResolution, &DisputeParam{ContractAddress: ca, DisputeID: h}, true},
+		{"sign", MethodNameSignDisputeResolution, &DisputeParam{ContractAddress: ca, DisputeID: h}, false},
+		{"sign without id", MethodNameSignDisputeResolution, &DisputeParam{ContractAddress: ca}, true},
+		{"invalid method", MethodNameProcessCDR, &DisputeParam{ContractAddress: ca, DisputeID: h}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.param.Verify(tt.methodName); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	param := &DisputeParam{ContractAddress: ca, DisputeID: h, Resolution: DisputeResolutionSplit, SplitRate: 30}
+	abi, err := param.ToABI(MethodNameProposeDisputeResolution)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2 := &DisputeParam{}
+	if err := p2.FromABI(MethodNameSignDisputeResolution, abi); err == nil {
+		t.Fatal("invalid method should fail")
+	}
+	if err := p2.FromABI(MethodNameProposeDisputeResolution, abi); err != nil || p2.SplitRate != 30 || p2.DisputeID != h {
+		t.Fatal(err, p2)
+	}
+}
+
+func TestGenerateInvoices_Disputes(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	a1 := mock.Address()
+	a2 := mock.Address()
+	param := buildContractParam()
+	param.PartyA.Address = a1
+	param.PartyB.Address = a2
+	contractAddr, _ := param.Address()
+	abi, _ := param.ToABI()
+	if err := SaveContractParam(ctx, &contractAddr, abi[:]); err != nil {
+		t.Fatal(err)
+	}
+	c, err := GetSettlementContract(ctx, &contractAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first CDR is delivered, party B reports the others undelivered
+	now := time.Now().Unix()
+	var cdrs []types.Hash
+	for i := 0; i < 4; i++ {
+		p1 := cdrParam
+		p1.Index = uint64(i + 1)
+		p1.SmsDt = now
+		p2 := p1
+		s := &CDRStatus{Status: SettlementStatusSuccess}
+		if i > 0 {
+			p2.DlrStatus = DLRStatusUndelivered
+			s.Status = SettlementStatusFailure
+		}
+		s.Params = map[string][]CDRParam{a1.String(): {p1}, a2.String(): {p2}}
+		h, err := s.ToHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := SaveCDRStatus(ctx, &contractAddr, &h, s); err != nil {
+			t.Fatal(err)
+		}
+		cdrs = append(cdrs, h)
+	}
+
+	// CDR 1 is disputed, CDR 2 is resolved by accepting party A and CDR 3 is split
+	d1 := (&DisputeParam{ContractAddress: contractAddr, CDRs: cdrs[1:2], Reason: "mismatch"}).ToDispute(a1, now)
+	if err := d1.Sign(c, a2, now); err == nil {
+		t.Fatal("dispute without proposal should not be signed")
+	}
+	if err := d1.Propose(c, a1, DisputeResolutionAcceptPartyA, 0); err != nil {
+		t.Fatal(err)
+	}
+	d2 := (&DisputeParam{ContractAddress: contractAddr, CDRs: cdrs[2:3], Reason: "mismatch"}).ToDispute(a1, now)
+	if err := d2.Propose(c, a2, DisputeResolutionAcceptPartyA, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := d2.Sign(c, a2, now); err == nil {
+		t.Fatal("proposer has already signed")
+	}
+	if err := d2.Sign(c, a1, now+1); err != nil || !d2.IsResolved() || d2.ResolveDate != now+1 {
+		t.Fatal(err, d2)
+	}
+	if err := d2.AddEvidence(&Evidence{Description: "too late"}); err == nil {
+		t.Fatal("resolved dispute should not be changed")
+	}
+	d3 := (&DisputeParam{ContractAddress: contractAddr, CDRs: cdrs[3:], Reason: "mismatch"}).ToDispute(a2, now)
+	if err := d3.Propose(c, a1, DisputeResolutionSplit, 50); err != nil {
+		t.Fatal(err)
+	}
+	if err := d3.Sign(c, a2, now); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []*Dispute{d1, d2, d3} {
+		if err := SaveDispute(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	if d, err := GetDisputeByCDR(ctx, &contractAddr, cdrs[3]); err != nil || d.ID != d3.ID || d.SplitRate != 50 {
+		t.Fatal(err, d)
+	}
+	if disputes, err := GetDisputes(l, &contractAddr); err != nil || len(disputes) != 3 {
+		t.Fatal(err, disputes)
+	}
+
+	report, err := GetSummaryReport(l, &contractAddr, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Disputes.Proposed != 1 || report.Disputes.Resolved != 2 || report.Disputes.Open != 0 {
+		t.Fatal(report.Disputes)
+	}
+	for _, party := range []string{"partyA", "partyB"} {
+		if r := report.Total.records[party]; r.Matching.Success != 3 || r.Matching.Fail != 0 {
+			t.Fatal(party, r)
+		}
+	}
+
+	invoices, err := GenerateInvoicesByContract(l, &contractAddr, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2 + 2 + 2 * 50%
+	if len(invoices) != 1 || invoices[0].SumOfBillableSMSCustomer != 3 || invoices[0].SumOfDisputedSMS != 1 ||
+		invoices[0].Charge != "5" {
+		t.Fatal(invoices)
+	}
+}
@@ -9,6 +9,9 @@ package settlement
 
 import "github.com/qlcchain/go-qlc/common/types"
 
+// InvoiceCustomerCommitment is the customer of the invoice record of the minimum commitment shortfall
+const InvoiceCustomerCommitment = "MinCommitmentShortfall"
+
 type InvoiceRecord struct {
 	Address                  types.Address `json:"contractAddress"`
 	StartDate                int64         `json:"startDate"`
@@ -24,6 +27,32 @@ type InvoiceRecord struct {
 	UnitPrice                float64       `json:"unitPrice"`
 	SumOfBillableSMSCustomer uint64        `json:"sumOfBillableSMSCustomer"`
 	SumOfTOTPrice            float64       `json:"sumOfTOTPrice"`
+	// records are split by the kind of CDRs and the billing unit, the unit price is the charge per unit
+	Kind              CDRKind     `json:"kind"`
+	SumOfBillableCDRs uint64      `json:"sumOfBillableCDRs"`
+	Unit              BillingUnit `json:"unit"`
+	Quantity          string      `json:"quantity"`
+	// CDRs of unresolved disputes, which are not charged until both parties sign off the resolution
+	SumOfDisputedSMS uint64 `json:"sumOfDisputedSMS,omitempty"`
+	// exact decimal of SumOfTOTPrice, charged by the rate cards in force at the time of CDRs
+	Charge           string   `json:"charge"`
+	RateCardVersions []uint64 `json:"rateCardVersions,omitempty"`
+	// SLA credits(negative) and penalties(positive) of the period
+	Compensations     []*InvoiceCompensation `json:"compensations,omitempty"`
+	SumOfCompensation float64                `json:"sumOfCompensation"`
+	TotalPrice        float64                `json:"totalPrice"`
+	// exact decimals of SumOfCompensation and TotalPrice
+	CompensationCharge string `json:"compensationCharge"`
+	TotalCharge        string `json:"totalCharge"`
+}
+
+// InvoiceCompensation is the line item of a matched SLA compensation bracket, Charge is the exact decimal of Amount
+type InvoiceCompensation struct {
+	SLAType SLAType `json:"type"`
+	Actual  float32 `json:"actual"`
+	Rate    float32 `json:"rate"`
+	Amount  float64 `json:"amount"`
+	Charge  string  `json:"charge"`
 }
 
 func sortInvoiceFun(r1, r2 *InvoiceRecord) bool {
@@ -0,0 +1,565 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/validator.v2"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+)
+
+const (
+	// maxPriceDecimals is the max decimal places of prices in rate cards, charges are exact within it
+	maxPriceDecimals = 8
+	bytesPerMB       = 1024 * 1024
+)
+
+var ErrNoRate = errors.New("can not find rate of the destination")
+
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+Message
+Second
+Minute
+MB
+)
+*/
+type BillingUnit int
+
+// billingUnits are the units which can charge the kinds of CDRs
+var billingUnits = map[CDRKind][]BillingUnit{
+	CDRKindSMS:   {BillingUnitMessage},
+	CDRKindVoice: {BillingUnitSecond, BillingUnitMinute},
+	CDRKindData:  {BillingUnitMB},
+}
+
+// quantity returns the charged quantity of the usage of a CDR, calls are rounded up to minutes one by one and
+// data volume is charged exactly
+func (x BillingUnit) quantity(usage uint64) *big.Rat {
+	switch x {
+	case BillingUnitSecond:
+		return new(big.Rat).SetUint64(usage)
+	case BillingUnitMinute:
+		return new(big.Rat).SetUint64((usage + 59) / 60)
+	case BillingUnitMB:
+		return new(big.Rat).SetFrac(new(big.Int).SetUint64(usage), big.NewInt(bytesPerMB))
+	default:
+		return big.NewRat(1, 1)
+	}
+}
+
+//go:generate msgp
+type RateTier struct {
+	From  uint64 `msg:"f" json:"from"`                     // the tier applies after the first From units of the billing period
+	Price string `msg:"p" json:"price" validate:"nonzero"` // decimal unit price, such as "0.0125"
+}
+
+//go:generate msgp
+type Rate struct {
+	Mcc   uint64      `msg:"mcc" json:"mcc"` // destination mcc, mcc and mnc are both 0 means any destination
+	Mnc   uint64      `msg:"mnc" json:"mnc"`
+	Tiers []*RateTier `msg:"t" json:"tiers" validate:"min=1"`
+	// rates without kind charge SMS per message
+	Kind CDRKind     `msg:"k,omitempty" json:"kind"`
+	Unit BillingUnit `msg:"u,omitempty" json:"unit"`
+}
+
+func (z *Rate) verifyUnit() error {
+	units, ok := billingUnits[z.Kind]
+	if !ok {
+		return fmt.Errorf("invalid CDR kind %d", z.Kind)
+	}
+	for _, u := range units {
+		if u == z.Unit {
+			return nil
+		}
+	}
+	names := make([]string, len(units))
+	for i, u := range units {
+		names[i] = u.String()
+	}
+	return fmt.Errorf("%s CDRs can not be charged per %s, try [%s]", z.Kind, z.Unit, strings.Join(names, ", "))
+}
+
+// Price returns the unit price of the tier, volume is the quantity charged before
+func (z *Rate) Price(volume uint64) (*big.Rat, error) {
+	tier := z.Tiers[0]
+	for _, t := range z.Tiers {
+		if t.From > volume {
+			break
+		}
+		tier = t
+	}
+	return parseDecimal(tier.Price)
+}
+
+// charge returns the amount of quantity charged after volume, the quantity crossing tier boundaries is split
+// and each part is charged by the price of its tier
+func (z *Rate) charge(volume, quantity *big.Rat) (*big.Rat, error) {
+	amount := new(big.Rat)
+	end := new(big.Rat).Add(volume, quantity)
+	for i, t := range z.Tiers {
+		low := new(big.Rat).SetUint64(t.From)
+		if low.Cmp(volume) < 0 {
+			low = volume
+		}
+		high := end
+		if i+1 < len(z.Tiers) {
+			if next := new(big.Rat).SetUint64(z.Tiers[i+1].From); next.Cmp(end) < 0 {
+				high = next
+			}
+		}
+		if high.Cmp(low) <= 0 {
+			continue
+		}
+		price, err := parseDecimal(t.Price)
+		if err != nil {
+			return nil, err
+		}
+		amount.Add(amount, new(big.Rat).Mul(price, new(big.Rat).Sub(high, low)))
+	}
+	return amount, nil
+}
+
+//go:generate msgp
+type RateCard struct {
+	Version       uint64  `msg:"v" json:"version"`
+	EffectiveDate int64   `msg:"e" json:"effectiveDate" validate:"min=1"`
+	Currency      string  `msg:"c" json:"currency" validate:"nonzero"`
+	MinCommitment string  `msg:"mc" json:"minCommitment,omitempty"` // minimum charge of a billing period, the shortfall is charged separately
+	Rates         []*Rate `msg:"r" json:"rates" validate:"min=1"`
+}
+
+func (z *RateCard) ToABI() ([]byte, error) {
+	return z.MarshalMsg(nil)
+}
+
+func (z *RateCard) String() string {
+	return util.ToIndentString(z)
+}
+
+func (z *RateCard) Verify() error {
+	if err := validator.Validate(z); err != nil {
+		return err
+	}
+	if z.MinCommitment != "" {
+		if _, err := parseDecimal(z.MinCommitment); err != nil {
+			return err
+		}
+	}
+	destinations := make(map[[3]uint64]struct{})
+	for _, r := range z.Rates {
+		if r == nil || len(r.Tiers) == 0 {
+			return errors.New("empty rate tiers")
+		}
+		if err := r.verifyUnit(); err != nil {
+			return err
+		}
+		key := [3]uint64{uint64(r.Kind), r.Mcc, r.Mnc}
+		if _, ok := destinations[key]; ok {
+			return fmt.Errorf("duplicate %s rate of mcc %d, mnc %d", r.Kind, r.Mcc, r.Mnc)
+		}
+		destinations[key] = struct{}{}
+		for i, t := range r.Tiers {
+			if t == nil {
+				return errors.New("empty rate tier")
+			}
+			if i == 0 && t.From != 0 {
+				return fmt.Errorf("first tier should start from 0, got %d", t.From)
+			}
+			if i > 0 && t.From <= r.Tiers[i-1].From {
+				return fmt.Errorf("tiers should be in ascending order, %d after %d", t.From, r.Tiers[i-1].From)
+			}
+			if _, err := parseDecimal(t.Price); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Rate returns the rate of the kind of CDRs to the destination
+func (z *RateCard) Rate(kind CDRKind, mcc, mnc uint64) (*Rate, error) {
+	var rate *Rate
+	for _, r := range z.Rates {
+		if r.Kind != kind {
+			continue
+		}
+		if r.Mcc == mcc && r.Mnc == mnc {
+			return r, nil
+		}
+		if r.Mcc == 0 && r.Mnc == 0 {
+			rate = r
+		}
+	}
+	if rate == nil {
+		return nil, ErrNoRate
+	}
+	return rate, nil
+}
+
+// Price returns the unit price of the kind of CDRs to the destination, volume is the quantity charged before
+func (z *RateCard) Price(kind CDRKind, mcc, mnc uint64, volume uint64) (*big.Rat, error) {
+	rate, err := z.Rate(kind, mcc, mnc)
+	if err != nil {
+		return nil, err
+	}
+	return rate.Price(volume)
+}
+
+//go:generate msgp
+type RateCardParam struct {
+	ContractAddress types.Address `msg:"ca" json:"contractAddress"`
+	RateCard        RateCard      `msg:"rc" json:"rateCard"`
+}
+
+func (z *RateCardParam) ToABI() ([]byte, error) {
+	id := SettlementABI.Methods[MethodNameUpdateRateCard].Id()
+	if data, err := z.MarshalMsg(nil); err != nil {
+		return nil, err
+	} else {
+		id = append(id, data...)
+		return id, nil
+	}
+}
+
+func (z *RateCardParam) FromABI(data []byte) error {
+	if method, err := SettlementABI.MethodById(data[:4]); err == nil && method.Name == MethodNameUpdateRateCard {
+		_, err := z.UnmarshalMsg(data[4:])
+		return err
+	} else {
+		return fmt.Errorf("could not locate named method: %s", MethodNameUpdateRateCard)
+	}
+}
+
+func (z *RateCardParam) Verify() error {
+	if z.ContractAddress.IsZero() {
+		return errors.New("invalid contract address")
+	}
+	return z.RateCard.Verify()
+}
+
+// verifyRateCards checks the rate cards set by CreateContract, which should be in the contract period
+// and in ascending order of effective date
+func verifyRateCards(cards []*RateCard, start, end int64) error {
+	for i, card := range cards {
+		if card == nil {
+			return errors.New("empty rate card")
+		}
+		if err := card.Verify(); err != nil {
+			return err
+		}
+		if card.EffectiveDate > end {
+			return fmt.Errorf("rate card should be effective before %d, got %d", end, card.EffectiveDate)
+		}
+		if i > 0 {
+			if card.EffectiveDate <= cards[i-1].EffectiveDate {
+				return fmt.Errorf("rate cards should be in ascending order of effective date, %d after %d",
+					card.EffectiveDate, cards[i-1].EffectiveDate)
+			}
+			if card.Currency != cards[0].Currency {
+				return fmt.Errorf("invalid currency, exp: %s, act: %s", cards[0].Currency, card.Currency)
+			}
+		} else if card.EffectiveDate > start {
+			return fmt.Errorf("first rate card should be effective from %d, got %d", start, card.EffectiveDate)
+		}
+	}
+	return nil
+}
+
+// AddRateCard adds a new version of the rate card, which can only change prices from now on
+func (z *ContractParam) AddRateCard(card *RateCard, now int64) error {
+	if z.Status != ContractStatusActiveStage1 && z.Status != ContractStatusActivated {
+		return fmt.Errorf("invalid contract status, %s", z.Status.String())
+	}
+	if err := card.Verify(); err != nil {
+		return err
+	}
+	if card.EffectiveDate < now {
+		return fmt.Errorf("rate card can not be effective before %d, got %d", now, card.EffectiveDate)
+	}
+	if card.EffectiveDate > z.EndDate {
+		return fmt.Errorf("rate card should be effective before %d, got %d", z.EndDate, card.EffectiveDate)
+	}
+
+	cards := z.GetRateCards()
+	latest := cards[len(cards)-1]
+	if card.EffectiveDate <= latest.EffectiveDate {
+		return fmt.Errorf("rate card should be effective after %d, got %d", latest.EffectiveDate, card.EffectiveDate)
+	}
+	if card.Currency != latest.Currency {
+		return fmt.Errorf("invalid currency, exp: %s, act: %s", latest.Currency, card.Currency)
+	}
+
+	card.Version = latest.Version + 1
+	// keep the flat price of the services as version 0
+	z.RateCards = append(cards, card)
+	return nil
+}
+
+// GetRateCards returns the rate cards of the contract in ascending order of effective date, contracts without
+// rate card are charged by the flat unit price of the first service per SMS from the start date
+func (z *CreateContractParam) GetRateCards() []*RateCard {
+	if len(z.RateCards) > 0 {
+		cards := make([]*RateCard, len(z.RateCards))
+		copy(cards, z.RateCards)
+		sort.SliceStable(cards, func(i, j int) bool {
+			return cards[i].EffectiveDate < cards[j].EffectiveDate
+		})
+		return cards
+	}
+
+	var service ContractService
+	if len(z.Services) > 0 {
+		service = z.Services[0]
+	}
+	price := "0"
+	if r := new(big.Rat).SetFloat64(service.UnitPrice); r != nil && r.Sign() > 0 {
+		price = formatDecimal(r)
+	}
+	return []*RateCard{
+		{
+			Version:       0,
+			EffectiveDate: z.StartDate,
+			Currency:      service.Currency,
+			Rates: []*Rate{
+				{Tiers: []*RateTier{{From: 0, Price: price}}},
+			},
+		},
+	}
+}
+
+// rateCardAt returns the rate card in force at dt, the first one is used for CDRs before all effective dates
+func rateCardAt(cards []*RateCard, dt int64) *RateCard {
+	card := cards[0]
+	for _, c := range cards {
+		if c.EffectiveDate > dt {
+			break
+		}
+		card = c
+	}
+	return card
+}
+
+// parseDecimal parses decimal string such as "0.0125" exactly
+func parseDecimal(s string) (*big.Rat, error) {
+	if s == "" || strings.ContainsAny(s, "/eE") {
+		return nil, fmt.Errorf("invalid decimal %s", s)
+	}
+	if i := strings.IndexByte(s, '.'); i >= 0 && len(s)-i-1 > maxPriceDecimals {
+		return nil, fmt.Errorf("decimal %s has more than %d decimal places", s, maxPriceDecimals)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() < 0 {
+		return nil, fmt.Errorf("invalid decimal %s", s)
+	}
+	return r, nil
+}
+
+// formatDecimal formats the exact value without trailing zeros
+func formatDecimal(r *big.Rat) string {
+	s := r.FloatString(maxPriceDecimals)
+	if strings.IndexByte(s, '.') >= 0 {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+type billableCDR struct {
+	customer string
+	dt       int64
+	mcc      uint64
+	mnc      uint64
+	hash     types.Hash
+	ratio    *big.Rat // charged ratio of the price by dispute resolution, nil means the full price
+	kind     CDRKind
+	usage    uint64
+	// CDRs of the billing period before the invoice period, which are counted in tiers and commitments only
+	counted bool
+}
+
+// billingPeriod is [start, end) of a calendar month in UTC, tiers and minimum commitments apply to each
+// billing period no matter how invoices are queried
+type billingPeriod struct {
+	start int64
+	end   int64
+}
+
+// billingPeriodOf returns the billing period which contains dt
+func billingPeriodOf(dt int64) billingPeriod {
+	t := time.Unix(dt, 0).UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return billingPeriod{start: start.Unix(), end: start.AddDate(0, 1, 0).Unix()}
+}
+
+// chargeKey groups the charges of a customer by the kind of CDRs and the billing unit
+type chargeKey struct {
+	customer string
+	kind     CDRKind
+	unit     BillingUnit
+}
+
+type customerCharge struct {
+	count    uint64
+	quantity *big.Rat
+	amount   *big.Rat
+	versions []uint64
+	disputed uint64
+}
+
+func newCustomerCharge() *customerCharge {
+	return &customerCharge{quantity: new(big.Rat), amount: new(big.Rat)}
+}
+
+func (z *customerCharge) add(quantity, price *big.Rat, version uint64) {
+	z.count++
+	z.quantity.Add(z.quantity, quantity)
+	z.amount.Add(z.amount, price)
+	if len(z.versions) == 0 || z.versions[len(z.versions)-1] != version {
+		z.versions = append(z.versions, version)
+	}
+}
+
+// addDisputed adds the count of disputed CDRs to the charges of the customers by the kind, the charge in the
+// first unit of the kind is used if the customer has charges in several units
+func addDisputed(charges map[chargeKey]*customerCharge, disputed map[chargeKey]uint64) {
+	for k, count := range disputed {
+		var found *customerCharge
+		for _, unit := range billingUnits[k.kind] {
+			if c, ok := charges[chargeKey{customer: k.customer, kind: k.kind, unit: unit}]; ok {
+				found = c
+				break
+			}
+		}
+		if found == nil {
+			found = newCustomerCharge()
+			charges[chargeKey{customer: k.customer, kind: k.kind, unit: billingUnits[k.kind][0]}] = found
+		}
+		found.disputed += count
+	}
+}
+
+// chargeCDRs charges each CDR by the rate card in force at its time, the volume of tiers is counted by the billing
+// period, the kind and the unit in time order across all customers of the contract, and the charges of the billing
+// periods are returned for the minimum commitments. CDRs without rate of the destination are not charged.
+func chargeCDRs(cards []*RateCard, cdrs []*billableCDR) (map[chargeKey]*customerCharge, map[billingPeriod]*big.Rat, error) {
+	sort.Slice(cdrs, func(i, j int) bool {
+		if cdrs[i].dt != cdrs[j].dt {
+			return cdrs[i].dt < cdrs[j].dt
+		}
+		return bytes.Compare(cdrs[i].hash[:], cdrs[j].hash[:]) < 0
+	})
+
+	type volumeKey struct {
+		period billingPeriod
+		kind   CDRKind
+		unit   BillingUnit
+	}
+	charges := make(map[chargeKey]*customerCharge)
+	totals := make(map[billingPeriod]*big.Rat)
+	volumes := make(map[volumeKey]*big.Rat)
+	var errs []string
+	for _, cdr := range cdrs {
+		card := rateCardAt(cards, cdr.dt)
+		rate, err := card.Rate(cdr.kind, cdr.mcc, cdr.mnc)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s(%s, mcc %d, mnc %d): %s", cdr.hash, cdr.kind, cdr.mcc, cdr.mnc, err))
+			continue
+		}
+		period := billingPeriodOf(cdr.dt)
+		vk := volumeKey{period: period, kind: cdr.kind, unit: rate.Unit}
+		volume, ok := volumes[vk]
+		if !ok {
+			volume = new(big.Rat)
+			volumes[vk] = volume
+		}
+		quantity := rate.Unit.quantity(cdr.usage)
+		amount, err := rate.charge(volume, quantity)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", cdr.hash, err))
+			continue
+		}
+		volume.Add(volume, quantity)
+		if cdr.ratio != nil {
+			amount.Mul(amount, cdr.ratio)
+		}
+		total, ok := totals[period]
+		if !ok {
+			total = new(big.Rat)
+			totals[period] = total
+		}
+		total.Add(total, amount)
+		if cdr.counted {
+			continue
+		}
+		key := chargeKey{customer: cdr.customer, kind: cdr.kind, unit: rate.Unit}
+		c, ok := charges[key]
+		if !ok {
+			c = newCustomerCharge()
+			charges[key] = c
+		}
+		c.add(quantity, amount, card.Version)
+	}
+
+	if len(errs) > 0 {
+		return charges, totals, errors.New(strings.Join(errs, "; "))
+	}
+	return charges, totals, nil
+}
+
+// commitmentShortfall is the charge below the minimum commitment of a billing period
+type commitmentShortfall struct {
+	period billingPeriod
+	card   *RateCard
+	amount *big.Rat
+}
+
+// commitmentShortfalls returns the shortfalls of the billing periods which close in the invoice period [start, end],
+// so the shortfall of a billing period is charged once. Billing periods are clipped to the contract period
+// [from, to], and the rate card in force at the close of a billing period is used.
+func commitmentShortfalls(cards []*RateCard, totals map[billingPeriod]*big.Rat, from, to, start, end int64) []*commitmentShortfall {
+	var result []*commitmentShortfall
+	for period := billingPeriodOf(from); period.start <= to; period = billingPeriodOf(period.end) {
+		closed := period.end - 1
+		if closed > to {
+			closed = to
+		}
+		if closed < start {
+			continue
+		}
+		if closed > end {
+			break
+		}
+		card := rateCardAt(cards, closed)
+		if card.MinCommitment == "" {
+			continue
+		}
+		min, err := parseDecimal(card.MinCommitment)
+		if err != nil {
+			continue
+		}
+		total, ok := totals[period]
+		if !ok {
+			total = new(big.Rat)
+		}
+		if min.Cmp(total) > 0 {
+			result = append(result, &commitmentShortfall{period: period, card: card, amount: new(big.Rat).Sub(min, total)})
+		}
+	}
+	return result
+}
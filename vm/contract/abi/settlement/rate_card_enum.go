@@ -0,0 +1,82 @@
+// Code generated by go-enum
+// DO NOT EDIT!
+
+package settlement
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// BillingUnitMessage is a BillingUnit of type Message
+	BillingUnitMessage BillingUnit = iota
+	// BillingUnitSecond is a BillingUnit of type Second
+	BillingUnitSecond
+	// BillingUnitMinute is a BillingUnit of type Minute
+	BillingUnitMinute
+	// BillingUnitMB is a BillingUnit of type MB
+	BillingUnitMB
+)
+
+const _BillingUnitName = "MessageSecondMinuteMB"
+
+var _BillingUnitNames = []string{
+	_BillingUnitName[0:7],
+	_BillingUnitName[7:13],
+	_BillingUnitName[13:19],
+	_BillingUnitName[19:21],
+}
+
+// BillingUnitNames returns a list of possible string values of BillingUnit.
+func BillingUnitNames() []string {
+	tmp := make([]string, len(_BillingUnitNames))
+	copy(tmp, _BillingUnitNames)
+	return tmp
+}
+
+var _BillingUnitMap = map[BillingUnit]string{
+	0: _BillingUnitName[0:7],
+	1: _BillingUnitName[7:13],
+	2: _BillingUnitName[13:19],
+	3: _BillingUnitName[19:21],
+}
+
+// String implements the Stringer interface.
+func (x BillingUnit) String() string {
+	if str, ok := _BillingUnitMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("BillingUnit(%d)", x)
+}
+
+var _BillingUnitValue = map[string]BillingUnit{
+	_BillingUnitName[0:7]:   0,
+	_BillingUnitName[7:13]:  1,
+	_BillingUnitName[13:19]: 2,
+	_BillingUnitName[19:21]: 3,
+}
+
+// ParseBillingUnit attempts to convert a string to a BillingUnit
+func ParseBillingUnit(name string) (BillingUnit, error) {
+	if x, ok := _BillingUnitValue[name]; ok {
+		return x, nil
+	}
+	return BillingUnit(0), fmt.Errorf("%s is not a valid BillingUnit, try [%s]", name, strings.Join(_BillingUnitNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x BillingUnit) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *BillingUnit) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseBillingUnit(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
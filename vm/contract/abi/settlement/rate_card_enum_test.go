@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBillingUnit(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    BillingUnit
+		wantErr bool
+	}{
+		{
+			name:    "Minute",
+			want:    BillingUnitMinute,
+			wantErr: false,
+		}, {
+			name:    "KB",
+			want:    BillingUnitMessage,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBillingUnit(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBillingUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseBillingUnit() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBillingUnit_MarshalText(t *testing.T) {
+	if got := BillingUnitNames(); !reflect.DeepEqual(got, []string{"Message", "Second", "Minute", "MB"}) {
+		t.Errorf("BillingUnitNames() = %v", got)
+	}
+	for _, x := range []BillingUnit{BillingUnitMessage, BillingUnitSecond, BillingUnitMinute, BillingUnitMB} {
+		text, err := x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var u BillingUnit
+		if err := u.UnmarshalText(text); err != nil || u != x {
+			t.Fatalf("UnmarshalText() got = %v, want %v, %v", u, x, err)
+		}
+	}
+	if s := BillingUnit(4).String(); s != "BillingUnit(4)" {
+		t.Errorf("String() got = %s", s)
+	}
+}
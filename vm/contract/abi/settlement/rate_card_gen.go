@@ -0,0 +1,1009 @@
+package settlement
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *BillingUnit) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = BillingUnit(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z BillingUnit) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z BillingUnit) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *BillingUnit) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = BillingUnit(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z BillingUnit) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Rate) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "mcc":
+			z.Mcc, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Mcc")
+				return
+			}
+		case "mnc":
+			z.Mnc, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Mnc")
+				return
+			}
+		case "t":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Tiers")
+				return
+			}
+			if cap(z.Tiers) >= int(zb0002) {
+				z.Tiers = (z.Tiers)[:zb0002]
+			} else {
+				z.Tiers = make([]*RateTier, zb0002)
+			}
+			for za0001 := range z.Tiers {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "Tiers", za0001)
+						return
+					}
+					z.Tiers[za0001] = nil
+				} else {
+					if z.Tiers[za0001] == nil {
+						z.Tiers[za0001] = new(RateTier)
+					}
+					var zb0003 uint32
+					zb0003, err = dc.ReadMapHeader()
+					if err != nil {
+						err = msgp.WrapError(err, "Tiers", za0001)
+						return
+					}
+					for zb0003 > 0 {
+						zb0003--
+						field, err = dc.ReadMapKeyPtr()
+						if err != nil {
+							err = msgp.WrapError(err, "Tiers", za0001)
+							return
+						}
+						switch msgp.UnsafeString(field) {
+						case "f":
+							z.Tiers[za0001].From, err = dc.ReadUint64()
+							if err != nil {
+								err = msgp.WrapError(err, "Tiers", za0001, "From")
+								return
+							}
+						case "p":
+							z.Tiers[za0001].Price, err = dc.ReadString()
+							if err != nil {
+								err = msgp.WrapError(err, "Tiers", za0001, "Price")
+								return
+							}
+						default:
+							err = dc.Skip()
+							if err != nil {
+								err = msgp.WrapError(err, "Tiers", za0001)
+								return
+							}
+						}
+					}
+				}
+			}
+		case "k":
+			err = z.Kind.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Kind")
+				return
+			}
+		case "u":
+			{
+				var zb0004 int
+				zb0004, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Unit")
+					return
+				}
+				z.Unit = BillingUnit(zb0004)
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Rate) EncodeMsg(en *msgp.Writer) (err error) {
+	// omitempty: check for empty values
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	if z.Unit == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+	if zb0001Len == 0 {
+		return
+	}
+	// write "mcc"
+	err = en.Append(0xa3, 0x6d, 0x63, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Mcc)
+	if err != nil {
+		err = msgp.WrapError(err, "Mcc")
+		return
+	}
+	// write "mnc"
+	err = en.Append(0xa3, 0x6d, 0x6e, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Mnc)
+	if err != nil {
+		err = msgp.WrapError(err, "Mnc")
+		return
+	}
+	// write "t"
+	err = en.Append(0xa1, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Tiers)))
+	if err != nil {
+		err = msgp.WrapError(err, "Tiers")
+		return
+	}
+	for za0001 := range z.Tiers {
+		if z.Tiers[za0001] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			// map header, size 2
+			// write "f"
+			err = en.Append(0x82, 0xa1, 0x66)
+			if err != nil {
+				return
+			}
+			err = en.WriteUint64(z.Tiers[za0001].From)
+			if err != nil {
+				err = msgp.WrapError(err, "Tiers", za0001, "From")
+				return
+			}
+			// write "p"
+			err = en.Append(0xa1, 0x70)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Tiers[za0001].Price)
+			if err != nil {
+				err = msgp.WrapError(err, "Tiers", za0001, "Price")
+				return
+			}
+		}
+	}
+	// write "k"
+	err = en.Append(0xa1, 0x6b)
+	if err != nil {
+		return
+	}
+	err = z.Kind.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Kind")
+		return
+	}
+	if (zb0001Mask & 0x10) == 0 { // if not empty
+		// write "u"
+		err = en.Append(0xa1, 0x75)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(int(z.Unit))
+		if err != nil {
+			err = msgp.WrapError(err, "Unit")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Rate) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	if z.Unit == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len == 0 {
+		return
+	}
+	// string "mcc"
+	o = append(o, 0xa3, 0x6d, 0x63, 0x63)
+	o = msgp.AppendUint64(o, z.Mcc)
+	// string "mnc"
+	o = append(o, 0xa3, 0x6d, 0x6e, 0x63)
+	o = msgp.AppendUint64(o, z.Mnc)
+	// string "t"
+	o = append(o, 0xa1, 0x74)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Tiers)))
+	for za0001 := range z.Tiers {
+		if z.Tiers[za0001] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			// map header, size 2
+			// string "f"
+			o = append(o, 0x82, 0xa1, 0x66)
+			o = msgp.AppendUint64(o, z.Tiers[za0001].From)
+			// string "p"
+			o = append(o, 0xa1, 0x70)
+			o = msgp.AppendString(o, z.Tiers[za0001].Price)
+		}
+	}
+	// string "k"
+	o = append(o, 0xa1, 0x6b)
+	o, err = z.Kind.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Kind")
+		return
+	}
+	if (zb0001Mask & 0x10) == 0 { // if not empty
+		// string "u"
+		o = append(o, 0xa1, 0x75)
+		o = msgp.AppendInt(o, int(z.Unit))
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Rate) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "mcc":
+			z.Mcc, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Mcc")
+				return
+			}
+		case "mnc":
+			z.Mnc, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Mnc")
+				return
+			}
+		case "t":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Tiers")
+				return
+			}
+			if cap(z.Tiers) >= int(zb0002) {
+				z.Tiers = (z.Tiers)[:zb0002]
+			} else {
+				z.Tiers = make([]*RateTier, zb0002)
+			}
+			for za0001 := range z.Tiers {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Tiers[za0001] = nil
+				} else {
+					if z.Tiers[za0001] == nil {
+						z.Tiers[za0001] = new(RateTier)
+					}
+					var zb0003 uint32
+					zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Tiers", za0001)
+						return
+					}
+					for zb0003 > 0 {
+						zb0003--
+						field, bts, err = msgp.ReadMapKeyZC(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Tiers", za0001)
+							return
+						}
+						switch msgp.UnsafeString(field) {
+						case "f":
+							z.Tiers[za0001].From, bts, err = msgp.ReadUint64Bytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Tiers", za0001, "From")
+								return
+							}
+						case "p":
+							z.Tiers[za0001].Price, bts, err = msgp.ReadStringBytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Tiers", za0001, "Price")
+								return
+							}
+						default:
+							bts, err = msgp.Skip(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Tiers", za0001)
+								return
+							}
+						}
+					}
+				}
+			}
+		case "k":
+			bts, err = z.Kind.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Kind")
+				return
+			}
+		case "u":
+			{
+				var zb0004 int
+				zb0004, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Unit")
+					return
+				}
+				z.Unit = BillingUnit(zb0004)
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Rate) Msgsize() (s int) {
+	s = 1 + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 2 + msgp.ArrayHeaderSize
+	for za0001 := range z.Tiers {
+		if z.Tiers[za0001] == nil {
+			s += msgp.NilSize
+		} else {
+			s += 1 + 2 + msgp.Uint64Size + 2 + msgp.StringPrefixSize + len(z.Tiers[za0001].Price)
+		}
+	}
+	s += 2 + z.Kind.Msgsize() + 2 + msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *RateCard) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "v":
+			z.Version, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		case "e":
+			z.EffectiveDate, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "EffectiveDate")
+				return
+			}
+		case "c":
+			z.Currency, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Currency")
+				return
+			}
+		case "mc":
+			z.MinCommitment, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "MinCommitment")
+				return
+			}
+		case "r":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Rates")
+				return
+			}
+			if cap(z.Rates) >= int(zb0002) {
+				z.Rates = (z.Rates)[:zb0002]
+			} else {
+				z.Rates = make([]*Rate, zb0002)
+			}
+			for za0001 := range z.Rates {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "Rates", za0001)
+						return
+					}
+					z.Rates[za0001] = nil
+				} else {
+					if z.Rates[za0001] == nil {
+						z.Rates[za0001] = new(Rate)
+					}
+					err = z.Rates[za0001].DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "Rates", za0001)
+						return
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *RateCard) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "v"
+	err = en.Append(0x85, 0xa1, 0x76)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Version)
+	if err != nil {
+		err = msgp.WrapError(err, "Version")
+		return
+	}
+	// write "e"
+	err = en.Append(0xa1, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.EffectiveDate)
+	if err != nil {
+		err = msgp.WrapError(err, "EffectiveDate")
+		return
+	}
+	// write "c"
+	err = en.Append(0xa1, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Currency)
+	if err != nil {
+		err = msgp.WrapError(err, "Currency")
+		return
+	}
+	// write "mc"
+	err = en.Append(0xa2, 0x6d, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.MinCommitment)
+	if err != nil {
+		err = msgp.WrapError(err, "MinCommitment")
+		return
+	}
+	// write "r"
+	err = en.Append(0xa1, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Rates)))
+	if err != nil {
+		err = msgp.WrapError(err, "Rates")
+		return
+	}
+	for za0001 := range z.Rates {
+		if z.Rates[za0001] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.Rates[za0001].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "Rates", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *RateCard) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "v"
+	o = append(o, 0x85, 0xa1, 0x76)
+	o = msgp.AppendUint64(o, z.Version)
+	// string "e"
+	o = append(o, 0xa1, 0x65)
+	o = msgp.AppendInt64(o, z.EffectiveDate)
+	// string "c"
+	o = append(o, 0xa1, 0x63)
+	o = msgp.AppendString(o, z.Currency)
+	// string "mc"
+	o = append(o, 0xa2, 0x6d, 0x63)
+	o = msgp.AppendString(o, z.MinCommitment)
+	// string "r"
+	o = append(o, 0xa1, 0x72)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Rates)))
+	for za0001 := range z.Rates {
+		if z.Rates[za0001] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Rates[za0001].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Rates", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *RateCard) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "v":
+			z.Version, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		case "e":
+			z.EffectiveDate, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EffectiveDate")
+				return
+			}
+		case "c":
+			z.Currency, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Currency")
+				return
+			}
+		case "mc":
+			z.MinCommitment, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinCommitment")
+				return
+			}
+		case "r":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Rates")
+				return
+			}
+			if cap(z.Rates) >= int(zb0002) {
+				z.Rates = (z.Rates)[:zb0002]
+			} else {
+				z.Rates = make([]*Rate, zb0002)
+			}
+			for za0001 := range z.Rates {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Rates[za0001] = nil
+				} else {
+					if z.Rates[za0001] == nil {
+						z.Rates[za0001] = new(Rate)
+					}
+					bts, err = z.Rates[za0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Rates", za0001)
+						return
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *RateCard) Msgsize() (s int) {
+	s = 1 + 2 + msgp.Uint64Size + 2 + msgp.Int64Size + 2 + msgp.StringPrefixSize + len(z.Currency) + 3 + msgp.StringPrefixSize + len(z.MinCommitment) + 2 + msgp.ArrayHeaderSize
+	for za0001 := range z.Rates {
+		if z.Rates[za0001] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Rates[za0001].Msgsize()
+		}
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *RateCardParam) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			err = z.ContractAddress.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "rc":
+			err = z.RateCard.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "RateCard")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *RateCardParam) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "ca"
+	err = en.Append(0x82, 0xa2, 0x63, 0x61)
+	if err != nil {
+		return
+	}
+	err = z.ContractAddress.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// write "rc"
+	err = en.Append(0xa2, 0x72, 0x63)
+	if err != nil {
+		return
+	}
+	err = z.RateCard.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "RateCard")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *RateCardParam) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "ca"
+	o = append(o, 0x82, 0xa2, 0x63, 0x61)
+	o, err = z.ContractAddress.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// string "rc"
+	o = append(o, 0xa2, 0x72, 0x63)
+	o, err = z.RateCard.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "RateCard")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *RateCardParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			bts, err = z.ContractAddress.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "rc":
+			bts, err = z.RateCard.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RateCard")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *RateCardParam) Msgsize() (s int) {
+	s = 1 + 3 + z.ContractAddress.Msgsize() + 3 + z.RateCard.Msgsize()
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *RateTier) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "f":
+			z.From, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "From")
+				return
+			}
+		case "p":
+			z.Price, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Price")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z RateTier) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "f"
+	err = en.Append(0x82, 0xa1, 0x66)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.From)
+	if err != nil {
+		err = msgp.WrapError(err, "From")
+		return
+	}
+	// write "p"
+	err = en.Append(0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Price)
+	if err != nil {
+		err = msgp.WrapError(err, "Price")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z RateTier) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "f"
+	o = append(o, 0x82, 0xa1, 0x66)
+	o = msgp.AppendUint64(o, z.From)
+	// string "p"
+	o = append(o, 0xa1, 0x70)
+	o = msgp.AppendString(o, z.Price)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *RateTier) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "f":
+			z.From, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "From")
+				return
+			}
+		case "p":
+			z.Price, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Price")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z RateTier) Msgsize() (s int) {
+	s = 1 + 2 + msgp.Uint64Size + 2 + msgp.StringPrefixSize + len(z.Price)
+	return
+}
@@ -0,0 +1,498 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func mockRateCard(effective int64) *RateCard {
+	return &RateCard{
+		EffectiveDate: effective,
+		Currency:      "USD",
+		Rates: []*Rate{
+			{Tiers: []*RateTier{{From: 0, Price: "0.1"}, {From: 2, Price: "0.05"}}},
+			{Mcc: 454, Mnc: 0, Tiers: []*RateTier{{From: 0, Price: "0.2"}}},
+		},
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, s := range []string{"", "abc", "-1", "1/3", "1e3", "0.123456789"} {
+		if _, err := parseDecimal(s); err == nil {
+			t.Fatalf("%s should be invalid", s)
+		}
+	}
+	r, err := parseDecimal("0.12345678")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := formatDecimal(r); s != "0.12345678" {
+		t.Fatal(s)
+	}
+	if s := formatDecimal(big.NewRat(3, 1)); s != "3" {
+		t.Fatal(s)
+	}
+}
+
+func TestRateCard_Verify(t *testing.T) {
+	card := mockRateCard(1)
+	if err := card.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	card = mockRateCard(1)
+	card.Rates[0].Tiers[0].From = 1
+	if err := card.Verify(); err == nil {
+		t.Fatal("first tier should start from 0")
+	}
+	card = mockRateCard(1)
+	card.Rates[0].Tiers[1].From = 0
+	if err := card.Verify(); err == nil {
+		t.Fatal("tiers should be in ascending order")
+	}
+	card = mockRateCard(1)
+	card.Rates[1].Mcc = 0
+	if err := card.Verify(); err == nil {
+		t.Fatal("duplicate destination")
+	}
+	card = mockRateCard(1)
+	card.MinCommitment = "x"
+	if err := card.Verify(); err == nil {
+		t.Fatal("invalid min commitment")
+	}
+	card = mockRateCard(0)
+	if err := card.Verify(); err == nil {
+		t.Fatal("invalid effective date")
+	}
+}
+
+func TestRateCard_Price(t *testing.T) {
+	card := mockRateCard(1)
+	cases := []struct {
+		mcc, mnc, volume uint64
+		exp              string
+	}{
+		{0, 0, 0, "0.1"},
+		{0, 0, 1, "0.1"},
+		{0, 0, 2, "0.05"},
+		{460, 1, 100, "0.05"},
+		{454, 0, 100, "0.2"},
+	}
+	for _, c := range cases {
+		p, err := card.Price(CDRKindSMS, c.mcc, c.mnc, c.volume)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := formatDecimal(p); s != c.exp {
+			t.Fatalf("%v: exp %s, act %s", c, c.exp, s)
+		}
+	}
+
+	card.Rates = card.Rates[1:]
+	if _, err := card.Price(CDRKindSMS, 460, 1, 0); err != ErrNoRate {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateContractParam_RateCards(t *testing.T) {
+	param := buildContractParam()
+	cards := param.GetRateCards()
+	if len(cards) != 1 || cards[0].EffectiveDate != param.StartDate || cards[0].Rates[0].Tiers[0].Price != "2" {
+		t.Fatal(cards)
+	}
+
+	a1, _ := param.Address()
+	param.RateCards = []*RateCard{mockRateCard(param.StartDate), mockRateCard(param.StartDate + 100)}
+	if _, err := param.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if a2, _ := param.Address(); a1 == a2 {
+		t.Fatal("rate cards should change the contract address")
+	}
+
+	param.RateCards = []*RateCard{mockRateCard(param.StartDate + 1)}
+	if _, err := param.Verify(); err == nil {
+		t.Fatal("first rate card should be effective from the start date")
+	}
+	param.RateCards = []*RateCard{mockRateCard(param.StartDate), mockRateCard(param.StartDate)}
+	if _, err := param.Verify(); err == nil {
+		t.Fatal("rate cards should be in ascending order")
+	}
+}
+
+func TestRate_Charge(t *testing.T) {
+	rate := &Rate{Tiers: []*RateTier{{From: 0, Price: "0.1"}, {From: 2, Price: "0.05"}, {From: 5, Price: "0.01"}}}
+	for _, c := range []struct {
+		volume, quantity *big.Rat
+		exp              string
+	}{
+		{big.NewRat(0, 1), big.NewRat(1, 1), "0.1"},
+		{big.NewRat(2, 1), big.NewRat(1, 1), "0.05"},
+		// 2 * 0.1 + 3 * 0.05 + 1 * 0.01
+		{big.NewRat(0, 1), big.NewRat(6, 1), "0.36"},
+		// 0.5 * 0.1 + 1 * 0.05
+		{big.NewRat(3, 2), big.NewRat(3, 2), "0.1"},
+		{big.NewRat(10, 1), big.NewRat(2, 1), "0.02"},
+	} {
+		amount, err := rate.charge(c.volume, c.quantity)
+		if err != nil || formatDecimal(amount) != c.exp {
+			t.Fatal(c.volume, c.quantity, formatDecimal(amount), err)
+		}
+	}
+}
+
+func TestBillingPeriodOf(t *testing.T) {
+	dt := time.Date(2020, 2, 29, 23, 59, 59, 0, time.UTC).Unix()
+	p := billingPeriodOf(dt)
+	if p.start != time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC).Unix() || p.end != dt+1 {
+		t.Fatal(p)
+	}
+	if billingPeriodOf(p.end).start != p.end || billingPeriodOf(p.start) != p {
+		t.Fatal("invalid billing period")
+	}
+}
+
+func TestChargeCDRs(t *testing.T) {
+	cards := []*RateCard{mockRateCard(100), mockRateCard(200)}
+	cards[1].Version = 1
+	cards[1].Rates[0].Tiers = []*RateTier{{From: 0, Price: "0.3"}}
+	cards[1].MinCommitment = "10"
+
+	jan := billingPeriodOf(100)
+	cdrs := []*billableCDR{
+		// counted in the tiers of the billing period, but charged by the invoice before
+		{customer: "c1", dt: 90, hash: mock.Hash(), counted: true},
+		{customer: "c1", dt: 150, hash: mock.Hash()},
+		{customer: "c1", dt: 120, hash: mock.Hash()},
+		{customer: "c2", dt: 160, hash: mock.Hash()},
+		{customer: "c2", dt: 210, hash: mock.Hash()},
+		{customer: "c2", dt: 220, mcc: 454, hash: mock.Hash()},
+		{customer: "c1", dt: 230, mcc: 460, mnc: 1, hash: mock.Hash()},
+		{customer: "c2", dt: jan.end + 10, hash: mock.Hash()},
+	}
+	charges, totals, err := chargeCDRs(cards, cdrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// c1: 0.1 + 0.05 + 0.3, c2: 0.05 + 0.3 + 0.2 + 0.3(next billing period)
+	if c := charges[chargeKey{customer: "c1"}]; c.count != 3 || formatDecimal(c.amount) != "0.45" || len(c.versions) != 2 {
+		t.Fatal(formatDecimal(c.amount), c.versions)
+	}
+	if c := charges[chargeKey{customer: "c2"}]; c.count != 4 || formatDecimal(c.amount) != "0.85" {
+		t.Fatal(formatDecimal(c.amount))
+	}
+	feb := billingPeriodOf(jan.end)
+	if len(totals) != 2 || formatDecimal(totals[jan]) != "1.1" || formatDecimal(totals[feb]) != "0.3" {
+		t.Fatal(totals)
+	}
+
+	// the contract ends in Feb
+	shortfalls := commitmentShortfalls(cards, totals, 100, feb.start+100, 0, feb.end)
+	if len(shortfalls) != 2 || shortfalls[0].period != jan || shortfalls[0].card.Version != 1 ||
+		formatDecimal(shortfalls[0].amount) != "8.9" || formatDecimal(shortfalls[1].amount) != "9.7" {
+		t.Fatal(shortfalls)
+	}
+	// the shortfall is charged by the invoice period which the billing period closes in
+	if shortfalls := commitmentShortfalls(cards, totals, 100, feb.start+100, 150, jan.end-2); len(shortfalls) != 0 {
+		t.Fatal(shortfalls)
+	}
+	if shortfalls := commitmentShortfalls(cards, totals, 100, feb.start+100, jan.end, feb.start+100); len(shortfalls) != 1 ||
+		shortfalls[0].period != feb {
+		t.Fatal(shortfalls)
+	}
+
+	// tiers start over in the next billing period
+	card := mockRateCard(100)
+	if _, totals, err := chargeCDRs([]*RateCard{card}, []*billableCDR{
+		{customer: "c1", dt: 110, hash: mock.Hash()},
+		{customer: "c1", dt: 120, hash: mock.Hash()},
+		{customer: "c1", dt: 130, hash: mock.Hash()},
+		{customer: "c1", dt: jan.end, hash: mock.Hash()},
+	}); err != nil || formatDecimal(totals[jan]) != "0.25" || formatDecimal(totals[feb]) != "0.1" {
+		t.Fatal(totals, err)
+	}
+
+	cards[1].Rates = cards[1].Rates[1:]
+	if _, _, err := chargeCDRs(cards, cdrs); err == nil {
+		t.Fatal("CDRs without rate should be reported")
+	}
+}
+
+func TestGenerateInvoices_RateCards(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	a1 := mock.Address()
+	a2 := mock.Address()
+	param := buildContractParam()
+	param.PartyA.Address = a1
+	param.PartyB.Address = a2
+	// the contract starts before the billing period
+	period := billingPeriodOf(time.Now().Unix())
+	now := period.start + 15*24*3600
+	param.StartDate = period.start - 24*3600
+	card1 := mockRateCard(param.StartDate)
+	card2 := mockRateCard(now)
+	card2.Version = 1
+	card2.Rates[0].Tiers = []*RateTier{{From: 0, Price: "0.3"}}
+	card2.MinCommitment = "2"
+	param.RateCards = []*RateCard{card1, card2}
+
+	contractAddr, err := param.Address()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abi, _ := param.ToABI()
+	if err := SaveContractParam(ctx, &contractAddr, abi[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	// 3 CDRs before the new version and 2 after it
+	for i := 0; i < 5; i++ {
+		p := cdrParam
+		p.Index = uint64(i + 1)
+		p.SmsDt = now - 150 + int64(i)*50
+		s := &CDRStatus{
+			Params: map[string][]CDRParam{a1.String(): {p}, a2.String(): {p}},
+			Status: SettlementStatusSuccess,
+		}
+		h, err := s.ToHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		abi, _ := s.ToABI()
+		if err := ctx.SetStorage(contractAddr[:], h[:], abi); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	invoices, err := GenerateInvoicesByContract(l, &contractAddr, period.start, period.end-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invoices) != 2 {
+		t.Fatal(invoices)
+	}
+	var charged, shortfall *InvoiceRecord
+	for _, invoice := range invoices {
+		if invoice.Customer == InvoiceCustomerCommitment {
+			shortfall = invoice
+		} else {
+			charged = invoice
+		}
+	}
+	// 0.1 + 0.1 + 0.05 + 0.3 + 0.3
+	if charged == nil || charged.SumOfBillableSMSCustomer != 5 || charged.Charge != "0.85" || charged.SumOfTOTPrice != 0.85 ||
+		len(charged.RateCardVersions) != 2 || charged.Currency != "USD" {
+		t.Fatal(charged)
+	}
+	if shortfall == nil || shortfall.Charge != "1.15" || shortfall.StartDate != period.start || shortfall.EndDate != period.end-1 {
+		t.Fatal(shortfall)
+	}
+
+	// tiers are counted from the start of the billing period, and the shortfall is not charged before it closes
+	invoices, err = GenerateInvoicesByContract(l, &contractAddr, now-100, now+1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0.1 + 0.05 + 0.3 + 0.3, the first CDR is charged at 0.1 before
+	if len(invoices) != 1 || invoices[0].SumOfBillableSMSCustomer != 4 || invoices[0].Charge != "0.75" {
+		t.Fatal(invoices)
+	}
+}
+
+func TestRate_Unit(t *testing.T) {
+	card := mockRateCard(1)
+	card.Rates = append(card.Rates,
+		&Rate{Kind: CDRKindVoice, Unit: BillingUnitMinute, Tiers: []*RateTier{{From: 0, Price: "0.02"}}},
+		&Rate{Kind: CDRKindData, Unit: BillingUnitMB, Tiers: []*RateTier{{From: 0, Price: "0.01"}}},
+	)
+	if err := card.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := card.Rate(CDRKindVoice, 454, 0); err != nil || r.Unit != BillingUnitMinute {
+		t.Fatal(r, err)
+	}
+
+	card.Rates[2].Unit = BillingUnitMB
+	if err := card.Verify(); err == nil {
+		t.Fatal("voice CDRs should not be charged per MB")
+	}
+	card.Rates[2].Unit = BillingUnitSecond
+	card.Rates = append(card.Rates, &Rate{Kind: CDRKindVoice, Unit: BillingUnitMinute, Tiers: []*RateTier{{From: 0, Price: "1"}}})
+	if err := card.Verify(); err == nil {
+		t.Fatal("duplicated voice rate should be reported")
+	}
+
+	if _, err := mockRateCard(1).Rate(CDRKindData, 460, 1); err == nil {
+		t.Fatal("data CDRs without rate should be reported")
+	}
+
+	for unit, exp := range map[BillingUnit]string{
+		BillingUnitMessage: "1",
+		BillingUnitSecond:  "61",
+		BillingUnitMinute:  "2",
+		BillingUnitMB:      "0.00005817",
+	} {
+		if s := formatDecimal(unit.quantity(61)); s != exp {
+			t.Fatal(unit, s)
+		}
+	}
+}
+
+func TestChargeCDRs_Kinds(t *testing.T) {
+	card := &RateCard{
+		Currency: "USD",
+		Rates: []*Rate{
+			{Kind: CDRKindVoice, Unit: BillingUnitMinute, Tiers: []*RateTier{{From: 0, Price: "0.02"}, {From: 3, Price: "0.01"}}},
+			{Kind: CDRKindData, Unit: BillingUnitMB, Tiers: []*RateTier{{From: 0, Price: "0.5"}}},
+		},
+	}
+	cards := []*RateCard{card}
+	cdrs := []*billableCDR{
+		{customer: "c1", dt: 100, kind: CDRKindVoice, usage: 61, hash: mock.Hash()},
+		{customer: "c1", dt: 110, kind: CDRKindVoice, usage: 30, hash: mock.Hash()},
+		{customer: "c1", dt: 120, kind: CDRKindVoice, usage: 60, hash: mock.Hash()},
+		{customer: "c1", dt: 130, kind: CDRKindData, usage: 3 * bytesPerMB / 2, hash: mock.Hash()},
+	}
+	charges, totals, err := chargeCDRs(cards, cdrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// voice: 2 + 1 minutes at 0.02, the third call starts from the 4th minute at 0.01
+	voice := charges[chargeKey{customer: "c1", kind: CDRKindVoice, unit: BillingUnitMinute}]
+	if voice == nil || voice.count != 3 || formatDecimal(voice.quantity) != "4" || formatDecimal(voice.amount) != "0.07" {
+		t.Fatal(voice)
+	}
+	data := charges[chargeKey{customer: "c1", kind: CDRKindData, unit: BillingUnitMB}]
+	if data == nil || data.count != 1 || formatDecimal(data.quantity) != "1.5" || formatDecimal(data.amount) != "0.75" {
+		t.Fatal(data)
+	}
+	if total := totals[billingPeriodOf(100)]; formatDecimal(total) != "0.82" {
+		t.Fatal(formatDecimal(total))
+	}
+
+	// SMS are not charged without SMS rate
+	cdrs = append(cdrs, &billableCDR{customer: "c1", dt: 140, hash: mock.Hash()})
+	if _, _, err := chargeCDRs(cards, cdrs); err == nil {
+		t.Fatal("CDRs without rate should be reported")
+	}
+}
+
+func TestGenerateInvoices_Kinds(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	a1 := mock.Address()
+	a2 := mock.Address()
+	param := buildContractParam()
+	param.PartyA.Address = a1
+	param.PartyB.Address = a2
+	now := time.Now().Unix()
+	card := mockRateCard(param.StartDate)
+	card.Rates = append(card.Rates,
+		&Rate{Kind: CDRKindVoice, Unit: BillingUnitMinute, Tiers: []*RateTier{{From: 0, Price: "0.02"}}},
+		&Rate{Kind: CDRKindData, Unit: BillingUnitMB, Tiers: []*RateTier{{From: 0, Price: "0.5"}}},
+	)
+	param.RateCards = []*RateCard{card}
+
+	contractAddr, err := param.Address()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abi, _ := param.ToABI()
+	if err := SaveContractParam(ctx, &contractAddr, abi[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	save := func(index uint64, p1, p2 CDRParam) {
+		p1.Index, p2.Index = index, index
+		p1.SmsDt, p2.SmsDt = now, now
+		s := &CDRStatus{}
+		if err := s.DoSettlement(SettlementCDR{CDRParam: p1, From: a1}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.DoSettlement(SettlementCDR{CDRParam: p2, From: a2}); err != nil {
+			t.Fatal(err)
+		}
+		h, err := s.ToHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		abi, _ := s.ToABI()
+		if err := ctx.SetStorage(contractAddr[:], h[:], abi); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	voice1, voice2 := cdrParam, cdrParam
+	voice1.Kind, voice1.Duration = CDRKindVoice, 61
+	voice2.Kind, voice2.Duration = CDRKindVoice, 60
+	save(1, voice1, voice2)
+	voice2.Duration = 90
+	save(2, voice1, voice2)
+	data := cdrParam
+	data.Kind, data.Volume = CDRKindData, 3*bytesPerMB
+	save(3, data, data)
+	save(4, cdrParam, cdrParam)
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	invoices, err := GenerateInvoicesByContract(l, &contractAddr, now-1000, now+1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := make(map[CDRKind]*InvoiceRecord)
+	for _, invoice := range invoices {
+		records[invoice.Kind] = invoice
+	}
+	if len(invoices) != 3 {
+		t.Fatal(invoices)
+	}
+	// the mismatched call is not charged, the matched one is charged by the smaller duration
+	if r := records[CDRKindVoice]; r.SumOfBillableCDRs != 1 || r.SumOfBillableSMSCustomer != 0 || r.Unit != BillingUnitMinute ||
+		r.Quantity != "1" || r.Charge != "0.02" {
+		t.Fatal(r)
+	}
+	if r := records[CDRKindData]; r.SumOfBillableCDRs != 1 || r.Unit != BillingUnitMB || r.Quantity != "3" ||
+		r.Charge != "1.5" || r.UnitPrice != 0.5 {
+		t.Fatal(r)
+	}
+	if r := records[CDRKindSMS]; r.SumOfBillableCDRs != 1 || r.SumOfBillableSMSCustomer != 1 || r.Charge != "0.1" {
+		t.Fatal(r)
+	}
+
+	report, err := GetSummaryReport(l, &contractAddr, now-1000, now+1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u := report.Usage[CDRKindVoice]; u == nil || u.CDRs != 1 || u.Seconds != 60 || u.Minutes != 1 || u.Mismatch != 1 {
+		t.Fatal(report.Usage)
+	}
+	if u := report.Usage[CDRKindData]; u == nil || u.CDRs != 1 || u.Bytes != 3*bytesPerMB || u.MB != "3" {
+		t.Fatal(report.Usage)
+	}
+	if u := report.Usage[CDRKindSMS]; u == nil || u.CDRs != 1 {
+		t.Fatal(report.Usage)
+	}
+}
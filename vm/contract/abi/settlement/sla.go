@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+var ErrNoSLAAsset = errors.New("can not find any asset with SLA of the contract")
+
+// SLAAttainment is the measured value of a SLA, delivered rate is in 0~1 and latency is in seconds.
+// Rate is the compensation rate(percent of the invoice) of the matched bracket, positive rate is a credit
+// to the customer and negative rate is a penalty charged to the customer.
+type SLAAttainment struct {
+	SLAType  SLAType `json:"type"`
+	Priority uint    `json:"priority"`
+	Target   float32 `json:"target"`
+	Actual   float32 `json:"actual"`
+	Attained bool    `json:"attained"`
+	Matched  bool    `json:"matched"`
+	Rate     float32 `json:"rate"`
+}
+
+// SLAReport is the SLA attainment of a settlement contract in the period
+type SLAReport struct {
+	Address          types.Address    `json:"contractAddress"`
+	StartDate        int64            `json:"startDate"`
+	EndDate          int64            `json:"endDate"`
+	Mcc              uint64           `json:"mcc"`
+	Mnc              uint64           `json:"mnc"`
+	Total            uint64           `json:"total"`
+	Delivered        uint64           `json:"delivered"`
+	Measured         uint64           `json:"measured"`
+	Attainments      []*SLAAttainment `json:"attainments"`
+	CompensationRate float32          `json:"compensationRate"`
+}
+
+func (z *SLAReport) String() string {
+	return util.ToIndentString(z)
+}
+
+// Match returns the compensation bracket which contains the value, Low is inclusive and High is exclusive
+func (z *SLA) Match(value float32) *Compensation {
+	for _, c := range z.Compensations {
+		if value >= c.Low && value < c.High {
+			return c
+		}
+	}
+	return nil
+}
+
+// IsAttained checks the value against the SLA, delivered rate should not be less than the target and
+// latency should not be greater than the target
+func (z *SLA) IsAttained(value float32) bool {
+	switch z.SLAType {
+	case SLATypeDeliveredRate:
+		return value >= z.Value
+	case SLATypeLatency:
+		return value <= z.Value
+	}
+	return false
+}
+
+// normalize returns the SLA whose latencies are in seconds, latencies of SLAs before SLAVersion are in nanoseconds
+func (z *SLA) normalize() *SLA {
+	if z.SLAType != SLATypeLatency || z.Version >= SLAVersion {
+		return z
+	}
+	sla := &SLA{
+		SLAType:  z.SLAType,
+		Priority: z.Priority,
+		Value:    nanoToSeconds(z.Value),
+		Version:  SLAVersion,
+	}
+	for _, c := range z.Compensations {
+		sla.Compensations = append(sla.Compensations, &Compensation{
+			Low:  nanoToSeconds(c.Low),
+			High: nanoToSeconds(c.High),
+			Rate: c.Rate,
+		})
+	}
+	return sla
+}
+
+// decimalOf returns the shortest decimal of f, which is the registered value, to avoid the rounding error of float32
+func decimalOf(f float32) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	return r
+}
+
+func nanoToSeconds(ns float32) float32 {
+	v, _ := new(big.Rat).Quo(decimalOf(ns), big.NewRat(int64(time.Second), 1)).Float32()
+	return v
+}
+
+// newSLAReport evaluates the SLAs of the asset by the CDR records of the contract
+func newSLAReport(addr types.Address, asset *Asset, cdrs []*CDRStatus, start, end int64) *SLAReport {
+	report := &SLAReport{
+		Address:     addr,
+		StartDate:   start,
+		EndDate:     end,
+		Mcc:         asset.Mcc,
+		Mnc:         asset.Mnc,
+		Attainments: make([]*SLAAttainment, 0),
+	}
+
+	var latency int64
+	for _, cdr := range cdrs {
+		switch cdr.Status {
+		case SettlementStatusSuccess:
+			report.Delivered++
+			report.Total++
+		case SettlementStatusFailure:
+			report.Total++
+		}
+		if l, ok := cdr.latency(); ok {
+			latency += l
+			report.Measured++
+		}
+	}
+
+	slas := make([]*SLA, len(asset.SLAs))
+	for i, sla := range asset.SLAs {
+		slas[i] = sla.normalize()
+	}
+	sort.SliceStable(slas, func(i, j int) bool {
+		return slas[i].Priority < slas[j].Priority
+	})
+
+	for _, sla := range slas {
+		var actual float32
+		switch sla.SLAType {
+		case SLATypeDeliveredRate:
+			if report.Total == 0 {
+				continue
+			}
+			actual = float32(report.Delivered) / float32(report.Total)
+		case SLATypeLatency:
+			if report.Measured == 0 {
+				continue
+			}
+			actual = float32(latency) / float32(report.Measured)
+		default:
+			continue
+		}
+		attainment := &SLAAttainment{
+			SLAType:  sla.SLAType,
+			Priority: sla.Priority,
+			Target:   sla.Value,
+			Actual:   actual,
+			Attained: sla.IsAttained(actual),
+		}
+		if c := sla.Match(actual); c != nil {
+			attainment.Matched = true
+			attainment.Rate = c.Rate
+			report.CompensationRate += c.Rate
+		}
+		report.Attainments = append(report.Attainments, attainment)
+	}
+
+	return report
+}
+
+// latency is the interval in seconds between the earliest and the latest CDR of the parties
+func (z *CDRStatus) latency() (int64, bool) {
+	if len(z.Params) < 2 {
+		return 0, false
+	}
+	var min, max int64
+	for _, params := range z.Params {
+		if len(params) == 0 {
+			return 0, false
+		}
+		dt := params[0].SmsDt
+		if min == 0 || dt < min {
+			min = dt
+		}
+		if dt > max {
+			max = dt
+		}
+	}
+	return max - min, true
+}
+
+// applyCompensations adds the compensation line items of the matched SLA brackets to the invoices, the amounts
+// are computed from the exact charge of the invoices
+func applyCompensations(report *SLAReport, invoices []*InvoiceRecord) {
+	for _, invoice := range invoices {
+		charge, ok := new(big.Rat).SetString(invoice.Charge)
+		if !ok {
+			charge = new(big.Rat).SetFloat64(invoice.SumOfTOTPrice)
+		}
+		sum := new(big.Rat)
+		if report != nil && invoice.Customer != InvoiceCustomerCommitment {
+			for _, a := range report.Attainments {
+				if !a.Matched || a.Rate == 0 {
+					continue
+				}
+				amount := new(big.Rat).Mul(charge, decimalOf(a.Rate))
+				amount.Quo(amount, big.NewRat(-100, 1))
+				f, _ := amount.Float64()
+				invoice.Compensations = append(invoice.Compensations, &InvoiceCompensation{
+					SLAType: a.SLAType,
+					Actual:  a.Actual,
+					Rate:    a.Rate,
+					Amount:  f,
+					Charge:  formatDecimal(amount),
+				})
+				sum.Add(sum, amount)
+			}
+		}
+		total := new(big.Rat).Add(charge, sum)
+		invoice.SumOfCompensation, _ = sum.Float64()
+		invoice.CompensationCharge = formatDecimal(sum)
+		invoice.TotalPrice, _ = total.Float64()
+		invoice.TotalCharge = formatDecimal(total)
+	}
+}
+
+// getSLAAsset returns the latest activated asset of the contract parties with SLAs, which has the same mcc/mnc
+// as the service of the contract and is valid in the period
+func getSLAAsset(store ledger.Store, c *ContractParam, start, end int64) (*Asset, error) {
+	if len(c.Services) == 0 {
+		return nil, ErrNoSLAAsset
+	}
+	service := c.Services[0]
+
+	params, err := queryAsserts(store, "getSLAAsset", func(param *AssetParam) bool {
+		if param.Status != AssetStatusActivated {
+			return false
+		}
+		if param.Owner.Address != c.PartyA.Address && param.Owner.Address != c.PartyB.Address {
+			return false
+		}
+		if start != 0 && end != 0 && (param.EndDate < start || param.StartDate > end) {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(params) - 1; i >= 0; i-- {
+		for _, asset := range params[i].Assets {
+			if asset.Mcc == service.Mcc && asset.Mnc == service.Mnc && len(asset.SLAs) > 0 {
+				return asset, nil
+			}
+		}
+	}
+	return nil, ErrNoSLAAsset
+}
+
+// GetSLAReport evaluates the SLA attainment of the settlement contract in the period
+// @param addr settlement contract address
+func GetSLAReport(store ledger.Store, addr *types.Address, start, end int64) (*SLAReport, error) {
+	ctx := vmstore.NewVMContext(store, &contractaddress.SettlementAddress)
+	c, err := GetSettlementContract(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	contractAddr, err := c.Address()
+	if err != nil {
+		return nil, err
+	}
+	asset, err := getSLAAsset(store, c, start, end)
+	if err != nil {
+		return nil, err
+	}
+	cdrs, err := GetCDRStatusByDate(store, &contractAddr, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return newSLAReport(contractAddr, asset, cdrs, start, end), nil
+}
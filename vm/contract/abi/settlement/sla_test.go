@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestSLA_Match(t *testing.T) {
+	sla := NewLatency(30*time.Second, []*Compensation{
+		{Low: 50, High: 60, Rate: 10},
+		{Low: 60, High: 80, Rate: 20.5},
+	})
+	if sla.Value != 30 {
+		t.Fatal(sla.Value)
+	}
+	if c := sla.Match(60); c == nil || c.Rate != 20.5 {
+		t.Fatal(c)
+	}
+	if c := sla.Match(40); c != nil {
+		t.Fatal(c)
+	}
+	if !sla.IsAttained(30) || sla.IsAttained(31) {
+		t.Fatal("invalid latency attainment")
+	}
+
+	rate := NewDeliveredRate(0.95, nil)
+	if !rate.IsAttained(0.95) || rate.IsAttained(0.9) {
+		t.Fatal("invalid delivered rate attainment")
+	}
+}
+
+func TestSLA_Normalize(t *testing.T) {
+	// latency SLA registered before versioning is in nanoseconds
+	legacy := &SLA{
+		SLAType:       SLATypeLatency,
+		Value:         float32(30 * time.Second),
+		Compensations: []*Compensation{{Low: float32(50 * time.Second), High: float32(100 * time.Second), Rate: 10}},
+	}
+	bts, err := legacy.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &SLA{}
+	if err := decoded.Deserialize(bts); err != nil || decoded.Version != 0 {
+		t.Fatal(err, decoded)
+	}
+	if b, _ := decoded.Serialize(); string(b) != string(bts) {
+		t.Fatal("legacy SLA should be serialized as before")
+	}
+
+	sla := decoded.normalize()
+	if sla.Value != 30 || sla.Compensations[0].Low != 50 || sla.Compensations[0].High != 100 || sla.Compensations[0].Rate != 10 {
+		t.Fatal(sla)
+	}
+	if decoded.Value != float32(30*time.Second) {
+		t.Fatal("legacy SLA should not be changed")
+	}
+	if c := sla.Match(60); c == nil || !sla.IsAttained(30) {
+		t.Fatal("invalid normalized SLA")
+	}
+
+	latency := NewLatency(30*time.Second, nil)
+	if latency.Version != SLAVersion || latency.normalize() != latency {
+		t.Fatal("latency in seconds should not be normalized")
+	}
+	rate := &SLA{SLAType: SLATypeDeliveredRate, Value: 0.95}
+	if rate.normalize() != rate {
+		t.Fatal("delivered rate should not be normalized")
+	}
+}
+
+func TestGetSLAReport(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	a1 := mock.Address()
+	a2 := mock.Address()
+	param := buildContractParam()
+	param.PartyA.Address = a1
+	param.PartyB.Address = a2
+	contractAddr, _ := param.Address()
+	abi, _ := param.ToABI()
+	if err := SaveContractParam(ctx, &contractAddr, abi[:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	// no asset with SLA of the contract
+	if _, err := GetSLAReport(l, &contractAddr, 0, 0); err != ErrNoSLAAsset {
+		t.Fatal(err)
+	}
+
+	asset := assetParam
+	asset.Owner.Address = a2
+	asset.Previous = mock.Hash()
+	asset.Assets = []*Asset{
+		{
+			Mcc:         param.Services[0].Mcc,
+			Mnc:         param.Services[0].Mnc,
+			TotalAmount: 1000,
+			SLAs: []*SLA{
+				NewDeliveredRate(0.95, []*Compensation{{Low: 0.7, High: 0.9, Rate: 5}}),
+				NewLatency(30*time.Second, []*Compensation{{Low: 50, High: 100, Rate: 10}}),
+			},
+		},
+	}
+	if abi, err := asset.ToABI(); err != nil {
+		t.Fatal(err)
+	} else if err := SaveAssetParam(ctx, abi); err != nil {
+		t.Fatal(err)
+	}
+
+	// 8 of 10 CDRs are delivered, party B records them 60 seconds later
+	now := time.Now().Unix()
+	for i := 0; i < 10; i++ {
+		p1 := cdrParam
+		p1.Index = uint64(i + 1)
+		p1.SmsDt = now
+		p2 := p1
+		p2.SmsDt = now + 60
+		s := &CDRStatus{
+			Params: map[string][]CDRParam{a1.String(): {p1}, a2.String(): {p2}},
+			Status: SettlementStatusSuccess,
+		}
+		if i < 2 {
+			s.Status = SettlementStatusFailure
+		}
+		h, err := s.ToHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		abi, _ := s.ToABI()
+		if err := ctx.SetStorage(contractAddr[:], h[:], abi); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := GetSLAReport(l, &contractAddr, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 10 || report.Delivered != 8 || report.Measured != 10 || len(report.Attainments) != 2 {
+		t.Fatal(report)
+	}
+	latency := report.Attainments[0]
+	if latency.SLAType != SLATypeLatency || latency.Actual != 60 || latency.Attained || latency.Rate != 10 {
+		t.Fatal(latency)
+	}
+	rate := report.Attainments[1]
+	if rate.SLAType != SLATypeDeliveredRate || rate.Actual != 0.8 || rate.Attained || rate.Rate != 5 {
+		t.Fatal(rate)
+	}
+	if report.CompensationRate != 15 {
+		t.Fatal(report.CompensationRate)
+	}
+
+	invoices, err := GenerateInvoicesByContract(l, &contractAddr, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invoices) == 0 {
+		t.Fatal("invalid invoice")
+	}
+	for _, invoice := range invoices {
+		if len(invoice.Compensations) != 2 {
+			t.Fatal(invoice.Compensations)
+		}
+		exp := -invoice.SumOfTOTPrice * 0.15
+		if math.Abs(invoice.SumOfCompensation-exp) > 1e-9 || math.Abs(invoice.TotalPrice-invoice.SumOfTOTPrice-exp) > 1e-9 {
+			t.Fatal(invoice.SumOfCompensation, invoice.TotalPrice)
+		}
+	}
+}
+
+func TestApplyCompensations(t *testing.T) {
+	invoices := []*InvoiceRecord{{Address: mock.Address(), SumOfTOTPrice: 100.1, Charge: "100.1"}}
+	applyCompensations(nil, invoices)
+	if invoices[0].TotalPrice != 100.1 || invoices[0].TotalCharge != "100.1" || invoices[0].CompensationCharge != "0" ||
+		len(invoices[0].Compensations) != 0 {
+		t.Fatal(invoices[0])
+	}
+
+	report := &SLAReport{
+		Address: types.ZeroAddress,
+		Attainments: []*SLAAttainment{
+			{SLAType: SLATypeDeliveredRate, Actual: 0.99, Attained: true, Matched: true, Rate: 0.3},
+			{SLAType: SLATypeLatency, Actual: 10, Attained: true, Matched: true, Rate: -2},
+			{SLAType: SLATypeLatency, Actual: 10, Attained: true},
+		},
+	}
+	applyCompensations(report, invoices)
+	// -100.1 * 0.3% + 100.1 * 2%
+	invoice := invoices[0]
+	if len(invoice.Compensations) != 2 || invoice.Compensations[0].Charge != "-0.3003" || invoice.Compensations[1].Charge != "2.002" {
+		t.Fatal(invoice.Compensations)
+	}
+	if invoice.CompensationCharge != "1.7017" || invoice.TotalCharge != "101.8017" || invoice.SumOfCompensation != 1.7017 ||
+		invoice.TotalPrice != 101.8017 {
+		t.Fatal(invoice)
+	}
+
+	// the shortfall of the minimum commitment is not compensated
+	commitment := &InvoiceRecord{Customer: InvoiceCustomerCommitment, SumOfTOTPrice: 5, Charge: "5"}
+	applyCompensations(report, []*InvoiceRecord{commitment})
+	if len(commitment.Compensations) != 0 || commitment.TotalCharge != "5" {
+		t.Fatal(commitment)
+	}
+}
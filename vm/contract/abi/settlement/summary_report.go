@@ -10,6 +10,7 @@ package settlement
 import (
 	"encoding/json"
 
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
 )
 
@@ -79,16 +80,78 @@ func (z *CompareRecord) DoCalculate() {
 	}
 }
 
+// DisputeRecord counts the disputed CDRs by dispute status, CDRs of unresolved disputes are excluded from the
+// compare records and CDRs of resolved disputes are counted as matching by the resolution
+type DisputeRecord struct {
+	Open     uint64 `json:"open"`
+	Proposed uint64 `json:"proposed"`
+	Resolved uint64 `json:"resolved"`
+}
+
+func (z *DisputeRecord) UpdateCounter(status DisputeStatus) {
+	switch status {
+	case DisputeStatusOpen:
+		z.Open++
+	case DisputeStatusProposed:
+		z.Proposed++
+	case DisputeStatusResolved:
+		z.Resolved++
+	}
+}
+
+// UsageRecord sums the usage of the billable CDRs of a kind in the billing units, voice calls are rounded up
+// to minutes one by one
+type UsageRecord struct {
+	CDRs     uint64 `json:"cdrs"`
+	Seconds  uint64 `json:"seconds,omitempty"`
+	Minutes  uint64 `json:"minutes,omitempty"`
+	Bytes    uint64 `json:"bytes,omitempty"`
+	MB       string `json:"mb,omitempty"`
+	Mismatch uint64 `json:"mismatch,omitempty"` // CDRs whose usage reported by the parties does not match
+}
+
+func (z *UsageRecord) add(kind CDRKind, usage uint64) {
+	z.CDRs++
+	switch kind {
+	case CDRKindVoice:
+		z.Seconds += usage
+		z.Minutes += (usage + 59) / 60
+	case CDRKindData:
+		z.Bytes += usage
+		z.MB = formatDecimal(BillingUnitMB.quantity(z.Bytes))
+	}
+}
+
 type SummaryResult struct {
 	Contract *ContractParam            `json:"contract"`
 	Records  map[string]*CompareRecord `json:"records"`
 	Total    *CompareRecord            `json:"total"`
+	Disputes DisputeRecord             `json:"disputes"`
+	Usage    map[CDRKind]*UsageRecord  `json:"usage"`
 }
 
 func newSummaryResult() *SummaryResult {
 	return &SummaryResult{
 		Records: make(map[string]*CompareRecord),
 		Total:   newCompareRecord(),
+		Usage:   make(map[CDRKind]*UsageRecord),
+	}
+}
+
+func (z *SummaryResult) usage(kind CDRKind) *UsageRecord {
+	if _, ok := z.Usage[kind]; !ok {
+		z.Usage[kind] = &UsageRecord{}
+	}
+	return z.Usage[kind]
+}
+
+// UpdateUsage sums the usage of the CDR if it is billable, the usage of mismatched CDRs is not counted
+func (z *SummaryResult) UpdateUsage(status *CDRStatus, party *types.Address, billable bool) {
+	kind, usage := status.ExtractUsage(party)
+	if billable {
+		z.usage(kind).add(kind, usage)
+	} else if status.Status == SettlementStatusMismatch {
+		z.usage(kind).Mismatch++
 	}
 }
 
@@ -43,6 +43,12 @@ var (
 			cabi.MethodNameRemoveNextStop:    &RemoveNextStop{},
 			cabi.MethodNameTerminateContract: &TerminateContract{},
 			cabi.MethodNameRegisterAsset:     &RegisterAsset{},
+			cabi.MethodNameUpdateRateCard:    &UpdateRateCard{},
+
+			cabi.MethodNameOpenDispute:              &OpenDispute{},
+			cabi.MethodNameAddDisputeEvidence:       &AddDisputeEvidence{},
+			cabi.MethodNameProposeDisputeResolution: &ProposeDisputeResolution{},
+			cabi.MethodNameSignDisputeResolution:    &SignDisputeResolution{},
 		},
 		cabi.SettlementABI,
 		cabi.JsonSettlement,
@@ -369,7 +375,7 @@ func (p *ProcessCDR) save(ctx *vmstore.VMContext, block *types.StateBlock, ca *t
 	}()
 
 	if param.SmsDt <= 0 || param.SmsDt < contract.StartDate || param.SmsDt > contract.EndDate {
-		return fmt.Errorf("invalid SMS date, should be in [%s, %s], got %s",
+		return fmt.Errorf("invalid CDR date, should be in [%s, %s], got %s",
 			timeString(contract.StartDate), timeString(contract.EndDate), timeString(param.SmsDt))
 	}
 
@@ -886,6 +892,180 @@ func (r *RegisterAsset) ProcessSend(ctx *vmstore.VMContext, block *types.StateBl
 		}, nil
 }
 
+type UpdateRateCard struct {
+	internalContract
+}
+
+func (u *UpdateRateCard) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, input *types.StateBlock) ([]*ContractBlock, error) {
+	return handleReceive(ctx, block, input, func(data []byte) error {
+		param := new(cabi.RateCardParam)
+		return param.FromABI(data)
+	})
+}
+
+func (u *UpdateRateCard) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	param := new(cabi.RateCardParam)
+	err := param.FromABI(block.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := param.Verify(); err != nil {
+		return nil, nil, err
+	}
+
+	// only PartyA who created the contract can change the prices
+	return handleSend(ctx, block, true, param.ContractAddress, func(cp *cabi.ContractParam) (err error) {
+		card := param.RateCard
+		return cp.AddRateCard(&card, block.Timestamp)
+	})
+}
+
+type OpenDispute struct {
+	internalContract
+}
+
+func (o *OpenDispute) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, input *types.StateBlock) ([]*ContractBlock, error) {
+	return handleReceive(ctx, block, input, func(data []byte) error {
+		param := new(cabi.DisputeParam)
+		return param.FromABI(cabi.MethodNameOpenDispute, data)
+	})
+}
+
+func (o *OpenDispute) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	return handleDispute(ctx, block, cabi.MethodNameOpenDispute, func(param *cabi.DisputeParam, cp *cabi.ContractParam) (*cabi.Dispute, error) {
+		for _, h := range param.CDRs {
+			if _, err := cabi.GetCDRStatus(ctx, &param.ContractAddress, h); err != nil {
+				return nil, fmt.Errorf("can not find CDR %s, %s", h.String(), err)
+			}
+			// a CDR can only be disputed once
+			if _, err := cabi.GetDisputeByCDR(ctx, &param.ContractAddress, h); err == nil {
+				return nil, fmt.Errorf("%s, %s", cabi.ErrCDRDisputed, h.String())
+			} else if err != vmstore.ErrStorageNotFound {
+				return nil, err
+			}
+		}
+		return param.ToDispute(block.Address, block.Timestamp), nil
+	})
+}
+
+type AddDisputeEvidence struct {
+	internalContract
+}
+
+func (a *AddDisputeEvidence) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, input *types.StateBlock) ([]*ContractBlock, error) {
+	return handleReceive(ctx, block, input, func(data []byte) error {
+		param := new(cabi.DisputeParam)
+		return param.FromABI(cabi.MethodNameAddDisputeEvidence, data)
+	})
+}
+
+func (a *AddDisputeEvidence) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	return handleDispute(ctx, block, cabi.MethodNameAddDisputeEvidence, func(param *cabi.DisputeParam, cp *cabi.ContractParam) (*cabi.Dispute, error) {
+		dispute, err := cabi.GetDispute(ctx, &param.ContractAddress, param.DisputeID)
+		if err != nil {
+			return nil, err
+		}
+		evidence := *param.Evidence
+		evidence.From = block.Address
+		evidence.Date = block.Timestamp
+		if err := dispute.AddEvidence(&evidence); err != nil {
+			return nil, err
+		}
+		return dispute, nil
+	})
+}
+
+type ProposeDisputeResolution struct {
+	internalContract
+}
+
+func (p *ProposeDisputeResolution) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, input *types.StateBlock) ([]*ContractBlock, error) {
+	return handleReceive(ctx, block, input, func(data []byte) error {
+		param := new(cabi.DisputeParam)
+		return param.FromABI(cabi.MethodNameProposeDisputeResolution, data)
+	})
+}
+
+func (p *ProposeDisputeResolution) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	return handleDispute(ctx, block, cabi.MethodNameProposeDisputeResolution, func(param *cabi.DisputeParam, cp *cabi.ContractParam) (*cabi.Dispute, error) {
+		dispute, err := cabi.GetDispute(ctx, &param.ContractAddress, param.DisputeID)
+		if err != nil {
+			return nil, err
+		}
+		if err := dispute.Propose(cp, block.Address, param.Resolution, param.SplitRate); err != nil {
+			return nil, err
+		}
+		return dispute, nil
+	})
+}
+
+type SignDisputeResolution struct {
+	internalContract
+}
+
+func (s *SignDisputeResolution) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, input *types.StateBlock) ([]*ContractBlock, error) {
+	return handleReceive(ctx, block, input, func(data []byte) error {
+		param := new(cabi.DisputeParam)
+		return param.FromABI(cabi.MethodNameSignDisputeResolution, data)
+	})
+}
+
+func (s *SignDisputeResolution) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	return handleDispute(ctx, block, cabi.MethodNameSignDisputeResolution, func(param *cabi.DisputeParam, cp *cabi.ContractParam) (*cabi.Dispute, error) {
+		dispute, err := cabi.GetDispute(ctx, &param.ContractAddress, param.DisputeID)
+		if err != nil {
+			return nil, err
+		}
+		if err := dispute.Sign(cp, block.Address, block.Timestamp); err != nil {
+			return nil, err
+		}
+		return dispute, nil
+	})
+}
+
+// handleDispute processes the dispute of the settlement contract, which can be changed by both parties
+func handleDispute(ctx *vmstore.VMContext, block *types.StateBlock, methodName string,
+	process func(param *cabi.DisputeParam, cp *cabi.ContractParam) (*cabi.Dispute, error)) (*types.PendingKey, *types.PendingInfo, error) {
+	// check token is QGAS
+	if block.Token != cfg.GasToken() {
+		return nil, nil, fmt.Errorf("invalid token: %s", block.Token.String())
+	}
+
+	param := new(cabi.DisputeParam)
+	if err := param.FromABI(methodName, block.Data); err != nil {
+		return nil, nil, err
+	}
+	if err := param.Verify(methodName); err != nil {
+		return nil, nil, err
+	}
+
+	cp, err := cabi.GetSettlementContract(ctx, &param.ContractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cp.IsContractor(block.Address) {
+		return nil, nil, fmt.Errorf("permission denied, %s is not the contractor", block.Address.String())
+	}
+
+	dispute, err := process(param, cp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cabi.SaveDispute(ctx, dispute); err != nil {
+		return nil, nil, err
+	}
+
+	return &types.PendingKey{
+			Address: block.Address,
+			Hash:    block.GetHash(),
+		}, &types.PendingInfo{
+			Source: types.Address(block.Link),
+			Amount: types.ZeroBalance,
+			Type:   block.Token,
+		}, nil
+}
+
 func timeString(t int64) string {
 	return time.Unix(t, 0).Format(time.RFC3339)
 }
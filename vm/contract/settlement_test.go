@@ -1834,3 +1834,205 @@ func TestProcessCDR_save(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateRateCard_ProcessSend(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	address, a1, a2, err := buildContract(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	now := common.TimeNow().Unix()
+	param := &cabi.RateCardParam{
+		ContractAddress: address,
+		RateCard: cabi.RateCard{
+			EffectiveDate: now + 3600,
+			Currency:      "USD",
+			Rates: []*cabi.Rate{
+				{Tiers: []*cabi.RateTier{{From: 0, Price: "0.05"}, {From: 1000, Price: "0.04"}}},
+			},
+		},
+	}
+	newBlock := func(addr types.Address, param *cabi.RateCardParam) *types.StateBlock {
+		tm, err := ctx.GetTokenMeta(addr, cfg.GasToken())
+		if err != nil {
+			t.Fatal(err)
+		}
+		abi, err := param.ToABI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &types.StateBlock{
+			Type:           types.ContractSend,
+			Token:          tm.Type,
+			Address:        addr,
+			Balance:        tm.Balance,
+			Vote:           types.ZeroBalance,
+			Network:        types.ZeroBalance,
+			Oracle:         types.ZeroBalance,
+			Storage:        types.ZeroBalance,
+			Previous:       tm.Header,
+			Link:           types.Hash(contractaddress.SettlementAddress),
+			Representative: tm.Representative,
+			Data:           abi,
+			Timestamp:      now,
+		}
+	}
+
+	u := &UpdateRateCard{}
+	// only PartyA can update the rate card
+	if _, _, err := u.ProcessSend(ctx, newBlock(a2, param)); err == nil {
+		t.Fatal("partyB should not update the rate card")
+	}
+
+	sb := newBlock(a1, param)
+	if _, _, err := u.ProcessSend(ctx, sb); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	c, err := cabi.GetSettlementContract(ctx, &address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// flat price of the services is kept as version 0
+	if cards := c.GetRateCards(); len(cards) != 2 || cards[0].Version != 0 || cards[1].Version != 1 ||
+		cards[0].Rates[0].Tiers[0].Price != "2" {
+		t.Fatal(c.RateCards)
+	}
+
+	if _, err := u.DoReceive(ctx, &types.StateBlock{}, sb); err != nil {
+		t.Fatal(err)
+	}
+
+	// price can not be changed retroactively
+	param.RateCard.EffectiveDate = now - 1
+	if _, _, err := u.ProcessSend(ctx, newBlock(a1, param)); err == nil {
+		t.Fatal("rate card should not be effective in the past")
+	}
+	param.RateCard.EffectiveDate = now + 7200
+	param.RateCard.Currency = "EUR"
+	if _, _, err := u.ProcessSend(ctx, newBlock(a1, param)); err == nil {
+		t.Fatal("currency should not be changed")
+	}
+}
+
+func TestDispute_ProcessSend(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	address, a1, a2, err := buildContract(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	var cdrs []types.Hash
+	for i := 0; i < 2; i++ {
+		cdr := cabi.CDRParam{
+			Index:         uint64(10000 + i),
+			SmsDt:         time.Now().Unix(),
+			Sender:        "PCCWG",
+			Destination:   "85257***34" + strconv.Itoa(i),
+			SendingStatus: cabi.SendingStatusSent,
+			DlrStatus:     cabi.DLRStatusDelivered,
+		}
+		cdr2 := cdr
+		cdr2.DlrStatus = cabi.DLRStatusUndelivered
+		status := &cabi.CDRStatus{
+			Params: map[string][]cabi.CDRParam{a1.String(): {cdr}, a2.String(): {cdr2}},
+			Status: cabi.SettlementStatusFailure,
+		}
+		h, _ := cdr.ToHash()
+		if err := cabi.SaveCDRStatus(ctx, &address, &h, status); err != nil {
+			t.Fatal(err)
+		}
+		cdrs = append(cdrs, h)
+	}
+
+	now := common.TimeNow().Unix()
+	newBlock := func(addr types.Address, methodName string, param *cabi.DisputeParam) *types.StateBlock {
+		abi, err := param.ToABI(methodName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &types.StateBlock{
+			Type:      types.ContractSend,
+			Token:     cfg.GasToken(),
+			Address:   addr,
+			Link:      types.Hash(contractaddress.SettlementAddress),
+			Data:      abi,
+			Timestamp: now,
+		}
+	}
+
+	o := &OpenDispute{}
+	param := &cabi.DisputeParam{ContractAddress: address, CDRs: cdrs, Reason: "DLR status mismatch"}
+	if _, _, err := o.ProcessSend(ctx, newBlock(mock.Address(), cabi.MethodNameOpenDispute, param)); err == nil {
+		t.Fatal("only contractors can open the dispute")
+	}
+	if _, _, err := o.ProcessSend(ctx, newBlock(a2, cabi.MethodNameOpenDispute,
+		&cabi.DisputeParam{ContractAddress: address, CDRs: []types.Hash{mock.Hash()}, Reason: "invalid"})); err == nil {
+		t.Fatal("CDR should exist")
+	}
+	sb := newBlock(a2, cabi.MethodNameOpenDispute, param)
+	if _, _, err := o.ProcessSend(ctx, sb); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.DoReceive(ctx, &types.StateBlock{}, sb); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := o.ProcessSend(ctx, newBlock(a1, cabi.MethodNameOpenDispute,
+		&cabi.DisputeParam{ContractAddress: address, CDRs: cdrs[:1], Reason: "duplicate"})); err == nil {
+		t.Fatal("CDR should be disputed only once")
+	}
+
+	dispute, err := cabi.GetDisputeByCDR(ctx, &address, cdrs[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dispute.Opener != a2 || dispute.Status != cabi.DisputeStatusOpen || len(dispute.CDRs) != 2 {
+		t.Fatal(dispute)
+	}
+	id := dispute.ID
+
+	e := &AddDisputeEvidence{}
+	if _, _, err := e.ProcessSend(ctx, newBlock(a1, cabi.MethodNameAddDisputeEvidence, &cabi.DisputeParam{
+		ContractAddress: address, DisputeID: id, Evidence: &cabi.Evidence{Description: "DLR of the SMSC", Hash: mock.Hash()},
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &SignDisputeResolution{}
+	sign := &cabi.DisputeParam{ContractAddress: address, DisputeID: id}
+	if _, _, err := s.ProcessSend(ctx, newBlock(a2, cabi.MethodNameSignDisputeResolution, sign)); err == nil {
+		t.Fatal("dispute without proposal should not be signed")
+	}
+
+	p := &ProposeDisputeResolution{}
+	propose := &cabi.DisputeParam{ContractAddress: address, DisputeID: id, Resolution: cabi.DisputeResolutionSplit, SplitRate: 50}
+	if _, _, err := p.ProcessSend(ctx, newBlock(a1, cabi.MethodNameProposeDisputeResolution, propose)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.ProcessSend(ctx, newBlock(a1, cabi.MethodNameSignDisputeResolution, sign)); err == nil {
+		t.Fatal("proposer has already signed")
+	}
+	if _, _, err := s.ProcessSend(ctx, newBlock(a2, cabi.MethodNameSignDisputeResolution, sign)); err != nil {
+		t.Fatal(err)
+	}
+
+	dispute, err = cabi.GetDispute(ctx, &address, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dispute.IsResolved() || len(dispute.Evidences) != 1 || dispute.Evidences[0].From != a1 || dispute.ResolveDate != now {
+		t.Fatal(dispute)
+	}
+	if _, _, err := p.ProcessSend(ctx, newBlock(a2, cabi.MethodNameProposeDisputeResolution, propose)); err == nil {
+		t.Fatal("resolved dispute should not be changed")
+	}
+}
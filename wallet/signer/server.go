@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package signer
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+// Server serves the signers by the remote signer protocol, it runs in the process holding the
+// private keys, e.g. cmd/signer or an HSM bridge
+type Server struct {
+	token   string
+	signers map[types.Address]types.Signer
+	logger  *zap.SugaredLogger
+}
+
+func NewServer(token string, signers ...types.Signer) *Server {
+	s := &Server{
+		token:   token,
+		signers: make(map[types.Address]types.Signer),
+		logger:  log.NewLogger("signer"),
+	}
+	for _, signer := range signers {
+		s.signers[signer.Address()] = signer
+	}
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	switch r.URL.Path {
+	case PathAccounts:
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		rsp := &AccountsResponse{Accounts: make([]types.Address, 0, len(s.signers))}
+		for addr := range s.signers {
+			rsp.Accounts = append(rsp.Accounts, addr)
+		}
+		writeJSON(w, http.StatusOK, rsp)
+	case PathSign:
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		s.sign(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) sign(w http.ResponseWriter, r *http.Request) {
+	req := new(SignRequest)
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxDataSize*2+1024)).Decode(req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	data, err := hex.DecodeString(req.Data)
+	if err != nil || len(data) == 0 || len(data) > maxDataSize {
+		writeError(w, http.StatusBadRequest, "invalid data")
+		return
+	}
+	signer, ok := s.signers[req.Address]
+	if !ok {
+		writeError(w, http.StatusNotFound, "account not found")
+		return
+	}
+	sig, err := signer.SignBytes(data)
+	if err != nil {
+		s.logger.Errorf("sign for %s: %s", req.Address, err)
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.logger.Infof("signed %d bytes for %s from %s", len(data), req.Address, r.RemoteAddr)
+	writeJSON(w, http.StatusOK, &SignResponse{Signature: hex.EncodeToString(sig[:])})
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+	const prefix = "bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return false
+	}
+	token := strings.TrimSpace(auth[len(prefix):])
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, &ErrorResponse{Error: msg})
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package signer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+// Protocol of the remote signer, requests carry header `Authorization: Bearer <token>` if the token is set
+//
+//	GET  /v1/accounts  -> {"accounts": ["qlc_..."]}
+//	POST /v1/sign      {"address": "qlc_...", "data": "<hex>"} -> {"signature": "<hex>"}
+//
+// failed requests are answered with a non 200 status and {"error": "..."}
+const (
+	PathAccounts = "/v1/accounts"
+	PathSign     = "/v1/sign"
+
+	maxDataSize = 64 * 1024
+)
+
+var (
+	ErrSignerDisabled   = errors.New("remote signer is disabled")
+	ErrInvalidSignature = errors.New("invalid signature from remote signer")
+)
+
+type AccountsResponse struct {
+	Accounts []types.Address `json:"accounts"`
+}
+
+type SignRequest struct {
+	Address types.Address `json:"address"`
+	Data    string        `json:"data"`
+}
+
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Remote signs for an address by the remote signer, the returned signatures are verified
+// against the address
+type Remote struct {
+	address  types.Address
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+func NewRemote(cfg *config.SignerConfig, address types.Address) *Remote {
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &Remote{
+		address:  address,
+		endpoint: strings.TrimRight(cfg.Endpoint, "/"),
+		token:    cfg.Token,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Address implements types.Signer
+func (r *Remote) Address() types.Address {
+	return r.address
+}
+
+// SignBytes implements types.Signer
+func (r *Remote) SignBytes(data []byte) (types.Signature, error) {
+	req := &SignRequest{Address: r.address, Data: hex.EncodeToString(data)}
+	rsp := new(SignResponse)
+	if err := r.call(http.MethodPost, PathSign, req, rsp); err != nil {
+		return types.ZeroSignature, err
+	}
+	b, err := hex.DecodeString(rsp.Signature)
+	if err != nil {
+		return types.ZeroSignature, fmt.Errorf("decode signature: %s", err)
+	}
+	sig, err := types.BytesToSignature(b)
+	if err != nil {
+		return types.ZeroSignature, err
+	}
+	if !r.address.Verify(data, sig[:]) {
+		return types.ZeroSignature, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+// Accounts returns the addresses served by the remote signer
+func (r *Remote) Accounts() ([]types.Address, error) {
+	rsp := new(AccountsResponse)
+	if err := r.call(http.MethodGet, PathAccounts, nil, rsp); err != nil {
+		return nil, err
+	}
+	return rsp.Accounts, nil
+}
+
+func (r *Remote) call(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, r.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	rsp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote signer: %s", err)
+	}
+	defer rsp.Body.Close()
+	b, err := ioutil.ReadAll(io.LimitReader(rsp.Body, maxDataSize))
+	if err != nil {
+		return err
+	}
+	if rsp.StatusCode != http.StatusOK {
+		e := new(ErrorResponse)
+		if err := json.Unmarshal(b, e); err == nil && e.Error != "" {
+			return fmt.Errorf("remote signer: %s", e.Error)
+		}
+		return fmt.Errorf("remote signer: %s", rsp.Status)
+	}
+	return json.Unmarshal(b, out)
+}
+
+// NewAccounts creates the accounts of cfg.Accounts signed by the remote signer, it fails if
+// any of them is not served by the remote signer
+func NewAccounts(cfg *config.SignerConfig) ([]*types.Account, error) {
+	if cfg == nil || !cfg.Enable {
+		return nil, ErrSignerDisabled
+	}
+
+	var accounts []*types.Account
+	for _, s := range cfg.Accounts {
+		addr, err := types.HexToAddress(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer account %s: %s", s, err)
+		}
+		accounts = append(accounts, types.NewAccountWithSigner(NewRemote(cfg, addr)))
+	}
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+
+	served, err := NewRemote(cfg, types.ZeroAddress).Accounts()
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range accounts {
+		found := false
+		for _, addr := range served {
+			if addr == acc.Address() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("account %s is not served by remote signer", acc.Address())
+		}
+	}
+	return accounts, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package signer
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+type badSigner struct {
+	*types.Account
+	other *types.Account
+}
+
+func (s *badSigner) SignBytes(data []byte) (types.Signature, error) {
+	return s.other.SignBytes(data)
+}
+
+func setupSignerTestCase(t *testing.T, signers ...types.Signer) (*httptest.Server, *config.SignerConfig) {
+	ts := httptest.NewServer(NewServer("secret", signers...))
+	cfg := &config.SignerConfig{
+		Enable:   true,
+		Endpoint: ts.URL,
+		Token:    "secret",
+		Timeout:  1,
+	}
+	return ts, cfg
+}
+
+func TestRemote_SignBytes(t *testing.T) {
+	acc := mock.Account()
+	ts, cfg := setupSignerTestCase(t, acc)
+	defer ts.Close()
+
+	r := NewRemote(cfg, acc.Address())
+	hash := mock.Hash()
+	sig, err := r.SignBytes(hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig != acc.Sign(hash) {
+		t.Fatal("invalid signature")
+	}
+
+	// account backed by the remote signer
+	external := types.NewAccountWithSigner(r)
+	if !external.IsExternal() || external.PrivateKey() != nil || external.Address() != acc.Address() {
+		t.Fatal("invalid external account")
+	}
+	if sig, err := external.SignHash(hash); err != nil || !acc.Address().Verify(hash[:], sig[:]) {
+		t.Fatal(err)
+	}
+
+	if _, err := NewRemote(cfg, mock.Address()).SignBytes(hash[:]); err == nil {
+		t.Fatal("unknown account should fail")
+	}
+
+	cfg.Token = "wrong"
+	if _, err := NewRemote(cfg, acc.Address()).SignBytes(hash[:]); err == nil {
+		t.Fatal("unauthorized request should fail")
+	}
+	if sig := types.NewAccountWithSigner(NewRemote(cfg, acc.Address())).Sign(hash); sig != types.ZeroSignature {
+		t.Fatal("failed signing should return zero signature")
+	}
+}
+
+func TestRemote_InvalidSignature(t *testing.T) {
+	acc := mock.Account()
+	ts, cfg := setupSignerTestCase(t, &badSigner{Account: acc, other: mock.Account()})
+	defer ts.Close()
+
+	hash := mock.Hash()
+	if _, err := NewRemote(cfg, acc.Address()).SignBytes(hash[:]); err != ErrInvalidSignature {
+		t.Fatal(err)
+	}
+}
+
+func TestNewAccounts(t *testing.T) {
+	acc1 := mock.Account()
+	acc2 := mock.Account()
+	ts, cfg := setupSignerTestCase(t, acc1, acc2)
+	defer ts.Close()
+
+	if _, err := NewAccounts(&config.SignerConfig{}); err != ErrSignerDisabled {
+		t.Fatal(err)
+	}
+
+	cfg.Accounts = []string{acc1.Address().String(), acc2.Address().String()}
+	accounts, err := NewAccounts(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 2 || accounts[0].Address() != acc1.Address() || !accounts[1].IsExternal() {
+		t.Fatal("invalid accounts", accounts)
+	}
+
+	cfg.Accounts = append(cfg.Accounts, mock.Address().String())
+	if _, err := NewAccounts(cfg); err == nil {
+		t.Fatal("account not served should fail")
+	}
+
+	cfg.Accounts = []string{"xxx"}
+	if _, err := NewAccounts(cfg); err == nil {
+		t.Fatal("invalid account should fail")
+	}
+}
@@ -279,19 +279,55 @@ func (s *Session) ChangePassword(password string) error {
 }
 
 func (s *Session) GetRawKey(account types.Address) (*types.Account, error) {
+	_, a, err := s.searchAccount(account)
+	return a, err
+}
+
+// Signer returns the signer of account, the private key is derived from the wallet seed for each
+// signature and is not kept by the signer
+func (s *Session) Signer(account types.Address) (types.Signer, error) {
+	index, a, err := s.searchAccount(account)
+	if err != nil {
+		return nil, err
+	}
+	wipe(a.PrivateKey())
+	return &walletSigner{session: s, index: index, address: account}, nil
+}
+
+// Signers returns the signers of the first count accounts of the wallet
+func (s *Session) Signers(count int) ([]types.Signer, error) {
+	seed, err := s.getSeed()
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(seed[:])
+
+	var signers []types.Signer
+	for i := uint32(0); i < uint32(count); i++ {
+		a, err := seed.Account(i)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, &walletSigner{session: s, index: i, address: a.Address()})
+		wipe(a.PrivateKey())
+	}
+	return signers, nil
+}
+
+// searchAccount returns the index of account in the wallet and its raw key
+func (s *Session) searchAccount(account types.Address) (uint32, *types.Account, error) {
 	index, err := s.GetDeterministicIndex()
 	if err != nil {
 		index = 0
 	}
 
-	seedArray, err := s.GetSeed()
+	seed, err := s.getSeed()
 	if err != nil {
-		return nil, err
+		return 0, nil, err
 	}
+	defer wipe(seed[:])
 
 	max := util.UInt32Max(uint32(index), uint32(s.maxAccountCount))
-	seed, _ := types.BytesToSeed(seedArray)
-
 	for i := uint32(0); i < max; i++ {
 		a, err := seed.Account(uint32(i))
 		if err != nil {
@@ -299,11 +335,56 @@ func (s *Session) GetRawKey(account types.Address) (*types.Account, error) {
 		}
 		address := a.Address()
 		if address == account {
-			return a, nil
+			return i, a, nil
 		}
+		wipe(a.PrivateKey())
 	}
 
-	return nil, fmt.Errorf("can not fetch account[%s]'s raw key", account.String())
+	return 0, nil, fmt.Errorf("can not fetch account[%s]'s raw key", account.String())
+}
+
+func (s *Session) getSeed() (*types.Seed, error) {
+	seedArray, err := s.GetSeed()
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(seedArray)
+	return types.BytesToSeed(seedArray)
+}
+
+// walletSigner signs by the account of the wallet, so the wallet can be replaced by a remote signer
+type walletSigner struct {
+	session *Session
+	index   uint32
+	address types.Address
+}
+
+func (ws *walletSigner) Address() types.Address {
+	return ws.address
+}
+
+func (ws *walletSigner) SignBytes(data []byte) (types.Signature, error) {
+	seed, err := ws.session.getSeed()
+	if err != nil {
+		return types.ZeroSignature, err
+	}
+	defer wipe(seed[:])
+
+	a, err := seed.Account(ws.index)
+	if err != nil {
+		return types.ZeroSignature, err
+	}
+	defer wipe(a.PrivateKey())
+	if a.Address() != ws.address {
+		return types.ZeroSignature, fmt.Errorf("account[%s] is not in the wallet", ws.address.String())
+	}
+	return a.SignBytes(data)
+}
+
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
 }
 
 func (s *Session) getKey(t byte) []byte {
@@ -193,6 +193,57 @@ func TestSession_GetRawKey(t *testing.T) {
 	}
 }
 
+func TestSession_Signer(t *testing.T) {
+	teardownTestCase, store := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	id, err := store.NewWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session := store.NewSession(id)
+	seedArray, err := session.GetSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, _, err := types.KeypairFromSeed(hex.EncodeToString(seedArray), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := types.PubToAddress(pub)
+
+	signer, err := session.Signer(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := mock.Hash()
+	sign, err := types.NewAccountWithSigner(signer).SignHash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verify := addr.Verify(hash[:], sign[:]); !verify {
+		t.Fatal("verify failed.")
+	}
+	if _, err := session.Signer(mock.Address()); err == nil {
+		t.Fatal("signer of invalid account should fail")
+	}
+
+	signers, err := session.Signers(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signers) != 3 || signers[2].Address() != addr {
+		t.Fatal("invalid signers")
+	}
+
+	// the wallet is locked by a wrong password
+	session.setPassword("wrong")
+	if _, err := signer.SignBytes(hash[:]); err == nil {
+		t.Fatal("locked wallet should not sign")
+	}
+}
+
 func TestSession_SetSeed(t *testing.T) {
 	teardownTestCase, store := setupTestCase(t)
 	defer teardownTestCase(t)